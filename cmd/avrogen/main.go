@@ -0,0 +1,132 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Command avrogen generates Go types from Avro schemas held in the Event Streams schema registry or in `.avsc` files.
+//
+// It is intended to be run from a go:generate directive, for example:
+//
+//	//go:generate go run github.com/IBM/eventstreams-go-sdk/cmd/avrogen -package events -o user_gen.go -schema-id users
+//	//go:generate go run github.com/IBM/eventstreams-go-sdk/cmd/avrogen -package events -o address_gen.go schemas/address.avsc
+//
+// Schemas are retrieved from the registry with the external configuration of the schemaregistry service, for example
+// the SCHEMAREGISTRY_URL and SCHEMAREGISTRY_APIKEY environment variables, unless -url and either -apikey or
+// -bearer-token are set.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/IBM/eventstreams-go-sdk/pkg/avro"
+	"github.com/IBM/eventstreams-go-sdk/pkg/schemaregistryv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+type schemaIDs []string
+
+func (ids *schemaIDs) String() string {
+	return strings.Join(*ids, ",")
+}
+
+func (ids *schemaIDs) Set(value string) error {
+	*ids = append(*ids, value)
+	return nil
+}
+
+func main() {
+	var ids schemaIDs
+	packageName := flag.String("package", os.Getenv("GOPACKAGE"), "the package of the generated code; defaults to $GOPACKAGE when run by go generate")
+	output := flag.String("o", "", "the file to write the generated code to; defaults to standard output")
+	version := flag.Int64("version", 0, "the version of the registry schema to generate from; defaults to the latest version")
+	url := flag.String("url", "", "the URL of the schema registry")
+	apiKey := flag.String("apikey", "", "an API key to authenticate to the schema registry with")
+	bearerToken := flag.String("bearer-token", "", "a bearer token to authenticate to the schema registry with")
+	flag.Var(&ids, "schema-id", "the ID of a registry schema to generate from; may be repeated")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: avrogen [flags] [file.avsc ...]\n\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if len(ids) == 0 && flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	if *version != 0 && len(ids) != 1 {
+		fail(fmt.Errorf("-version can only be used with a single -schema-id"))
+	}
+
+	generator := avro.NewGenerator(*packageName)
+	if flag.NArg() > 0 {
+		if err := generator.AddFiles(flag.Args()...); err != nil {
+			fail(err)
+		}
+	}
+	if len(ids) > 0 {
+		registry, err := newRegistry(*url, *apiKey, *bearerToken)
+		if err != nil {
+			fail(err)
+		}
+		var schemaVersion *int64
+		if *version != 0 {
+			schemaVersion = version
+		}
+		for _, id := range ids {
+			source, err := avro.FetchRegistrySchema(context.Background(), registry, id, schemaVersion)
+			if err != nil {
+				fail(err)
+			}
+			generator.AddSource(source)
+		}
+	}
+
+	code, err := generator.Generate()
+	if err != nil {
+		fail(err)
+	}
+	if *output == "" {
+		os.Stdout.Write(code)
+		return
+	}
+	if err := os.WriteFile(*output, code, 0644); err != nil {
+		fail(err)
+	}
+}
+
+func newRegistry(url string, apiKey string, bearerToken string) (*schemaregistryv1.SchemaregistryV1, error) {
+	options := &schemaregistryv1.SchemaregistryV1Options{URL: url}
+	var err error
+	switch {
+	case apiKey != "" && bearerToken != "":
+		return nil, fmt.Errorf("set either -apikey or -bearer-token, not both")
+	case apiKey != "":
+		options.Authenticator, err = core.NewBasicAuthenticator("token", apiKey)
+	case bearerToken != "":
+		options.Authenticator, err = core.NewBearerTokenAuthenticator(bearerToken)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return schemaregistryv1.NewSchemaregistryV1UsingExternalConfig(options)
+}
+
+func fail(err error) {
+	fmt.Fprintf(os.Stderr, "avrogen: %s\n", err.Error())
+	os.Exit(1)
+}
@@ -0,0 +1,98 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Command avrolint checks Avro schema files against the conventions in a lint config before they are registered, for
+// example:
+//
+//	avrolint -config lint.json schemas/*.avsc
+//
+// Findings are printed one per line, or as a JSON array of objects with file, rule, severity, path and message
+// properties when -format json is set. The exit status is 1 if any finding is an error.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/IBM/eventstreams-go-sdk/pkg/avro"
+)
+
+type fileFinding struct {
+	File string `json:"file"`
+	avro.LintFinding
+}
+
+func main() {
+	configPath := flag.String("config", "", "the JSON file with the lint rules to enforce")
+	format := flag.String("format", "text", "the output format, text or json")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: avrolint -config lint.json [flags] file.avsc ...\n\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if *configPath == "" || flag.NArg() == 0 || (*format != "text" && *format != "json") {
+		flag.Usage()
+		os.Exit(2)
+	}
+	config, err := avro.LoadLintConfig(*configPath)
+	if err != nil {
+		fail(err)
+	}
+
+	findings := []fileFinding{}
+	failed := false
+	for _, path := range flag.Args() {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			fail(err)
+		}
+		schema, err := avro.Parse(string(data))
+		if err != nil {
+			fail(fmt.Errorf("%s: %s", path, err.Error()))
+		}
+		fileFindings, err := config.Lint(schema)
+		if err != nil {
+			fail(err)
+		}
+		failed = failed || avro.HasLintErrors(fileFindings)
+		for _, finding := range fileFindings {
+			findings = append(findings, fileFinding{File: path, LintFinding: finding})
+		}
+	}
+
+	if *format == "json" {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(findings); err != nil {
+			fail(err)
+		}
+	} else {
+		for _, finding := range findings {
+			fmt.Printf("%s: %s\n", finding.File, finding.LintFinding.String())
+		}
+	}
+	if failed {
+		os.Exit(1)
+	}
+}
+
+func fail(err error) {
+	fmt.Fprintf(os.Stderr, "avrolint: %s\n", err.Error())
+	os.Exit(1)
+}
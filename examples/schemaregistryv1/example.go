@@ -14,13 +14,13 @@ package main
 
 // Code Setup
 import (
-	"encoding/json"
 	"fmt"
 	"log"
 	"net/http"
 	"os"
 	"time"
 
+	"github.com/IBM/eventstreams-go-sdk/pkg/avro"
 	"github.com/IBM/eventstreams-go-sdk/pkg/schemaregistryv1"
 	"github.com/IBM/go-sdk-core/v5/core"
 )
@@ -212,21 +212,13 @@ func main() {
 }
 
 func createSchema(esClient *schemaregistryv1.SchemaregistryV1) error {
-	// Form a schema of type map[string]interface{}
-	schema := make(map[string]interface{})
-	record := `{"type":"record","name":"Citizen","fields":[{"name":"firstName","type":"string"}]}`
-	err := json.Unmarshal([]byte(record), &schema)
-	if err != nil {
-		return fmt.Errorf("error while unmarshalling schema, %s", err)
+	// The Go type that the schema is derived from
+	type Citizen struct {
+		FirstName string `avro:"firstName"`
 	}
 
-	// Construct an instance of the createSchemaOptions
-	createSchemaOptions := esClient.NewCreateSchemaOptions()
-	createSchemaOptions.SetXRegistryArtifactID("schema-id")
-	createSchemaOptions.SetSchema(schema)
-
-	// Create the schema
-	schemaMetadata, response, operationErr := esClient.CreateSchema(createSchemaOptions)
+	// Derive the schema from the Citizen struct and create it
+	schemaMetadata, response, operationErr := avro.CreateSchemaFromStruct(esClient, "schema-id", Citizen{}, "")
 
 	if operationErr != nil {
 		return fmt.Errorf("error creating schema: %s", operationErr.Error())
@@ -333,17 +325,18 @@ func listSchemas(esClient *schemaregistryv1.SchemaregistryV1) error {
 } // func.end
 
 func updateSchema(esClient *schemaregistryv1.SchemaregistryV1) error {
-	// Form a schema of type map[string]interface{}
-	schema := make(map[string]interface{})
-	record := `{"type":"record","name":"Citizen","fields":[{"name":"first_name","type":"int"}]}`
-	err := json.Unmarshal([]byte(record), &schema)
+	// Derive the schema from a Go type
+	type Citizen struct {
+		FirstName int32 `avro:"first_name"`
+	}
+	schema, err := avro.Reflect(Citizen{}, "")
 	if err != nil {
-		return fmt.Errorf("error while unmarshalling schema, %s", err)
+		return fmt.Errorf("error while deriving schema, %s", err)
 	}
 
 	// Construct an instance of the UpdateSchemaOptions model
 	updateSchemaOptions := esClient.NewUpdateSchemaOptions("schema-id")
-	updateSchemaOptions.SetSchema(schema)
+	updateSchemaOptions.SetSchema(schema.ToMap())
 
 	// Update Schema
 	schemaMetadata, response, operationErr := esClient.UpdateSchema(updateSchemaOptions)
@@ -519,20 +512,14 @@ func deleteSchemaRule(esClient *schemaregistryv1.SchemaregistryV1) error {
 } // func.end
 
 func createVersion(esClient *schemaregistryv1.SchemaregistryV1) error {
-	// Form a schema of type map[string]interface{}
-	schema := make(map[string]interface{})
-	record := `{"type":"record","name":"Citizen","fields":[{"name":"first_name","type":"string"},{"name":"last_name","type":"string"}]}`
-	err := json.Unmarshal([]byte(record), &schema)
-	if err != nil {
-		return fmt.Errorf("error while unmarshalling schema, %s", err)
+	// The Go type that the new version of the schema is derived from
+	type Citizen struct {
+		FirstName string `avro:"first_name"`
+		LastName  string `avro:"last_name"`
 	}
 
-	// Construct an instance of the CreateVersionOptions
-	createVersionOptions := esClient.NewCreateVersionOptions("schema-id")
-	createVersionOptions.SetSchema(schema)
-
-	// Create the new version of schema
-	schemaMetadata, response, operationErr := esClient.CreateVersion(createVersionOptions)
+	// Derive the schema from the Citizen struct and create the new version of schema
+	schemaMetadata, response, operationErr := avro.CreateVersionFromStruct(esClient, "schema-id", Citizen{}, "")
 	if operationErr != nil {
 		return fmt.Errorf("error creating new version of schema: %s", operationErr.Error())
 	}
@@ -38,6 +38,9 @@ import (
 // API Version: 1.3.1
 type AdminrestV1 struct {
 	Service *core.BaseService
+
+	// The default timeouts of the operations, applied when their context has no deadline.
+	timeouts common.OperationTimeouts
 }
 
 // DefaultServiceName is the default key used to find external configuration information.
@@ -165,6 +168,22 @@ func (adminrest *AdminrestV1) DisableRetries() {
 	adminrest.Service.DisableRetries()
 }
 
+// SetOperationTimeouts sets the default timeouts of the operations of the service, by operation class. The timeouts
+// apply to operations whose context has no deadline, including those called without a context.
+func (adminrest *AdminrestV1) SetOperationTimeouts(timeouts common.OperationTimeouts) {
+	adminrest.timeouts = timeouts
+}
+
+// GetOperationTimeouts returns the default timeouts of the operations of the service.
+func (adminrest *AdminrestV1) GetOperationTimeouts() common.OperationTimeouts {
+	return adminrest.timeouts
+}
+
+// operationContext returns the context of an operation of class, bounded by the default timeout of the class.
+func (adminrest *AdminrestV1) operationContext(ctx context.Context, class string) (context.Context, context.CancelFunc) {
+	return common.OperationContext(ctx, adminrest.timeouts, class)
+}
+
 // CreateTopic : Create a new topic
 // Create a new topic.
 func (adminrest *AdminrestV1) CreateTopic(createTopicOptions *CreateTopicOptions) (response *core.DetailedResponse, err error) {
@@ -175,6 +194,9 @@ func (adminrest *AdminrestV1) CreateTopic(createTopicOptions *CreateTopicOptions
 
 // CreateTopicWithContext is an alternate form of the CreateTopic method which supports a Context parameter
 func (adminrest *AdminrestV1) CreateTopicWithContext(ctx context.Context, createTopicOptions *CreateTopicOptions) (response *core.DetailedResponse, err error) {
+	ctx, cancel := adminrest.operationContext(ctx, common.OperationClassWrite)
+	defer cancel()
+
 	err = core.ValidateNotNil(createTopicOptions, "createTopicOptions cannot be nil")
 	if err != nil {
 		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
@@ -249,6 +271,9 @@ func (adminrest *AdminrestV1) Alive(aliveOptions *AliveOptions) (response *core.
 
 // AliveWithContext is an alternate form of the Alive method which supports a Context parameter
 func (adminrest *AdminrestV1) AliveWithContext(ctx context.Context, aliveOptions *AliveOptions) (response *core.DetailedResponse, err error) {
+	ctx, cancel := adminrest.operationContext(ctx, common.OperationClassRead)
+	defer cancel()
+
 	err = core.ValidateStruct(aliveOptions, "aliveOptions")
 	if err != nil {
 		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
@@ -300,6 +325,9 @@ func (adminrest *AdminrestV1) ListTopics(listTopicsOptions *ListTopicsOptions) (
 
 // ListTopicsWithContext is an alternate form of the ListTopics method which supports a Context parameter
 func (adminrest *AdminrestV1) ListTopicsWithContext(ctx context.Context, listTopicsOptions *ListTopicsOptions) (result []TopicDetail, response *core.DetailedResponse, err error) {
+	ctx, cancel := adminrest.operationContext(ctx, common.OperationClassRead)
+	defer cancel()
+
 	err = core.ValidateStruct(listTopicsOptions, "listTopicsOptions")
 	if err != nil {
 		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
@@ -370,6 +398,9 @@ func (adminrest *AdminrestV1) GetTopic(getTopicOptions *GetTopicOptions) (result
 
 // GetTopicWithContext is an alternate form of the GetTopic method which supports a Context parameter
 func (adminrest *AdminrestV1) GetTopicWithContext(ctx context.Context, getTopicOptions *GetTopicOptions) (result *TopicDetail, response *core.DetailedResponse, err error) {
+	ctx, cancel := adminrest.operationContext(ctx, common.OperationClassRead)
+	defer cancel()
+
 	err = core.ValidateNotNil(getTopicOptions, "getTopicOptions cannot be nil")
 	if err != nil {
 		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
@@ -439,6 +470,9 @@ func (adminrest *AdminrestV1) DeleteTopic(deleteTopicOptions *DeleteTopicOptions
 
 // DeleteTopicWithContext is an alternate form of the DeleteTopic method which supports a Context parameter
 func (adminrest *AdminrestV1) DeleteTopicWithContext(ctx context.Context, deleteTopicOptions *DeleteTopicOptions) (response *core.DetailedResponse, err error) {
+	ctx, cancel := adminrest.operationContext(ctx, common.OperationClassWrite)
+	defer cancel()
+
 	err = core.ValidateNotNil(deleteTopicOptions, "deleteTopicOptions cannot be nil")
 	if err != nil {
 		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
@@ -498,6 +532,9 @@ func (adminrest *AdminrestV1) UpdateTopic(updateTopicOptions *UpdateTopicOptions
 
 // UpdateTopicWithContext is an alternate form of the UpdateTopic method which supports a Context parameter
 func (adminrest *AdminrestV1) UpdateTopicWithContext(ctx context.Context, updateTopicOptions *UpdateTopicOptions) (response *core.DetailedResponse, err error) {
+	ctx, cancel := adminrest.operationContext(ctx, common.OperationClassWrite)
+	defer cancel()
+
 	err = core.ValidateNotNil(updateTopicOptions, "updateTopicOptions cannot be nil")
 	if err != nil {
 		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
@@ -571,6 +608,9 @@ func (adminrest *AdminrestV1) DeleteTopicRecords(deleteTopicRecordsOptions *Dele
 
 // DeleteTopicRecordsWithContext is an alternate form of the DeleteTopicRecords method which supports a Context parameter
 func (adminrest *AdminrestV1) DeleteTopicRecordsWithContext(ctx context.Context, deleteTopicRecordsOptions *DeleteTopicRecordsOptions) (response *core.DetailedResponse, err error) {
+	ctx, cancel := adminrest.operationContext(ctx, common.OperationClassLongRunning)
+	defer cancel()
+
 	err = core.ValidateNotNil(deleteTopicRecordsOptions, "deleteTopicRecordsOptions cannot be nil")
 	if err != nil {
 		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
@@ -641,6 +681,9 @@ func (adminrest *AdminrestV1) CreateQuota(createQuotaOptions *CreateQuotaOptions
 
 // CreateQuotaWithContext is an alternate form of the CreateQuota method which supports a Context parameter
 func (adminrest *AdminrestV1) CreateQuotaWithContext(ctx context.Context, createQuotaOptions *CreateQuotaOptions) (response *core.DetailedResponse, err error) {
+	ctx, cancel := adminrest.operationContext(ctx, common.OperationClassWrite)
+	defer cancel()
+
 	err = core.ValidateNotNil(createQuotaOptions, "createQuotaOptions cannot be nil")
 	if err != nil {
 		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
@@ -714,6 +757,9 @@ func (adminrest *AdminrestV1) UpdateQuota(updateQuotaOptions *UpdateQuotaOptions
 
 // UpdateQuotaWithContext is an alternate form of the UpdateQuota method which supports a Context parameter
 func (adminrest *AdminrestV1) UpdateQuotaWithContext(ctx context.Context, updateQuotaOptions *UpdateQuotaOptions) (response *core.DetailedResponse, err error) {
+	ctx, cancel := adminrest.operationContext(ctx, common.OperationClassWrite)
+	defer cancel()
+
 	err = core.ValidateNotNil(updateQuotaOptions, "updateQuotaOptions cannot be nil")
 	if err != nil {
 		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
@@ -787,6 +833,9 @@ func (adminrest *AdminrestV1) DeleteQuota(deleteQuotaOptions *DeleteQuotaOptions
 
 // DeleteQuotaWithContext is an alternate form of the DeleteQuota method which supports a Context parameter
 func (adminrest *AdminrestV1) DeleteQuotaWithContext(ctx context.Context, deleteQuotaOptions *DeleteQuotaOptions) (response *core.DetailedResponse, err error) {
+	ctx, cancel := adminrest.operationContext(ctx, common.OperationClassWrite)
+	defer cancel()
+
 	err = core.ValidateNotNil(deleteQuotaOptions, "deleteQuotaOptions cannot be nil")
 	if err != nil {
 		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
@@ -846,6 +895,9 @@ func (adminrest *AdminrestV1) GetQuota(getQuotaOptions *GetQuotaOptions) (result
 
 // GetQuotaWithContext is an alternate form of the GetQuota method which supports a Context parameter
 func (adminrest *AdminrestV1) GetQuotaWithContext(ctx context.Context, getQuotaOptions *GetQuotaOptions) (result *QuotaDetail, response *core.DetailedResponse, err error) {
+	ctx, cancel := adminrest.operationContext(ctx, common.OperationClassRead)
+	defer cancel()
+
 	err = core.ValidateNotNil(getQuotaOptions, "getQuotaOptions cannot be nil")
 	if err != nil {
 		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
@@ -915,6 +967,9 @@ func (adminrest *AdminrestV1) ListQuotas(listQuotasOptions *ListQuotasOptions) (
 
 // ListQuotasWithContext is an alternate form of the ListQuotas method which supports a Context parameter
 func (adminrest *AdminrestV1) ListQuotasWithContext(ctx context.Context, listQuotasOptions *ListQuotasOptions) (result *QuotaList, response *core.DetailedResponse, err error) {
+	ctx, cancel := adminrest.operationContext(ctx, common.OperationClassRead)
+	defer cancel()
+
 	err = core.ValidateStruct(listQuotasOptions, "listQuotasOptions")
 	if err != nil {
 		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
@@ -975,6 +1030,9 @@ func (adminrest *AdminrestV1) ListBrokers(listBrokersOptions *ListBrokersOptions
 
 // ListBrokersWithContext is an alternate form of the ListBrokers method which supports a Context parameter
 func (adminrest *AdminrestV1) ListBrokersWithContext(ctx context.Context, listBrokersOptions *ListBrokersOptions) (result []BrokerSummary, response *core.DetailedResponse, err error) {
+	ctx, cancel := adminrest.operationContext(ctx, common.OperationClassRead)
+	defer cancel()
+
 	err = core.ValidateStruct(listBrokersOptions, "listBrokersOptions")
 	if err != nil {
 		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
@@ -1035,6 +1093,9 @@ func (adminrest *AdminrestV1) GetBroker(getBrokerOptions *GetBrokerOptions) (res
 
 // GetBrokerWithContext is an alternate form of the GetBroker method which supports a Context parameter
 func (adminrest *AdminrestV1) GetBrokerWithContext(ctx context.Context, getBrokerOptions *GetBrokerOptions) (result *BrokerDetail, response *core.DetailedResponse, err error) {
+	ctx, cancel := adminrest.operationContext(ctx, common.OperationClassRead)
+	defer cancel()
+
 	err = core.ValidateNotNil(getBrokerOptions, "getBrokerOptions cannot be nil")
 	if err != nil {
 		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
@@ -1104,6 +1165,9 @@ func (adminrest *AdminrestV1) GetBrokerConfig(getBrokerConfigOptions *GetBrokerC
 
 // GetBrokerConfigWithContext is an alternate form of the GetBrokerConfig method which supports a Context parameter
 func (adminrest *AdminrestV1) GetBrokerConfigWithContext(ctx context.Context, getBrokerConfigOptions *GetBrokerConfigOptions) (result *BrokerDetail, response *core.DetailedResponse, err error) {
+	ctx, cancel := adminrest.operationContext(ctx, common.OperationClassRead)
+	defer cancel()
+
 	err = core.ValidateNotNil(getBrokerConfigOptions, "getBrokerConfigOptions cannot be nil")
 	if err != nil {
 		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
@@ -1180,6 +1244,9 @@ func (adminrest *AdminrestV1) GetCluster(getClusterOptions *GetClusterOptions) (
 
 // GetClusterWithContext is an alternate form of the GetCluster method which supports a Context parameter
 func (adminrest *AdminrestV1) GetClusterWithContext(ctx context.Context, getClusterOptions *GetClusterOptions) (result *Cluster, response *core.DetailedResponse, err error) {
+	ctx, cancel := adminrest.operationContext(ctx, common.OperationClassRead)
+	defer cancel()
+
 	err = core.ValidateStruct(getClusterOptions, "getClusterOptions")
 	if err != nil {
 		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
@@ -1240,6 +1307,9 @@ func (adminrest *AdminrestV1) ListConsumerGroups(listConsumerGroupsOptions *List
 
 // ListConsumerGroupsWithContext is an alternate form of the ListConsumerGroups method which supports a Context parameter
 func (adminrest *AdminrestV1) ListConsumerGroupsWithContext(ctx context.Context, listConsumerGroupsOptions *ListConsumerGroupsOptions) (result []string, response *core.DetailedResponse, err error) {
+	ctx, cancel := adminrest.operationContext(ctx, common.OperationClassRead)
+	defer cancel()
+
 	err = core.ValidateStruct(listConsumerGroupsOptions, "listConsumerGroupsOptions")
 	if err != nil {
 		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
@@ -1301,6 +1371,9 @@ func (adminrest *AdminrestV1) GetConsumerGroup(getConsumerGroupOptions *GetConsu
 
 // GetConsumerGroupWithContext is an alternate form of the GetConsumerGroup method which supports a Context parameter
 func (adminrest *AdminrestV1) GetConsumerGroupWithContext(ctx context.Context, getConsumerGroupOptions *GetConsumerGroupOptions) (result *GroupDetail, response *core.DetailedResponse, err error) {
+	ctx, cancel := adminrest.operationContext(ctx, common.OperationClassRead)
+	defer cancel()
+
 	err = core.ValidateNotNil(getConsumerGroupOptions, "getConsumerGroupOptions cannot be nil")
 	if err != nil {
 		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
@@ -1370,6 +1443,9 @@ func (adminrest *AdminrestV1) DeleteConsumerGroup(deleteConsumerGroupOptions *De
 
 // DeleteConsumerGroupWithContext is an alternate form of the DeleteConsumerGroup method which supports a Context parameter
 func (adminrest *AdminrestV1) DeleteConsumerGroupWithContext(ctx context.Context, deleteConsumerGroupOptions *DeleteConsumerGroupOptions) (response *core.DetailedResponse, err error) {
+	ctx, cancel := adminrest.operationContext(ctx, common.OperationClassWrite)
+	defer cancel()
+
 	err = core.ValidateNotNil(deleteConsumerGroupOptions, "deleteConsumerGroupOptions cannot be nil")
 	if err != nil {
 		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
@@ -1429,6 +1505,9 @@ func (adminrest *AdminrestV1) UpdateConsumerGroup(updateConsumerGroupOptions *Up
 
 // UpdateConsumerGroupWithContext is an alternate form of the UpdateConsumerGroup method which supports a Context parameter
 func (adminrest *AdminrestV1) UpdateConsumerGroupWithContext(ctx context.Context, updateConsumerGroupOptions *UpdateConsumerGroupOptions) (result []GroupResetResultsItem, response *core.DetailedResponse, err error) {
+	ctx, cancel := adminrest.operationContext(ctx, common.OperationClassWrite)
+	defer cancel()
+
 	err = core.ValidateNotNil(updateConsumerGroupOptions, "updateConsumerGroupOptions cannot be nil")
 	if err != nil {
 		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
@@ -1518,6 +1597,9 @@ func (adminrest *AdminrestV1) GetMirroringTopicSelection(getMirroringTopicSelect
 
 // GetMirroringTopicSelectionWithContext is an alternate form of the GetMirroringTopicSelection method which supports a Context parameter
 func (adminrest *AdminrestV1) GetMirroringTopicSelectionWithContext(ctx context.Context, getMirroringTopicSelectionOptions *GetMirroringTopicSelectionOptions) (result *MirroringTopicSelection, response *core.DetailedResponse, err error) {
+	ctx, cancel := adminrest.operationContext(ctx, common.OperationClassRead)
+	defer cancel()
+
 	err = core.ValidateStruct(getMirroringTopicSelectionOptions, "getMirroringTopicSelectionOptions")
 	if err != nil {
 		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
@@ -1578,6 +1660,9 @@ func (adminrest *AdminrestV1) ReplaceMirroringTopicSelection(replaceMirroringTop
 
 // ReplaceMirroringTopicSelectionWithContext is an alternate form of the ReplaceMirroringTopicSelection method which supports a Context parameter
 func (adminrest *AdminrestV1) ReplaceMirroringTopicSelectionWithContext(ctx context.Context, replaceMirroringTopicSelectionOptions *ReplaceMirroringTopicSelectionOptions) (result *MirroringTopicSelection, response *core.DetailedResponse, err error) {
+	ctx, cancel := adminrest.operationContext(ctx, common.OperationClassWrite)
+	defer cancel()
+
 	err = core.ValidateNotNil(replaceMirroringTopicSelectionOptions, "replaceMirroringTopicSelectionOptions cannot be nil")
 	if err != nil {
 		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
@@ -1654,6 +1739,9 @@ func (adminrest *AdminrestV1) GetMirroringActiveTopics(getMirroringActiveTopicsO
 
 // GetMirroringActiveTopicsWithContext is an alternate form of the GetMirroringActiveTopics method which supports a Context parameter
 func (adminrest *AdminrestV1) GetMirroringActiveTopicsWithContext(ctx context.Context, getMirroringActiveTopicsOptions *GetMirroringActiveTopicsOptions) (result *MirroringActiveTopics, response *core.DetailedResponse, err error) {
+	ctx, cancel := adminrest.operationContext(ctx, common.OperationClassRead)
+	defer cancel()
+
 	err = core.ValidateStruct(getMirroringActiveTopicsOptions, "getMirroringActiveTopicsOptions")
 	if err != nil {
 		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
@@ -1714,6 +1802,9 @@ func (adminrest *AdminrestV1) GetStatus(getStatusOptions *GetStatusOptions) (res
 
 // GetStatusWithContext is an alternate form of the GetStatus method which supports a Context parameter
 func (adminrest *AdminrestV1) GetStatusWithContext(ctx context.Context, getStatusOptions *GetStatusOptions) (result *InstanceStatus, response *core.DetailedResponse, err error) {
+	ctx, cancel := adminrest.operationContext(ctx, common.OperationClassRead)
+	defer cancel()
+
 	err = core.ValidateStruct(getStatusOptions, "getStatusOptions")
 	if err != nil {
 		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
@@ -26,9 +26,11 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"sync/atomic"
 	"time"
 
 	"github.com/IBM/eventstreams-go-sdk/pkg/adminrestv1"
+	common "github.com/IBM/eventstreams-go-sdk/pkg/common"
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/go-openapi/strfmt"
 	. "github.com/onsi/ginkgo"
@@ -162,6 +164,84 @@ var _ = Describe(`AdminrestV1`, func() {
 			fmt.Fprintf(GinkgoWriter, "Expected error: %s\n", err.Error())
 		})
 	})
+	Describe(`Operation timeouts`, func() {
+		var requests atomic.Int32
+		BeforeEach(func() {
+			requests.Store(0)
+			testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+				requests.Add(1)
+
+				res.Header().Set("Content-type", "application/json")
+				switch req.URL.EscapedPath() {
+				case "/admin/topics/unavailable":
+					res.WriteHeader(503)
+					fmt.Fprintf(res, "%s", `{"error_code": 503, "message": "unavailable"}`)
+					return
+				case "/admin/topics/testString/records":
+					Expect(req.Method).To(Equal("DELETE"))
+				}
+
+				// Sleep a short time to support a timeout test
+				time.Sleep(100 * time.Millisecond)
+				res.WriteHeader(200)
+				fmt.Fprintf(res, "%s", `{"name": "testString"}`)
+			}))
+		})
+		It(`Apply the timeout of the operation class`, func() {
+			adminrestService, serviceErr := adminrestv1.NewAdminrestV1(&adminrestv1.AdminrestV1Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+			timeouts := common.OperationTimeouts{Read: 50 * time.Millisecond, Write: 50 * time.Millisecond}
+			adminrestService.SetOperationTimeouts(timeouts)
+			Expect(adminrestService.GetOperationTimeouts()).To(Equal(timeouts))
+
+			_, _, operationErr := adminrestService.GetTopic(adminrestService.NewGetTopicOptions("testString"))
+			Expect(operationErr).ToNot(BeNil())
+			Expect(operationErr.Error()).To(ContainSubstring("deadline exceeded"))
+
+			// A deadline of the context replaces the default timeout
+			ctx, cancelFunc := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancelFunc()
+			result, _, operationErr := adminrestService.GetTopicWithContext(ctx, adminrestService.NewGetTopicOptions("testString"))
+			Expect(operationErr).To(BeNil())
+			Expect(*result.Name).To(Equal("testString"))
+
+			// DeleteTopicRecords is long-running, which has no timeout
+			deleteTopicRecordsOptions := adminrestService.NewDeleteTopicRecordsOptions("testString")
+			deleteTopicRecordsOptions.SetRecordsToDelete([]adminrestv1.RecordDeleteRequestRecordsToDeleteItem{
+				{Partition: core.Int64Ptr(0), BeforeOffset: core.Int64Ptr(10)},
+			})
+			_, operationErr = adminrestService.DeleteTopicRecords(deleteTopicRecordsOptions)
+			Expect(operationErr).To(BeNil())
+
+			adminrestService.SetOperationTimeouts(common.OperationTimeouts{LongRunning: 50 * time.Millisecond})
+			_, operationErr = adminrestService.DeleteTopicRecords(deleteTopicRecordsOptions)
+			Expect(operationErr).ToNot(BeNil())
+			Expect(operationErr.Error()).To(ContainSubstring("deadline exceeded"))
+		})
+		It(`Stop retrying when the timeout expires`, func() {
+			adminrestService, serviceErr := adminrestv1.NewAdminrestV1(&adminrestv1.AdminrestV1Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+			adminrestService.EnableRetries(5, 5*time.Second)
+			adminrestService.SetOperationTimeouts(common.OperationTimeouts{Read: 200 * time.Millisecond})
+
+			start := time.Now()
+			_, _, operationErr := adminrestService.GetTopic(adminrestService.NewGetTopicOptions("unavailable"))
+			Expect(operationErr).ToNot(BeNil())
+			// Without the timeout, the retries would wait for more than 10 seconds
+			Expect(time.Since(start)).To(BeNumerically("<", 3*time.Second))
+			Expect(requests.Load()).To(BeNumerically("<", int32(6)))
+		})
+		AfterEach(func() {
+			testServer.Close()
+		})
+	})
 	Describe(`CreateTopic(createTopicOptions *CreateTopicOptions)`, func() {
 		createTopicPath := "/admin/topics"
 		Context(`Using mock server endpoint`, func() {
@@ -0,0 +1,354 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package adminrestv1
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	common "github.com/IBM/eventstreams-go-sdk/pkg/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// GetBrokerConfigInventoryOptions : The GetBrokerConfigInventory options.
+type GetBrokerConfigInventoryOptions struct {
+	// A filter applied to the config names, as for GetBrokerConfig.
+	ConfigFilter *string
+
+	// When true, the source of every config and whether it is dynamic are included.
+	Verbose *bool
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewGetBrokerConfigInventoryOptions : Instantiate GetBrokerConfigInventoryOptions
+func (*AdminrestV1) NewGetBrokerConfigInventoryOptions() *GetBrokerConfigInventoryOptions {
+	return &GetBrokerConfigInventoryOptions{}
+}
+
+// SetConfigFilter : Allow user to set ConfigFilter
+func (_options *GetBrokerConfigInventoryOptions) SetConfigFilter(configFilter string) *GetBrokerConfigInventoryOptions {
+	_options.ConfigFilter = core.StringPtr(configFilter)
+	return _options
+}
+
+// SetVerbose : Allow user to set Verbose
+func (_options *GetBrokerConfigInventoryOptions) SetVerbose(verbose bool) *GetBrokerConfigInventoryOptions {
+	_options.Verbose = core.BoolPtr(verbose)
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *GetBrokerConfigInventoryOptions) SetHeaders(param map[string]string) *GetBrokerConfigInventoryOptions {
+	options.Headers = param
+	return options
+}
+
+// BrokerConfigValue : The value of a config property on one broker.
+type BrokerConfigValue struct {
+	// The ID of the broker.
+	BrokerID int64 `json:"broker_id"`
+
+	// The value with surrounding whitespace removed, nil when the value is sensitive, not set or missing.
+	Value *string `json:"value,omitempty"`
+
+	// Whether the value is sensitive and hidden by the broker.
+	Sensitive bool `json:"sensitive,omitempty"`
+
+	// Whether the broker does not report the config property at all.
+	Missing bool `json:"missing,omitempty"`
+
+	// The source of the value, such as STATIC_BROKER_CONFIG or DYNAMIC_BROKER_CONFIG. Only set in verbose mode.
+	Source string `json:"source,omitempty"`
+
+	// Whether the value was set dynamically. Only set in verbose mode.
+	Dynamic bool `json:"dynamic,omitempty"`
+}
+
+// BrokerConfigEntry : A config property across all brokers.
+type BrokerConfigEntry struct {
+	// The name of the config property.
+	Name string `json:"name"`
+
+	// The value on every broker, ordered by broker ID.
+	Values []BrokerConfigValue `json:"values"`
+
+	// Whether the value differs between brokers. Sensitive values cannot be compared, so only the values of the
+	// brokers where the property is not sensitive are compared with each other.
+	Differs bool `json:"differs"`
+
+	// Whether the value is sensitive on any broker.
+	Sensitive bool `json:"sensitive"`
+}
+
+// BrokerConfigInventory : The configs of every broker of a cluster.
+type BrokerConfigInventory struct {
+	// The IDs of the brokers, sorted.
+	Brokers []int64 `json:"brokers"`
+
+	// Whether the inventory includes sources and dynamic flags.
+	Verbose bool `json:"verbose"`
+
+	// The config properties, sorted by name.
+	Configs []BrokerConfigEntry `json:"configs"`
+
+	// The names of the config properties whose values differ between brokers.
+	Differences []string `json:"differences"`
+
+	// The names of the config properties whose values are sensitive and hidden.
+	SensitiveConfigs []string `json:"sensitive_configs"`
+}
+
+// brokerConfigItem is a config property as returned by GetBrokerConfig, including the source that is returned in
+// verbose mode but not described by BrokerDetailConfigsItem.
+type brokerConfigItem struct {
+	Name        *string `json:"name"`
+	Value       *string `json:"value"`
+	IsSensitive *bool   `json:"is_sensitive"`
+	Source      *string `json:"source"`
+}
+
+// GetBrokerConfigInventory : Get the configs of every broker
+// Lists the brokers with ListBrokers and gets the configs of each one concurrently with GetBrokerConfig. The result
+// is deterministic, so that inventories taken at different times or from different instances can be compared with
+// Text or as JSON.
+func (adminrest *AdminrestV1) GetBrokerConfigInventory(getBrokerConfigInventoryOptions *GetBrokerConfigInventoryOptions) (result *BrokerConfigInventory, err error) {
+	result, err = adminrest.GetBrokerConfigInventoryWithContext(context.Background(), getBrokerConfigInventoryOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetBrokerConfigInventoryWithContext is an alternate form of the GetBrokerConfigInventory method which supports a Context parameter
+func (adminrest *AdminrestV1) GetBrokerConfigInventoryWithContext(ctx context.Context, getBrokerConfigInventoryOptions *GetBrokerConfigInventoryOptions) (result *BrokerConfigInventory, err error) {
+	if getBrokerConfigInventoryOptions == nil {
+		getBrokerConfigInventoryOptions = adminrest.NewGetBrokerConfigInventoryOptions()
+	}
+	headers := getBrokerConfigInventoryOptions.Headers
+	verbose := getBrokerConfigInventoryOptions.Verbose != nil && *getBrokerConfigInventoryOptions.Verbose
+
+	brokers, _, err := adminrest.ListBrokersWithContext(ctx, adminrest.NewListBrokersOptions().SetHeaders(headers))
+	if err != nil {
+		err = core.SDKErrorf(err, "", "list-brokers-error", common.GetComponentInfo())
+		return
+	}
+	var brokerIDs []int64
+	for _, broker := range brokers {
+		if broker.ID != nil {
+			brokerIDs = append(brokerIDs, *broker.ID)
+		}
+	}
+
+	configs := make(map[int64][]brokerConfigItem, len(brokerIDs))
+	errs := make([]error, len(brokerIDs))
+	var mutex sync.Mutex
+	var wg sync.WaitGroup
+	for i, brokerID := range brokerIDs {
+		wg.Add(1)
+		go func(i int, brokerID int64) {
+			defer wg.Done()
+			getBrokerConfigOptions := adminrest.NewGetBrokerConfigOptions(brokerID).SetHeaders(headers)
+			getBrokerConfigOptions.ConfigFilter = getBrokerConfigInventoryOptions.ConfigFilter
+			getBrokerConfigOptions.Verbose = getBrokerConfigInventoryOptions.Verbose
+			items, itemsErr := adminrest.getBrokerConfigItems(ctx, getBrokerConfigOptions)
+			if itemsErr != nil {
+				errs[i] = core.SDKErrorf(itemsErr, fmt.Sprintf("failed to get configs of broker %d", brokerID), "get-broker-config-error", common.GetComponentInfo())
+				return
+			}
+			mutex.Lock()
+			configs[brokerID] = items
+			mutex.Unlock()
+		}(i, brokerID)
+	}
+	wg.Wait()
+	for _, brokerErr := range errs {
+		if brokerErr != nil {
+			err = brokerErr
+			return
+		}
+	}
+
+	result = buildBrokerConfigInventory(configs, verbose)
+	return
+}
+
+// getBrokerConfigItems performs the GetBrokerConfig request, decoding the config properties with their source.
+func (adminrest *AdminrestV1) getBrokerConfigItems(ctx context.Context, getBrokerConfigOptions *GetBrokerConfigOptions) (items []brokerConfigItem, err error) {
+	ctx, cancel := adminrest.operationContext(ctx, common.OperationClassRead)
+	defer cancel()
+
+	pathParamsMap := map[string]string{
+		"broker_id": fmt.Sprint(*getBrokerConfigOptions.BrokerID),
+	}
+
+	builder := core.NewRequestBuilder(core.GET)
+	builder = builder.WithContext(ctx)
+	builder.EnableGzipCompression = adminrest.GetEnableGzipCompression()
+	_, err = builder.ResolveRequestURL(adminrest.Service.Options.URL, `/admin/brokers/{broker_id}/configs`, pathParamsMap)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "url-resolve-error", common.GetComponentInfo())
+		return
+	}
+
+	for headerName, headerValue := range getBrokerConfigOptions.Headers {
+		builder.AddHeader(headerName, headerValue)
+	}
+
+	sdkHeaders := common.GetSdkHeaders("adminrest", "V1", "GetBrokerConfig")
+	for headerName, headerValue := range sdkHeaders {
+		builder.AddHeader(headerName, headerValue)
+	}
+	builder.AddHeader("Accept", "application/json")
+
+	if getBrokerConfigOptions.ConfigFilter != nil {
+		builder.AddQuery("config_filter", fmt.Sprint(*getBrokerConfigOptions.ConfigFilter))
+	}
+	if getBrokerConfigOptions.Verbose != nil {
+		builder.AddQuery("verbose", fmt.Sprint(*getBrokerConfigOptions.Verbose))
+	}
+
+	request, err := builder.Build()
+	if err != nil {
+		err = core.SDKErrorf(err, "", "build-error", common.GetComponentInfo())
+		return
+	}
+
+	var detail struct {
+		Configs []brokerConfigItem `json:"configs"`
+	}
+	_, err = adminrest.Service.Request(request, &detail)
+	if err != nil {
+		core.EnrichHTTPProblem(err, "GetBrokerConfig", getServiceComponentInfo())
+		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		return
+	}
+	items = detail.Configs
+	return
+}
+
+func buildBrokerConfigInventory(configs map[int64][]brokerConfigItem, verbose bool) *BrokerConfigInventory {
+	inventory := &BrokerConfigInventory{
+		Brokers:          []int64{},
+		Verbose:          verbose,
+		Configs:          []BrokerConfigEntry{},
+		Differences:      []string{},
+		SensitiveConfigs: []string{},
+	}
+	byBroker := make(map[int64]map[string]brokerConfigItem, len(configs))
+	names := make(map[string]bool)
+	for brokerID, items := range configs {
+		inventory.Brokers = append(inventory.Brokers, brokerID)
+		byName := make(map[string]brokerConfigItem, len(items))
+		for _, item := range items {
+			if item.Name != nil {
+				byName[*item.Name] = item
+				names[*item.Name] = true
+			}
+		}
+		byBroker[brokerID] = byName
+	}
+	sort.Slice(inventory.Brokers, func(i, j int) bool { return inventory.Brokers[i] < inventory.Brokers[j] })
+	sortedNames := make([]string, 0, len(names))
+	for name := range names {
+		sortedNames = append(sortedNames, name)
+	}
+	sort.Strings(sortedNames)
+
+	for _, name := range sortedNames {
+		entry := BrokerConfigEntry{Name: name, Values: []BrokerConfigValue{}}
+		for _, brokerID := range inventory.Brokers {
+			value := BrokerConfigValue{BrokerID: brokerID}
+			item, ok := byBroker[brokerID][name]
+			switch {
+			case !ok:
+				value.Missing = true
+			case item.IsSensitive != nil && *item.IsSensitive:
+				value.Sensitive = true
+				entry.Sensitive = true
+			case item.Value != nil:
+				value.Value = core.StringPtr(strings.TrimSpace(*item.Value))
+			}
+			if ok && verbose && item.Source != nil {
+				value.Source = *item.Source
+				value.Dynamic = strings.HasPrefix(value.Source, "DYNAMIC_")
+			}
+			entry.Values = append(entry.Values, value)
+		}
+		distinct := make(map[string]bool)
+		for _, value := range entry.Values {
+			if !value.Sensitive {
+				distinct[formatBrokerConfigValue(value, false)] = true
+			}
+		}
+		entry.Differs = len(distinct) > 1
+		if entry.Differs {
+			inventory.Differences = append(inventory.Differences, name)
+		}
+		if entry.Sensitive {
+			inventory.SensitiveConfigs = append(inventory.SensitiveConfigs, name)
+		}
+		inventory.Configs = append(inventory.Configs, entry)
+	}
+	return inventory
+}
+
+// Text renders the inventory with one line per config property, sorted by name, so that inventories can be compared
+// with a line-based diff. A property whose value, source and dynamic flag are the same on every broker is written once
+// as `name = value`; otherwise there is one line per broker, written as `name[broker] = value`. Sensitive values are
+// written as <sensitive>, values that are not set as <null> and missing properties as <missing>. In verbose mode, the
+// source and dynamic flag follow the value.
+func (inventory *BrokerConfigInventory) Text() string {
+	var b strings.Builder
+	for _, entry := range inventory.Configs {
+		same := true
+		for _, value := range entry.Values[1:] {
+			if formatBrokerConfigValue(value, inventory.Verbose) != formatBrokerConfigValue(entry.Values[0], inventory.Verbose) {
+				same = false
+				break
+			}
+		}
+		if same {
+			fmt.Fprintf(&b, "%s = %s\n", entry.Name, formatBrokerConfigValue(entry.Values[0], inventory.Verbose))
+			continue
+		}
+		for _, value := range entry.Values {
+			fmt.Fprintf(&b, "%s[%d] = %s\n", entry.Name, value.BrokerID, formatBrokerConfigValue(value, inventory.Verbose))
+		}
+	}
+	return b.String()
+}
+
+func formatBrokerConfigValue(value BrokerConfigValue, verbose bool) string {
+	var text string
+	switch {
+	case value.Missing:
+		return "<missing>"
+	case value.Sensitive:
+		text = "<sensitive>"
+	case value.Value == nil:
+		text = "<null>"
+	default:
+		text = *value.Value
+	}
+	if verbose {
+		text += fmt.Sprintf(" (source=%s, dynamic=%t)", value.Source, value.Dynamic)
+	}
+	return text
+}
@@ -0,0 +1,126 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package adminrestv1_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/eventstreams-go-sdk/pkg/adminrestv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`GetBrokerConfigInventory(getBrokerConfigInventoryOptions *GetBrokerConfigInventoryOptions)`, func() {
+	var testServer *httptest.Server
+	var adminrestService *adminrestv1.AdminrestV1
+
+	BeforeEach(func() {
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			res.Header().Set("Content-type", "application/json")
+			switch req.URL.EscapedPath() {
+			case "/admin/brokers":
+				res.WriteHeader(200)
+				fmt.Fprintf(res, "%s", `[{"id": 1}, {"id": 0}, {"id": 2}]`)
+			case "/admin/brokers/0/configs", "/admin/brokers/1/configs":
+				// The SASL mechanism is only sensitive on broker 0, which must not hide the difference between 1 and 2
+				mechanism := `{"name": "sasl.mechanism", "value": null, "is_sensitive": true, "source": "STATIC_BROKER_CONFIG"}`
+				if req.URL.EscapedPath() == "/admin/brokers/1/configs" {
+					mechanism = `{"name": "sasl.mechanism", "value": "PLAIN", "is_sensitive": false, "source": "STATIC_BROKER_CONFIG"}`
+				}
+				res.WriteHeader(200)
+				fmt.Fprintf(res, `{"configs": [
+					%s,
+					{"name": "num.io.threads", "value": "8", "is_sensitive": false, "source": "STATIC_BROKER_CONFIG"},
+					{"name": "log.retention.hours", "value": " 168 ", "is_sensitive": false, "source": "DEFAULT_CONFIG"},
+					{"name": "ssl.keystore.password", "value": null, "is_sensitive": true, "source": "STATIC_BROKER_CONFIG"}
+				]}`, mechanism)
+			case "/admin/brokers/2/configs":
+				if req.URL.Query().Get("config_filter") == "broken*" {
+					res.WriteHeader(500)
+					return
+				}
+				res.WriteHeader(200)
+				fmt.Fprintf(res, "%s", `{"configs": [
+					{"name": "sasl.mechanism", "value": "SCRAM-SHA-512", "is_sensitive": false, "source": "STATIC_BROKER_CONFIG"},
+					{"name": "num.io.threads", "value": "16", "is_sensitive": false, "source": "DYNAMIC_BROKER_CONFIG"},
+					{"name": "log.retention.hours", "value": "168", "is_sensitive": false, "source": "DEFAULT_CONFIG"},
+					{"name": "ssl.keystore.password", "value": null, "is_sensitive": true, "source": "STATIC_BROKER_CONFIG"},
+					{"name": "message.max.bytes", "value": "1048588", "is_sensitive": false, "source": "DEFAULT_CONFIG"}
+				]}`)
+			default:
+				res.WriteHeader(404)
+			}
+		}))
+		var serviceErr error
+		adminrestService, serviceErr = adminrestv1.NewAdminrestV1(&adminrestv1.AdminrestV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Invoke GetBrokerConfigInventory successfully`, func() {
+		_, err := adminrestService.GetBrokerConfigInventory(adminrestService.NewGetBrokerConfigInventoryOptions().SetConfigFilter("broken*"))
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("failed to get configs of broker 2"))
+
+		inventory, err := adminrestService.GetBrokerConfigInventory(nil)
+		Expect(err).To(BeNil())
+		Expect(inventory.Brokers).To(Equal([]int64{0, 1, 2}))
+		Expect(inventory.Verbose).To(BeFalse())
+		Expect(inventory.Differences).To(Equal([]string{"message.max.bytes", "num.io.threads", "sasl.mechanism"}))
+		Expect(inventory.SensitiveConfigs).To(Equal([]string{"sasl.mechanism", "ssl.keystore.password"}))
+		Expect(inventory.Configs).To(HaveLen(5))
+		Expect(inventory.Configs[0].Name).To(Equal("log.retention.hours"))
+		Expect(inventory.Configs[0].Differs).To(BeFalse())
+		Expect(inventory.Configs[1].Values[0]).To(Equal(adminrestv1.BrokerConfigValue{BrokerID: 0, Missing: true}))
+		Expect(inventory.Configs[2].Values[2]).To(Equal(adminrestv1.BrokerConfigValue{BrokerID: 2, Value: core.StringPtr("16")}))
+
+		Expect(inventory.Text()).To(Equal("log.retention.hours = 168\n" +
+			"message.max.bytes[0] = <missing>\n" +
+			"message.max.bytes[1] = <missing>\n" +
+			"message.max.bytes[2] = 1048588\n" +
+			"num.io.threads[0] = 8\n" +
+			"num.io.threads[1] = 8\n" +
+			"num.io.threads[2] = 16\n" +
+			"sasl.mechanism[0] = <sensitive>\n" +
+			"sasl.mechanism[1] = PLAIN\n" +
+			"sasl.mechanism[2] = SCRAM-SHA-512\n" +
+			"ssl.keystore.password = <sensitive>\n"))
+	})
+	It(`Invoke GetBrokerConfigInventory in verbose mode`, func() {
+		inventory, err := adminrestService.GetBrokerConfigInventory(adminrestService.NewGetBrokerConfigInventoryOptions().SetVerbose(true))
+		Expect(err).To(BeNil())
+		Expect(inventory.Verbose).To(BeTrue())
+		Expect(inventory.Configs[2].Values[2]).To(Equal(adminrestv1.BrokerConfigValue{
+			BrokerID: 2,
+			Value:    core.StringPtr("16"),
+			Source:   "DYNAMIC_BROKER_CONFIG",
+			Dynamic:  true,
+		}))
+		Expect(inventory.Text()).To(ContainSubstring("log.retention.hours = 168 (source=DEFAULT_CONFIG, dynamic=false)\n"))
+		Expect(inventory.Text()).To(ContainSubstring("num.io.threads[2] = 16 (source=DYNAMIC_BROKER_CONFIG, dynamic=true)\n"))
+	})
+})
@@ -0,0 +1,526 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package adminrestv1
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	common "github.com/IBM/eventstreams-go-sdk/pkg/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// GetClusterTopologyOptions : The GetClusterTopology options.
+type GetClusterTopologyOptions struct {
+	// A filter applied to the topic names, as for ListTopics. When not set, all topics are included.
+	TopicFilter *string
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewGetClusterTopologyOptions : Instantiate GetClusterTopologyOptions
+func (*AdminrestV1) NewGetClusterTopologyOptions() *GetClusterTopologyOptions {
+	return &GetClusterTopologyOptions{}
+}
+
+// SetTopicFilter : Allow user to set TopicFilter
+func (_options *GetClusterTopologyOptions) SetTopicFilter(topicFilter string) *GetClusterTopologyOptions {
+	_options.TopicFilter = core.StringPtr(topicFilter)
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *GetClusterTopologyOptions) SetHeaders(param map[string]string) *GetClusterTopologyOptions {
+	options.Headers = param
+	return options
+}
+
+// TopologyBroker : A broker of the cluster.
+type TopologyBroker struct {
+	// The ID of the broker.
+	ID int64 `json:"id"`
+
+	// The hostname that the broker is listening on.
+	Host string `json:"host,omitempty"`
+
+	// The port that the broker is listening on.
+	Port int64 `json:"port,omitempty"`
+
+	// The rack of the broker, empty if the broker has no 'broker.rack' config property.
+	Rack string `json:"rack,omitempty"`
+}
+
+// TopologyPartition : The placement of a partition.
+type TopologyPartition struct {
+	// The ID of the partition.
+	ID int64 `json:"id"`
+
+	// The IDs of the brokers hosting the replicas of the partition. The first replica is the preferred leader.
+	Replicas []int64 `json:"replicas"`
+}
+
+// TopologyTopic : The placement of the partitions of a topic.
+type TopologyTopic struct {
+	// The name of the topic.
+	Name string `json:"name"`
+
+	// The partitions of the topic, ordered by ID.
+	Partitions []TopologyPartition `json:"partitions"`
+}
+
+// ClusterTopology : The brokers of a cluster and the placement of the replicas of its topics.
+type ClusterTopology struct {
+	// The ID of the cluster.
+	ClusterID string `json:"cluster_id,omitempty"`
+
+	// The ID of the controller broker, nil if the cluster has not reported one.
+	ControllerID *int64 `json:"controller_id,omitempty"`
+
+	// The brokers of the cluster, ordered by ID.
+	Brokers []TopologyBroker `json:"brokers"`
+
+	// The topics, ordered by name.
+	Topics []TopologyTopic `json:"topics"`
+}
+
+// BrokerLoad : The replicas hosted by a broker.
+type BrokerLoad struct {
+	// The ID of the broker.
+	BrokerID int64 `json:"broker_id"`
+
+	// The rack of the broker.
+	Rack string `json:"rack,omitempty"`
+
+	// The number of partitions whose preferred leader is the broker.
+	Leaders int64 `json:"leaders"`
+
+	// The number of partition replicas hosted by the broker.
+	Replicas int64 `json:"replicas"`
+
+	// Whether the broker is referenced by a replica assignment but not reported as part of the cluster.
+	Unknown bool `json:"unknown,omitempty"`
+}
+
+// LoadSkew : Statistics on how evenly a count is spread over the brokers.
+type LoadSkew struct {
+	// The lowest count of a broker.
+	Min int64 `json:"min"`
+
+	// The highest count of a broker.
+	Max int64 `json:"max"`
+
+	// The mean count per broker.
+	Mean float64 `json:"mean"`
+
+	// The highest count divided by the mean, 1 for a perfectly even spread and 0 when there is nothing to spread.
+	Ratio float64 `json:"ratio"`
+}
+
+// RackCollision : A partition with replicas sharing a rack although enough racks are available to separate them.
+type RackCollision struct {
+	// The name of the topic.
+	Topic string `json:"topic"`
+
+	// The ID of the partition.
+	Partition int64 `json:"partition"`
+
+	// The IDs of the brokers hosting the replicas of the partition.
+	Replicas []int64 `json:"replicas"`
+
+	// The racks shared by more than one replica, sorted.
+	SharedRacks []string `json:"shared_racks"`
+}
+
+// TopicSpread : A topic whose partitions are placed on fewer brokers than they could be.
+type TopicSpread struct {
+	// The name of the topic.
+	Topic string `json:"topic"`
+
+	// The number of distinct brokers hosting replicas of the topic.
+	ReplicaBrokers int64 `json:"replica_brokers"`
+
+	// The number of distinct brokers that are preferred leaders of partitions of the topic.
+	LeaderBrokers int64 `json:"leader_brokers"`
+
+	// The number of brokers the replicas could be spread over: the number of replicas, capped by the number of brokers.
+	AvailableBrokers int64 `json:"available_brokers"`
+}
+
+// TopologyReport : An analysis of the replica placement of a cluster.
+type TopologyReport struct {
+	// The analysed topology.
+	Topology *ClusterTopology `json:"topology"`
+
+	// The load of every broker, ordered by broker ID.
+	Brokers []BrokerLoad `json:"brokers"`
+
+	// How evenly preferred leaders are spread over the brokers of the cluster.
+	LeaderSkew LoadSkew `json:"leader_skew"`
+
+	// How evenly replicas are spread over the brokers of the cluster.
+	ReplicaSkew LoadSkew `json:"replica_skew"`
+
+	// The partitions whose replicas share a rack although enough racks are available to separate them.
+	RackCollisions []RackCollision `json:"rack_collisions"`
+
+	// The topics whose replicas or preferred leaders are placed on fewer brokers than they could be.
+	UnderSpreadTopics []TopicSpread `json:"under_spread_topics"`
+}
+
+// GetClusterTopology : Get the topology of the cluster
+// Reads the brokers and controller with GetCluster, the rack of brokers whose summary has none with GetBroker, and the
+// replica assignments of the topics from every page of ListTopics, falling back to GetTopic for topics listed without
+// them.
+func (adminrest *AdminrestV1) GetClusterTopology(getClusterTopologyOptions *GetClusterTopologyOptions) (result *ClusterTopology, err error) {
+	result, err = adminrest.GetClusterTopologyWithContext(context.Background(), getClusterTopologyOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetClusterTopologyWithContext is an alternate form of the GetClusterTopology method which supports a Context parameter
+func (adminrest *AdminrestV1) GetClusterTopologyWithContext(ctx context.Context, getClusterTopologyOptions *GetClusterTopologyOptions) (result *ClusterTopology, err error) {
+	if getClusterTopologyOptions == nil {
+		getClusterTopologyOptions = adminrest.NewGetClusterTopologyOptions()
+	}
+	headers := getClusterTopologyOptions.Headers
+
+	cluster, _, err := adminrest.GetClusterWithContext(ctx, adminrest.NewGetClusterOptions().SetHeaders(headers))
+	if err != nil {
+		err = core.SDKErrorf(err, "", "get-cluster-error", common.GetComponentInfo())
+		return
+	}
+	result = &ClusterTopology{Brokers: []TopologyBroker{}, Topics: []TopologyTopic{}}
+	if cluster.ID != nil {
+		result.ClusterID = *cluster.ID
+	}
+	if cluster.Controller != nil && cluster.Controller.ID != nil {
+		result.ControllerID = core.Int64Ptr(*cluster.Controller.ID)
+	}
+	for _, summary := range cluster.Brokers {
+		if summary.ID == nil {
+			continue
+		}
+		broker := TopologyBroker{ID: *summary.ID, Host: stringValue(summary.Host), Port: int64Value(summary.Port), Rack: stringValue(summary.Rack)}
+		if summary.Rack == nil {
+			var detail *BrokerDetail
+			detail, _, err = adminrest.GetBrokerWithContext(ctx, adminrest.NewGetBrokerOptions(broker.ID).SetHeaders(headers))
+			if err != nil {
+				err = core.SDKErrorf(err, fmt.Sprintf("failed to get broker %d", broker.ID), "get-broker-error", common.GetComponentInfo())
+				return
+			}
+			broker.Rack = stringValue(detail.Rack)
+		}
+		result.Brokers = append(result.Brokers, broker)
+	}
+	sort.Slice(result.Brokers, func(i, j int) bool { return result.Brokers[i].ID < result.Brokers[j].ID })
+
+	topics, err := adminrest.topicDetails(ctx, getClusterTopologyOptions.TopicFilter, headers)
+	if err != nil {
+		return
+	}
+	for i := range topics {
+		topic := &topics[i]
+		if topic.Name == nil {
+			continue
+		}
+		if len(topic.ReplicaAssignments) == 0 && int64Value(topic.Partitions) > 0 {
+			topic, _, err = adminrest.GetTopicWithContext(ctx, adminrest.NewGetTopicOptions(*topic.Name).SetHeaders(headers))
+			if err != nil {
+				err = core.SDKErrorf(err, fmt.Sprintf("failed to get topic '%s'", *topics[i].Name), "get-topic-error", common.GetComponentInfo())
+				return
+			}
+		}
+		result.Topics = append(result.Topics, topologyTopic(topic))
+	}
+	sort.Slice(result.Topics, func(i, j int) bool { return result.Topics[i].Name < result.Topics[j].Name })
+	return
+}
+
+// GetTopologyReport : Analyse the replica placement of the cluster
+// Gets the topology of the cluster with GetClusterTopology and analyses it with AnalyzeTopology.
+func (adminrest *AdminrestV1) GetTopologyReport(getClusterTopologyOptions *GetClusterTopologyOptions) (result *TopologyReport, err error) {
+	result, err = adminrest.GetTopologyReportWithContext(context.Background(), getClusterTopologyOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetTopologyReportWithContext is an alternate form of the GetTopologyReport method which supports a Context parameter
+func (adminrest *AdminrestV1) GetTopologyReportWithContext(ctx context.Context, getClusterTopologyOptions *GetClusterTopologyOptions) (result *TopologyReport, err error) {
+	topology, err := adminrest.GetClusterTopologyWithContext(ctx, getClusterTopologyOptions)
+	if err != nil {
+		return
+	}
+	result = AnalyzeTopology(topology)
+	return
+}
+
+func topologyTopic(topic *TopicDetail) TopologyTopic {
+	result := TopologyTopic{Name: *topic.Name, Partitions: []TopologyPartition{}}
+	for _, assignment := range topic.ReplicaAssignments {
+		partition := TopologyPartition{ID: int64Value(assignment.ID), Replicas: []int64{}}
+		if assignment.Brokers != nil {
+			partition.Replicas = append(partition.Replicas, assignment.Brokers.Replicas...)
+		}
+		result.Partitions = append(result.Partitions, partition)
+	}
+	sort.Slice(result.Partitions, func(i, j int) bool { return result.Partitions[i].ID < result.Partitions[j].ID })
+	return result
+}
+
+// AnalyzeTopology computes the load of every broker, the leader and replica skew, the rack collisions and the
+// under-spread topics of a topology. Preferred leaders are used as leaders, since the admin API does not report the
+// current leader of a partition.
+//
+// A partition is reported as a rack collision when two of its replicas are on brokers with the same rack while the
+// cluster has enough racks to place every replica on a different one; brokers without a rack are ignored. A topic is
+// under-spread when its replicas, or its preferred leaders, are on fewer brokers than the number of replicas, or of
+// partitions, capped by the number of brokers.
+func AnalyzeTopology(topology *ClusterTopology) (report *TopologyReport) {
+	report = &TopologyReport{
+		Topology:          topology,
+		Brokers:           []BrokerLoad{},
+		RackCollisions:    []RackCollision{},
+		UnderSpreadTopics: []TopicSpread{},
+	}
+
+	loads := make(map[int64]*BrokerLoad, len(topology.Brokers))
+	racks := make(map[int64]string, len(topology.Brokers))
+	clusterRacks := make(map[string]bool)
+	for _, broker := range topology.Brokers {
+		loads[broker.ID] = &BrokerLoad{BrokerID: broker.ID, Rack: broker.Rack}
+		racks[broker.ID] = broker.Rack
+		if broker.Rack != "" {
+			clusterRacks[broker.Rack] = true
+		}
+	}
+	load := func(brokerID int64) *BrokerLoad {
+		if loads[brokerID] == nil {
+			loads[brokerID] = &BrokerLoad{BrokerID: brokerID, Unknown: true}
+		}
+		return loads[brokerID]
+	}
+
+	for _, topic := range topology.Topics {
+		replicaBrokers := make(map[int64]bool)
+		leaderBrokers := make(map[int64]bool)
+		var replicaCount int64
+		for _, partition := range topic.Partitions {
+			if len(partition.Replicas) > 0 {
+				load(partition.Replicas[0]).Leaders++
+				leaderBrokers[partition.Replicas[0]] = true
+			}
+			rackReplicas := make(map[string]int)
+			for _, brokerID := range partition.Replicas {
+				load(brokerID).Replicas++
+				replicaBrokers[brokerID] = true
+				replicaCount++
+				if rack := racks[brokerID]; rack != "" {
+					rackReplicas[rack]++
+				}
+			}
+			if collision := rackCollision(topic.Name, partition, rackReplicas, len(clusterRacks)); collision != nil {
+				report.RackCollisions = append(report.RackCollisions, *collision)
+			}
+		}
+
+		brokerCount := int64(len(topology.Brokers))
+		spread := TopicSpread{
+			Topic:            topic.Name,
+			ReplicaBrokers:   int64(len(replicaBrokers)),
+			LeaderBrokers:    int64(len(leaderBrokers)),
+			AvailableBrokers: minInt64(replicaCount, brokerCount),
+		}
+		if spread.ReplicaBrokers < spread.AvailableBrokers || spread.LeaderBrokers < minInt64(int64(len(topic.Partitions)), brokerCount) {
+			report.UnderSpreadTopics = append(report.UnderSpreadTopics, spread)
+		}
+	}
+
+	for _, brokerLoad := range loads {
+		report.Brokers = append(report.Brokers, *brokerLoad)
+	}
+	sort.Slice(report.Brokers, func(i, j int) bool { return report.Brokers[i].BrokerID < report.Brokers[j].BrokerID })
+
+	var leaders, replicas []int64
+	for _, brokerLoad := range report.Brokers {
+		if !brokerLoad.Unknown {
+			leaders = append(leaders, brokerLoad.Leaders)
+			replicas = append(replicas, brokerLoad.Replicas)
+		}
+	}
+	report.LeaderSkew = loadSkew(leaders)
+	report.ReplicaSkew = loadSkew(replicas)
+	return
+}
+
+func rackCollision(topic string, partition TopologyPartition, rackReplicas map[string]int, clusterRacks int) *RackCollision {
+	racked := 0
+	var shared []string
+	for rack, count := range rackReplicas {
+		racked += count
+		if count > 1 {
+			shared = append(shared, rack)
+		}
+	}
+	if len(shared) == 0 || clusterRacks < racked {
+		return nil
+	}
+	sort.Strings(shared)
+	return &RackCollision{Topic: topic, Partition: partition.ID, Replicas: partition.Replicas, SharedRacks: shared}
+}
+
+func loadSkew(counts []int64) (skew LoadSkew) {
+	if len(counts) == 0 {
+		return
+	}
+	skew.Min, skew.Max = counts[0], counts[0]
+	var total int64
+	for _, count := range counts {
+		total += count
+		if count < skew.Min {
+			skew.Min = count
+		}
+		if count > skew.Max {
+			skew.Max = count
+		}
+	}
+	skew.Mean = float64(total) / float64(len(counts))
+	if skew.Mean > 0 {
+		skew.Ratio = float64(skew.Max) / skew.Mean
+	}
+	return
+}
+
+func minInt64(a, b int64) int64 {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+// Text renders the report as plain text, with a table of broker loads followed by the problems found.
+func (report *TopologyReport) Text() string {
+	var b strings.Builder
+	topology := report.Topology
+	fmt.Fprintf(&b, "Cluster %s: %d brokers, %d topics", topology.ClusterID, len(topology.Brokers), len(topology.Topics))
+	if topology.ControllerID != nil {
+		fmt.Fprintf(&b, ", controller %d", *topology.ControllerID)
+	}
+	b.WriteString("\n\n")
+
+	fmt.Fprintf(&b, "%-8s %-12s %8s %8s\n", "BROKER", "RACK", "LEADERS", "REPLICAS")
+	for _, brokerLoad := range report.Brokers {
+		rack := brokerLoad.Rack
+		if brokerLoad.Unknown {
+			rack = "(unknown)"
+		} else if rack == "" {
+			rack = "-"
+		}
+		fmt.Fprintf(&b, "%-8d %-12s %8d %8d\n", brokerLoad.BrokerID, rack, brokerLoad.Leaders, brokerLoad.Replicas)
+	}
+	b.WriteString("\n")
+	fmt.Fprintf(&b, "Leader skew: min %d, max %d, mean %.2f, ratio %.2f\n", report.LeaderSkew.Min, report.LeaderSkew.Max, report.LeaderSkew.Mean, report.LeaderSkew.Ratio)
+	fmt.Fprintf(&b, "Replica skew: min %d, max %d, mean %.2f, ratio %.2f\n", report.ReplicaSkew.Min, report.ReplicaSkew.Max, report.ReplicaSkew.Mean, report.ReplicaSkew.Ratio)
+
+	fmt.Fprintf(&b, "\nRack collisions: %d\n", len(report.RackCollisions))
+	for _, collision := range report.RackCollisions {
+		fmt.Fprintf(&b, "  %s-%d replicas %s share rack %s\n", collision.Topic, collision.Partition, formatBrokerIDs(collision.Replicas), strings.Join(collision.SharedRacks, ", "))
+	}
+	fmt.Fprintf(&b, "\nUnder-spread topics: %d\n", len(report.UnderSpreadTopics))
+	for _, spread := range report.UnderSpreadTopics {
+		fmt.Fprintf(&b, "  %s replicas on %d of %d brokers, leaders on %d\n", spread.Topic, spread.ReplicaBrokers, spread.AvailableBrokers, spread.LeaderBrokers)
+	}
+	return b.String()
+}
+
+// DOT renders the topology as a Graphviz graph. Brokers are grouped by rack and the controller is drawn with a double
+// outline; each topic is linked to the brokers hosting its replicas, labelled with the number of replicas and of
+// preferred leaders.
+func (report *TopologyReport) DOT() string {
+	var b strings.Builder
+	b.WriteString("digraph topology {\n")
+	b.WriteString("  rankdir=LR;\n")
+	b.WriteString("  node [shape=box];\n")
+
+	byRack := make(map[string][]BrokerLoad)
+	var rackNames []string
+	for _, brokerLoad := range report.Brokers {
+		if _, ok := byRack[brokerLoad.Rack]; !ok {
+			rackNames = append(rackNames, brokerLoad.Rack)
+		}
+		byRack[brokerLoad.Rack] = append(byRack[brokerLoad.Rack], brokerLoad)
+	}
+	sort.Strings(rackNames)
+	controller := report.Topology.ControllerID
+	for i, rack := range rackNames {
+		indent := "  "
+		if rack != "" {
+			fmt.Fprintf(&b, "  subgraph cluster_%d {\n    label=%s;\n", i, strconv.Quote("rack "+rack))
+			indent = "    "
+		}
+		for _, brokerLoad := range byRack[rack] {
+			attributes := fmt.Sprintf("shape=ellipse, label=%s", strconv.Quote(fmt.Sprintf("broker %d\nleaders %d, replicas %d", brokerLoad.BrokerID, brokerLoad.Leaders, brokerLoad.Replicas)))
+			if controller != nil && *controller == brokerLoad.BrokerID {
+				attributes += ", peripheries=2"
+			}
+			if brokerLoad.Unknown {
+				attributes += ", style=dashed"
+			}
+			fmt.Fprintf(&b, "%s%s [%s];\n", indent, strconv.Quote(fmt.Sprintf("broker-%d", brokerLoad.BrokerID)), attributes)
+		}
+		if rack != "" {
+			b.WriteString("  }\n")
+		}
+	}
+
+	for _, topic := range report.Topology.Topics {
+		topicNode := strconv.Quote("topic-" + topic.Name)
+		fmt.Fprintf(&b, "  %s [label=%s];\n", topicNode, strconv.Quote(topic.Name))
+		replicas := make(map[int64]int)
+		leaders := make(map[int64]int)
+		var brokerIDs []int64
+		for _, partition := range topic.Partitions {
+			for i, brokerID := range partition.Replicas {
+				if replicas[brokerID] == 0 {
+					brokerIDs = append(brokerIDs, brokerID)
+				}
+				replicas[brokerID]++
+				if i == 0 {
+					leaders[brokerID]++
+				}
+			}
+		}
+		sort.Slice(brokerIDs, func(i, j int) bool { return brokerIDs[i] < brokerIDs[j] })
+		for _, brokerID := range brokerIDs {
+			label := fmt.Sprintf("%d replicas, %d leaders", replicas[brokerID], leaders[brokerID])
+			fmt.Fprintf(&b, "  %s -> %s [label=%s];\n", topicNode, strconv.Quote(fmt.Sprintf("broker-%d", brokerID)), strconv.Quote(label))
+		}
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
+
+func formatBrokerIDs(brokerIDs []int64) string {
+	ids := make([]string, len(brokerIDs))
+	for i, id := range brokerIDs {
+		ids[i] = strconv.FormatInt(id, 10)
+	}
+	return "[" + strings.Join(ids, ",") + "]"
+}
@@ -0,0 +1,153 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package adminrestv1_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/eventstreams-go-sdk/pkg/adminrestv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`GetTopologyReport(getClusterTopologyOptions *GetClusterTopologyOptions)`, func() {
+	var testServer *httptest.Server
+	var adminrestService *adminrestv1.AdminrestV1
+
+	BeforeEach(func() {
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			Expect(req.Method).To(Equal("GET"))
+			res.Header().Set("Content-type", "application/json")
+			if req.URL.EscapedPath() == "/admin/topics" {
+				// The topics are listed on two pages.
+				res.Header().Set("X-Total-Count", "2")
+			}
+			res.WriteHeader(200)
+			switch req.URL.EscapedPath() {
+			case "/admin/cluster":
+				fmt.Fprintf(res, "%s", `{
+					"id": "cluster-1",
+					"controller": {"id": 0},
+					"brokers": [
+						{"id": 2, "host": "kafka-2", "port": 9093},
+						{"id": 0, "host": "kafka-0", "port": 9093, "rack": "a"},
+						{"id": 1, "host": "kafka-1", "port": 9093, "rack": "a"}
+					]
+				}`)
+			case "/admin/brokers/2":
+				fmt.Fprintf(res, "%s", `{"id": 2, "host": "kafka-2", "port": 9093, "rack": "b"}`)
+			case "/admin/topics":
+				if req.URL.Query().Get("page") == "2" {
+					fmt.Fprintf(res, "%s", `[{"name": "logs", "partitions": 2, "replicationFactor": 1}]`)
+					return
+				}
+				fmt.Fprintf(res, "%s", `[
+					{"name": "orders", "partitions": 3, "replicationFactor": 2, "replicaAssignments": [
+						{"id": 2, "brokers": {"replicas": [2, 1]}},
+						{"id": 0, "brokers": {"replicas": [0, 2]}},
+						{"id": 1, "brokers": {"replicas": [1, 0]}}
+					]}
+				]`)
+			case "/admin/topics/logs":
+				fmt.Fprintf(res, "%s", `{"name": "logs", "partitions": 2, "replicationFactor": 1, "replicaAssignments": [
+					{"id": 0, "brokers": {"replicas": [0]}},
+					{"id": 1, "brokers": {"replicas": [0]}}
+				]}`)
+			default:
+				Fail("unexpected request " + req.URL.EscapedPath())
+			}
+		}))
+		var serviceErr error
+		adminrestService, serviceErr = adminrestv1.NewAdminrestV1(&adminrestv1.AdminrestV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Invoke GetClusterTopology successfully`, func() {
+		topology, err := adminrestService.GetClusterTopology(nil)
+		Expect(err).To(BeNil())
+		Expect(topology.ClusterID).To(Equal("cluster-1"))
+		Expect(*topology.ControllerID).To(Equal(int64(0)))
+		Expect(topology.Brokers).To(Equal([]adminrestv1.TopologyBroker{
+			{ID: 0, Host: "kafka-0", Port: 9093, Rack: "a"},
+			{ID: 1, Host: "kafka-1", Port: 9093, Rack: "a"},
+			{ID: 2, Host: "kafka-2", Port: 9093, Rack: "b"},
+		}))
+		Expect(topology.Topics).To(Equal([]adminrestv1.TopologyTopic{
+			{Name: "logs", Partitions: []adminrestv1.TopologyPartition{{ID: 0, Replicas: []int64{0}}, {ID: 1, Replicas: []int64{0}}}},
+			{Name: "orders", Partitions: []adminrestv1.TopologyPartition{
+				{ID: 0, Replicas: []int64{0, 2}},
+				{ID: 1, Replicas: []int64{1, 0}},
+				{ID: 2, Replicas: []int64{2, 1}},
+			}},
+		}))
+	})
+	It(`Invoke GetTopologyReport successfully`, func() {
+		report, err := adminrestService.GetTopologyReport(adminrestService.NewGetClusterTopologyOptions())
+		Expect(err).To(BeNil())
+		Expect(report.Brokers).To(Equal([]adminrestv1.BrokerLoad{
+			{BrokerID: 0, Rack: "a", Leaders: 3, Replicas: 4},
+			{BrokerID: 1, Rack: "a", Leaders: 1, Replicas: 2},
+			{BrokerID: 2, Rack: "b", Leaders: 1, Replicas: 2},
+		}))
+		Expect(report.ReplicaSkew.Min).To(Equal(int64(2)))
+		Expect(report.ReplicaSkew.Max).To(Equal(int64(4)))
+		Expect(report.ReplicaSkew.Ratio).To(BeNumerically("~", 1.5))
+		Expect(report.LeaderSkew.Ratio).To(BeNumerically("~", 1.8))
+		Expect(report.RackCollisions).To(Equal([]adminrestv1.RackCollision{
+			{Topic: "orders", Partition: 1, Replicas: []int64{1, 0}, SharedRacks: []string{"a"}},
+		}))
+		Expect(report.UnderSpreadTopics).To(Equal([]adminrestv1.TopicSpread{
+			{Topic: "logs", ReplicaBrokers: 1, LeaderBrokers: 1, AvailableBrokers: 2},
+		}))
+
+		text := report.Text()
+		Expect(text).To(ContainSubstring("Cluster cluster-1: 3 brokers, 2 topics, controller 0"))
+		Expect(text).To(ContainSubstring("orders-1 replicas [1,0] share rack a"))
+		Expect(text).To(ContainSubstring("logs replicas on 1 of 2 brokers, leaders on 1"))
+
+		dot := report.DOT()
+		Expect(dot).To(HavePrefix("digraph topology {\n"))
+		Expect(dot).To(ContainSubstring(`label="rack a";`))
+		Expect(dot).To(ContainSubstring(`"broker-0" [shape=ellipse, label="broker 0\nleaders 3, replicas 4", peripheries=2];`))
+		Expect(dot).To(ContainSubstring(`"topic-orders" -> "broker-0" [label="2 replicas, 1 leaders"];`))
+	})
+	It(`Analyzes replicas on brokers missing from the cluster`, func() {
+		report := adminrestv1.AnalyzeTopology(&adminrestv1.ClusterTopology{
+			Brokers: []adminrestv1.TopologyBroker{{ID: 0}, {ID: 1}},
+			Topics: []adminrestv1.TopologyTopic{
+				{Name: "t", Partitions: []adminrestv1.TopologyPartition{{ID: 0, Replicas: []int64{0, 5}}, {ID: 1, Replicas: []int64{1, 0}}}},
+			},
+		})
+		Expect(report.Brokers).To(HaveLen(3))
+		Expect(report.Brokers[2]).To(Equal(adminrestv1.BrokerLoad{BrokerID: 5, Replicas: 1, Unknown: true}))
+		Expect(report.ReplicaSkew).To(Equal(adminrestv1.LoadSkew{Min: 1, Max: 2, Mean: 1.5, Ratio: 2 / 1.5}))
+		Expect(report.RackCollisions).To(BeEmpty())
+		Expect(report.UnderSpreadTopics).To(BeEmpty())
+		Expect(report.Text()).To(ContainSubstring("(unknown)"))
+	})
+})
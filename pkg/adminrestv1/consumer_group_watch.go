@@ -0,0 +1,259 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package adminrestv1
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	common "github.com/IBM/eventstreams-go-sdk/pkg/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// DefaultConsumerGroupWatchInterval is the polling interval used by WatchConsumerGroup when none is specified.
+const DefaultConsumerGroupWatchInterval = 5 * time.Second
+
+// Constants associated with the GroupDetail.State property.
+// The state of the consumer group as reported by Kafka.
+const (
+	GroupDetailStateCompletingRebalanceConst = "CompletingRebalance"
+	GroupDetailStateDeadConst                = "Dead"
+	GroupDetailStateEmptyConst               = "Empty"
+	GroupDetailStatePreparingRebalanceConst  = "PreparingRebalance"
+	GroupDetailStateStableConst              = "Stable"
+	GroupDetailStateUnknownConst             = "Unknown"
+)
+
+// Constants associated with the ConsumerGroupEvent.Type property.
+// The kind of change observed by WatchConsumerGroup.
+const (
+	ConsumerGroupEventTypeStateChangedConst      = "state_changed"
+	ConsumerGroupEventTypeMemberJoinedConst      = "member_joined"
+	ConsumerGroupEventTypeMemberLeftConst        = "member_left"
+	ConsumerGroupEventTypeAssignmentChangedConst = "assignment_changed"
+	ConsumerGroupEventTypeErrorConst             = "error"
+)
+
+// ConsumerGroupEvent : A change in the state or membership of a consumer group observed by WatchConsumerGroup.
+type ConsumerGroupEvent struct {
+	// The kind of change, one of the ConsumerGroupEventType constants.
+	Type string
+
+	// The ID of the consumer group.
+	GroupID string
+
+	// The time at which the change was observed.
+	Time time.Time
+
+	// The state of the group before the change. Set for `state_changed` events.
+	PreviousState string
+
+	// The state of the group after the change. Set for `state_changed` events.
+	State string
+
+	// The member that joined, left or had its assignments changed.
+	Member *Member
+
+	// The partitions assigned to the member before the change. Set for `assignment_changed` and `member_left` events.
+	PreviousAssignments []MemberAssignmentsItem
+
+	// The partitions assigned to the member after the change. Set for `assignment_changed` and `member_joined` events.
+	Assignments []MemberAssignmentsItem
+
+	// The error returned while polling the group. Set for `error` events.
+	Err error
+}
+
+// WatchConsumerGroup : Watch a consumer group for state and membership changes
+// Polls GetConsumerGroup every interval and sends an event on the returned channel for every state transition, member
+// join or leave and partition assignment change. Polling errors are reported as `error` events and polling continues.
+// The channel is closed when ctx is done. The initial poll is made before returning so that a missing group or
+// unreachable endpoint is reported as an error.
+func (adminrest *AdminrestV1) WatchConsumerGroup(ctx context.Context, groupID string, interval time.Duration) (events <-chan ConsumerGroupEvent, err error) {
+	if groupID == "" {
+		err = core.SDKErrorf(nil, "groupID must not be empty", "missing-group-id", common.GetComponentInfo())
+		return
+	}
+	if interval <= 0 {
+		interval = DefaultConsumerGroupWatchInterval
+	}
+
+	getConsumerGroupOptions := adminrest.NewGetConsumerGroupOptions(groupID)
+	previous, _, err := adminrest.GetConsumerGroupWithContext(ctx, getConsumerGroupOptions)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "watch-initial-poll-error", common.GetComponentInfo())
+		return
+	}
+
+	channel := make(chan ConsumerGroupEvent, 16)
+	events = channel
+	go func() {
+		defer close(channel)
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+
+			current, _, pollErr := adminrest.GetConsumerGroupWithContext(ctx, getConsumerGroupOptions)
+			if ctx.Err() != nil {
+				return
+			}
+			var changes []ConsumerGroupEvent
+			if pollErr != nil {
+				changes = []ConsumerGroupEvent{{Type: ConsumerGroupEventTypeErrorConst, GroupID: groupID, Err: pollErr}}
+			} else {
+				changes = DiffConsumerGroups(previous, current)
+				previous = current
+			}
+
+			now := time.Now()
+			for _, change := range changes {
+				change.Time = now
+				select {
+				case channel <- change:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return
+}
+
+// DiffConsumerGroups returns the events describing how the consumer group previous became current. Members are
+// matched by ConsumerID. Events are ordered with the state change first, followed by members leaving, joining and
+// changing assignments, each sorted by consumer ID.
+func DiffConsumerGroups(previous *GroupDetail, current *GroupDetail) (events []ConsumerGroupEvent) {
+	if previous == nil {
+		previous = &GroupDetail{}
+	}
+	if current == nil {
+		current = &GroupDetail{}
+	}
+	groupID := stringValue(current.GroupID)
+	if groupID == "" {
+		groupID = stringValue(previous.GroupID)
+	}
+
+	previousState := stringValue(previous.State)
+	currentState := stringValue(current.State)
+	if previousState != currentState {
+		events = append(events, ConsumerGroupEvent{
+			Type:          ConsumerGroupEventTypeStateChangedConst,
+			GroupID:       groupID,
+			PreviousState: previousState,
+			State:         currentState,
+		})
+	}
+
+	before := membersByConsumerID(previous.Members)
+	after := membersByConsumerID(current.Members)
+
+	for _, consumerID := range sortedMemberIDs(before) {
+		if _, ok := after[consumerID]; !ok {
+			member := before[consumerID]
+			events = append(events, ConsumerGroupEvent{
+				Type:                ConsumerGroupEventTypeMemberLeftConst,
+				GroupID:             groupID,
+				Member:              member,
+				PreviousAssignments: member.Assignments,
+			})
+		}
+	}
+	for _, consumerID := range sortedMemberIDs(after) {
+		if _, ok := before[consumerID]; !ok {
+			member := after[consumerID]
+			events = append(events, ConsumerGroupEvent{
+				Type:        ConsumerGroupEventTypeMemberJoinedConst,
+				GroupID:     groupID,
+				Member:      member,
+				Assignments: member.Assignments,
+			})
+		}
+	}
+	for _, consumerID := range sortedMemberIDs(after) {
+		beforeMember, ok := before[consumerID]
+		if !ok {
+			continue
+		}
+		afterMember := after[consumerID]
+		if !sameAssignments(beforeMember.Assignments, afterMember.Assignments) {
+			events = append(events, ConsumerGroupEvent{
+				Type:                ConsumerGroupEventTypeAssignmentChangedConst,
+				GroupID:             groupID,
+				Member:              afterMember,
+				PreviousAssignments: beforeMember.Assignments,
+				Assignments:         afterMember.Assignments,
+			})
+		}
+	}
+	return
+}
+
+func membersByConsumerID(members []Member) map[string]*Member {
+	result := make(map[string]*Member, len(members))
+	for i := range members {
+		result[stringValue(members[i].ConsumerID)] = &members[i]
+	}
+	return result
+}
+
+func sortedMemberIDs(members map[string]*Member) []string {
+	ids := make([]string, 0, len(members))
+	for id := range members {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+func sameAssignments(a, b []MemberAssignmentsItem) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	keys := make(map[string]int, len(a))
+	for _, item := range a {
+		keys[assignmentKey(item)]++
+	}
+	for _, item := range b {
+		key := assignmentKey(item)
+		if keys[key] == 0 {
+			return false
+		}
+		keys[key]--
+	}
+	return true
+}
+
+func assignmentKey(item MemberAssignmentsItem) string {
+	return fmt.Sprintf("%s/%d", stringValue(item.Topic), int64Value(item.Partition))
+}
+
+func stringValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
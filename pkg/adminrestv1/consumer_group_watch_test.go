@@ -0,0 +1,142 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package adminrestv1_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"time"
+
+	"github.com/IBM/eventstreams-go-sdk/pkg/adminrestv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`WatchConsumerGroup(ctx, groupID, interval)`, func() {
+	var testServer *httptest.Server
+	var mutex sync.Mutex
+	var responses []string
+
+	BeforeEach(func() {
+		responses = []string{
+			`{"group_id": "billing", "state": "Stable", "members": [{"consumer_id": "c1", "client_id": "app", "host": "/10.0.0.1", "assignments": [{"topic": "orders", "partition": 0}, {"topic": "orders", "partition": 1}]}]}`,
+			`{"group_id": "billing", "state": "PreparingRebalance", "members": [{"consumer_id": "c1", "client_id": "app", "host": "/10.0.0.1", "assignments": [{"topic": "orders", "partition": 0}, {"topic": "orders", "partition": 1}]}]}`,
+			`{"group_id": "billing", "state": "Stable", "members": [{"consumer_id": "c1", "client_id": "app", "host": "/10.0.0.1", "assignments": [{"topic": "orders", "partition": 0}]}, {"consumer_id": "c2", "client_id": "app", "host": "/10.0.0.2", "assignments": [{"topic": "orders", "partition": 1}]}]}`,
+		}
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			Expect(req.URL.EscapedPath()).To(Equal("/admin/consumergroups/billing"))
+			Expect(req.Method).To(Equal("GET"))
+
+			mutex.Lock()
+			defer mutex.Unlock()
+			body := responses[0]
+			if len(responses) > 1 {
+				responses = responses[1:]
+			}
+			res.Header().Set("Content-type", "application/json")
+			res.WriteHeader(200)
+			fmt.Fprintf(res, "%s", body)
+		}))
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Streams state, membership and assignment changes`, func() {
+		adminrestService, serviceErr := adminrestv1.NewAdminrestV1(&adminrestv1.AdminrestV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		events, err := adminrestService.WatchConsumerGroup(ctx, "billing", 10*time.Millisecond)
+		Expect(err).To(BeNil())
+
+		var event adminrestv1.ConsumerGroupEvent
+		Eventually(events, time.Second).Should(Receive(&event))
+		Expect(event.Type).To(Equal(adminrestv1.ConsumerGroupEventTypeStateChangedConst))
+		Expect(event.PreviousState).To(Equal(adminrestv1.GroupDetailStateStableConst))
+		Expect(event.State).To(Equal(adminrestv1.GroupDetailStatePreparingRebalanceConst))
+		Expect(event.Time.IsZero()).To(BeFalse())
+
+		Eventually(events, time.Second).Should(Receive(&event))
+		Expect(event.Type).To(Equal(adminrestv1.ConsumerGroupEventTypeStateChangedConst))
+		Expect(event.State).To(Equal(adminrestv1.GroupDetailStateStableConst))
+
+		Eventually(events, time.Second).Should(Receive(&event))
+		Expect(event.Type).To(Equal(adminrestv1.ConsumerGroupEventTypeMemberJoinedConst))
+		Expect(*event.Member.ConsumerID).To(Equal("c2"))
+		Expect(*event.Member.Host).To(Equal("/10.0.0.2"))
+		Expect(event.Assignments).To(HaveLen(1))
+
+		Eventually(events, time.Second).Should(Receive(&event))
+		Expect(event.Type).To(Equal(adminrestv1.ConsumerGroupEventTypeAssignmentChangedConst))
+		Expect(*event.Member.ConsumerID).To(Equal("c1"))
+		Expect(event.PreviousAssignments).To(HaveLen(2))
+		Expect(event.Assignments).To(HaveLen(1))
+
+		Consistently(events, 50*time.Millisecond).ShouldNot(Receive())
+
+		cancel()
+		Eventually(events, time.Second).Should(BeClosed())
+	})
+
+	It(`Reports an error when the group cannot be read`, func() {
+		adminrestService, serviceErr := adminrestv1.NewAdminrestV1(&adminrestv1.AdminrestV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+
+		events, err := adminrestService.WatchConsumerGroup(context.Background(), "", time.Second)
+		Expect(err).ToNot(BeNil())
+		Expect(events).To(BeNil())
+
+		testServer.Close()
+		events, err = adminrestService.WatchConsumerGroup(context.Background(), "billing", time.Second)
+		Expect(err).ToNot(BeNil())
+		Expect(events).To(BeNil())
+	})
+
+	It(`Invoke DiffConsumerGroups() to detect members leaving`, func() {
+		previous := &adminrestv1.GroupDetail{
+			GroupID: core.StringPtr("billing"),
+			State:   core.StringPtr("Stable"),
+			Members: []adminrestv1.Member{{ConsumerID: core.StringPtr("c1")}},
+		}
+		current := &adminrestv1.GroupDetail{
+			GroupID: core.StringPtr("billing"),
+			State:   core.StringPtr("Empty"),
+		}
+		events := adminrestv1.DiffConsumerGroups(previous, current)
+		Expect(events).To(HaveLen(2))
+		Expect(events[0].Type).To(Equal(adminrestv1.ConsumerGroupEventTypeStateChangedConst))
+		Expect(events[1].Type).To(Equal(adminrestv1.ConsumerGroupEventTypeMemberLeftConst))
+		Expect(events[1].GroupID).To(Equal("billing"))
+
+		Expect(adminrestv1.DiffConsumerGroups(current, current)).To(BeEmpty())
+	})
+})
@@ -0,0 +1,145 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package adminrestv1
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	common "github.com/IBM/eventstreams-go-sdk/pkg/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// listPageSize is the number of items requested per page when every page of a list is read.
+const listPageSize = 100
+
+// listTotalCountHeader is the response header in which list operations return the total number of items.
+const listTotalCountHeader = "X-Total-Count"
+
+// lastListPage returns whether a page of a list is the last one: when it is empty, when the items read so far reach
+// the total count returned with the page, or, without a total count, when the page is shorter than requested.
+func lastListPage(response *core.DetailedResponse, pageLength int, read int) bool {
+	if pageLength == 0 {
+		return true
+	}
+	if response != nil {
+		if total, err := strconv.Atoi(response.Headers.Get(listTotalCountHeader)); err == nil {
+			return read >= total
+		}
+	}
+	return pageLength < listPageSize
+}
+
+// ListAllTopics : Get the topics from every page
+// Lists the topics with ListTopics, reading every page. The TopicFilter and Headers of the options are used, and
+// their Page and PerPage are ignored.
+func (adminrest *AdminrestV1) ListAllTopics(listTopicsOptions *ListTopicsOptions) (result []TopicDetail, err error) {
+	result, err = adminrest.ListAllTopicsWithContext(context.Background(), listTopicsOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// ListAllTopicsWithContext is an alternate form of the ListAllTopics method which supports a Context parameter
+func (adminrest *AdminrestV1) ListAllTopicsWithContext(ctx context.Context, listTopicsOptions *ListTopicsOptions) (result []TopicDetail, err error) {
+	err = core.ValidateStruct(listTopicsOptions, "listTopicsOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+	return adminrest.topicDetails(ctx, listTopicsOptions.TopicFilter, listTopicsOptions.Headers)
+}
+
+// topicDetails gets the topics from every page of ListTopics, filtered by topicFilter if it is not nil.
+func (adminrest *AdminrestV1) topicDetails(ctx context.Context, topicFilter *string, headers map[string]string) (topics []TopicDetail, err error) {
+	seen := map[string]bool{}
+	for page := int64(1); ; page++ {
+		listOptions := adminrest.NewListTopicsOptions().SetPerPage(listPageSize).SetPage(page).SetHeaders(headers)
+		listOptions.TopicFilter = topicFilter
+		var pageTopics []TopicDetail
+		var response *core.DetailedResponse
+		pageTopics, response, err = adminrest.ListTopicsWithContext(ctx, listOptions)
+		if err != nil {
+			err = core.SDKErrorf(err, "", "list-topics-error", common.GetComponentInfo())
+			return
+		}
+		added := 0
+		for _, topic := range pageTopics {
+			if name := stringValue(topic.Name); !seen[name] {
+				seen[name] = true
+				topics = append(topics, topic)
+				added++
+			}
+		}
+		// A page with nothing new means that the pages are not advancing.
+		if added == 0 || lastListPage(response, len(pageTopics), len(topics)) {
+			break
+		}
+	}
+	return
+}
+
+// consumerGroupIDs gets the IDs of the consumer groups from every page of ListConsumerGroups, sorted.
+func (adminrest *AdminrestV1) consumerGroupIDs(ctx context.Context, headers map[string]string) (groupIDs []string, err error) {
+	seen := map[string]bool{}
+	for page := int64(1); ; page++ {
+		listOptions := adminrest.NewListConsumerGroupsOptions().SetPerPage(listPageSize).SetPage(page).SetHeaders(headers)
+		var pageIDs []string
+		var response *core.DetailedResponse
+		pageIDs, response, err = adminrest.ListConsumerGroupsWithContext(ctx, listOptions)
+		if err != nil {
+			err = core.SDKErrorf(err, "", "list-consumer-groups-error", common.GetComponentInfo())
+			return
+		}
+		added := 0
+		for _, groupID := range pageIDs {
+			if !seen[groupID] {
+				seen[groupID] = true
+				groupIDs = append(groupIDs, groupID)
+				added++
+			}
+		}
+		// A page with nothing new means that the pages are not advancing.
+		if added == 0 || lastListPage(response, len(pageIDs), len(groupIDs)) {
+			break
+		}
+	}
+	sort.Strings(groupIDs)
+	return
+}
+
+// consumerGroupDetails gets every consumer group returned by ListConsumerGroups, across all pages, sorted by ID.
+func (adminrest *AdminrestV1) consumerGroupDetails(ctx context.Context, headers map[string]string) (groups []*GroupDetail, err error) {
+	groupIDs, err := adminrest.consumerGroupIDs(ctx, headers)
+	if err != nil {
+		return
+	}
+	for _, groupID := range groupIDs {
+		var group *GroupDetail
+		group, _, err = adminrest.GetConsumerGroupWithContext(ctx, adminrest.NewGetConsumerGroupOptions(groupID).SetHeaders(headers))
+		if err != nil {
+			err = core.SDKErrorf(err, fmt.Sprintf("failed to get consumer group '%s'", groupID), "get-consumer-group-error", common.GetComponentInfo())
+			return
+		}
+		if group.GroupID == nil {
+			group.GroupID = core.StringPtr(groupID)
+		}
+		groups = append(groups, group)
+	}
+	return
+}
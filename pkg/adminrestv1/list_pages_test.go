@@ -0,0 +1,84 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package adminrestv1_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/eventstreams-go-sdk/pkg/adminrestv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`ListAllTopics(listTopicsOptions *ListTopicsOptions)`, func() {
+	var testServer *httptest.Server
+	var adminrestService *adminrestv1.AdminrestV1
+	var requests []string
+
+	BeforeEach(func() {
+		requests = nil
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			Expect(req.URL.EscapedPath()).To(Equal("/admin/topics"))
+			query := req.URL.Query()
+			requests = append(requests, fmt.Sprintf("topic_filter=%s page=%s %s", query.Get("topic_filter"), query.Get("page"), req.Header.Get("X-Test")))
+			res.Header().Set("Content-type", "application/json")
+			res.Header().Set("X-Total-Count", "3")
+			res.WriteHeader(200)
+			switch query.Get("page") {
+			case "1":
+				fmt.Fprint(res, `[{"name": "orders"}, {"name": "payments"}]`)
+			case "2":
+				fmt.Fprint(res, `[{"name": "refunds"}]`)
+			default:
+				fmt.Fprint(res, `[]`)
+			}
+		}))
+		var serviceErr error
+		adminrestService, serviceErr = adminrestv1.NewAdminrestV1(&adminrestv1.AdminrestV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Invoke ListAllTopics successfully`, func() {
+		listTopicsOptions := adminrestService.NewListTopicsOptions().
+			SetTopicFilter("*s").
+			SetPage(5).
+			SetHeaders(map[string]string{"X-Test": "all"})
+		topics, err := adminrestService.ListAllTopics(listTopicsOptions)
+		Expect(err).To(BeNil())
+		Expect(topics).To(HaveLen(3))
+		Expect(*topics[0].Name).To(Equal("orders"))
+		Expect(*topics[2].Name).To(Equal("refunds"))
+		Expect(requests).To(Equal([]string{"topic_filter=*s page=1 all", "topic_filter=*s page=2 all"}))
+	})
+	It(`Invoke ListAllTopics with error: Operation validation and request error`, func() {
+		topics, err := adminrestService.ListAllTopics(nil)
+		Expect(err).ToNot(BeNil())
+		Expect(topics).To(BeNil())
+		Expect(requests).To(BeEmpty())
+	})
+})
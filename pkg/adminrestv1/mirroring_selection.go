@@ -0,0 +1,494 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package adminrestv1
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"time"
+
+	common "github.com/IBM/eventstreams-go-sdk/pkg/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+const (
+	// DefaultMirroringPatternsMaxRetries is the number of times a conflicting selection update is retried.
+	DefaultMirroringPatternsMaxRetries = 3
+
+	// DefaultMirroringPatternsRetryInterval is the delay between attempts to update a conflicting selection.
+	DefaultMirroringPatternsRetryInterval = 200 * time.Millisecond
+)
+
+// MirroringPatternsOptions : The AddMirroringPatterns and RemoveMirroringPatterns options.
+type MirroringPatternsOptions struct {
+	// The include patterns to add to or remove from the mirroring topic selection.
+	Patterns []string `validate:"required"`
+
+	// The number of times the update is retried when the selection is changed concurrently. Defaults to
+	// DefaultMirroringPatternsMaxRetries.
+	MaxRetries *int64
+
+	// The delay between retries. Defaults to DefaultMirroringPatternsRetryInterval when not positive.
+	RetryInterval *time.Duration
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewMirroringPatternsOptions : Instantiate MirroringPatternsOptions
+func (*AdminrestV1) NewMirroringPatternsOptions(patterns []string) *MirroringPatternsOptions {
+	return &MirroringPatternsOptions{
+		Patterns: patterns,
+	}
+}
+
+// SetPatterns : Allow user to set Patterns
+func (_options *MirroringPatternsOptions) SetPatterns(patterns []string) *MirroringPatternsOptions {
+	_options.Patterns = patterns
+	return _options
+}
+
+// SetMaxRetries : Allow user to set MaxRetries
+func (_options *MirroringPatternsOptions) SetMaxRetries(maxRetries int64) *MirroringPatternsOptions {
+	_options.MaxRetries = core.Int64Ptr(maxRetries)
+	return _options
+}
+
+// SetRetryInterval : Allow user to set RetryInterval
+func (_options *MirroringPatternsOptions) SetRetryInterval(retryInterval time.Duration) *MirroringPatternsOptions {
+	_options.RetryInterval = &retryInterval
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *MirroringPatternsOptions) SetHeaders(param map[string]string) *MirroringPatternsOptions {
+	options.Headers = param
+	return options
+}
+
+// AddMirroringPatterns : Add patterns to the mirroring topic selection
+// Reads the current selection, appends the patterns that are not already present and writes it back. The update is
+// retried against the new selection when another client is seen to change the selection before the write or to
+// overwrite it afterwards. This is best effort: the API has no conditional writes, so a change made by another client
+// just before the write can still be lost.
+func (adminrest *AdminrestV1) AddMirroringPatterns(mirroringPatternsOptions *MirroringPatternsOptions) (result *MirroringTopicSelection, err error) {
+	result, err = adminrest.AddMirroringPatternsWithContext(context.Background(), mirroringPatternsOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// AddMirroringPatternsWithContext is an alternate form of the AddMirroringPatterns method which supports a Context parameter
+func (adminrest *AdminrestV1) AddMirroringPatternsWithContext(ctx context.Context, mirroringPatternsOptions *MirroringPatternsOptions) (result *MirroringTopicSelection, err error) {
+	err = validateMirroringPatternsOptions(mirroringPatternsOptions)
+	if err != nil {
+		return
+	}
+	for _, pattern := range mirroringPatternsOptions.Patterns {
+		err = ValidateMirroringPattern(pattern)
+		if err != nil {
+			return
+		}
+	}
+
+	result, err = adminrest.updateMirroringSelection(ctx, mirroringPatternsOptions, func(includes []string) []string {
+		updated := append([]string{}, includes...)
+		for _, pattern := range mirroringPatternsOptions.Patterns {
+			if !containsString(updated, pattern) {
+				updated = append(updated, pattern)
+			}
+		}
+		return updated
+	})
+	return
+}
+
+// RemoveMirroringPatterns : Remove patterns from the mirroring topic selection
+// Reads the current selection, removes the patterns and writes it back. Patterns that are not in the selection are
+// ignored. Concurrent changes are handled as by AddMirroringPatterns, on a best-effort basis.
+func (adminrest *AdminrestV1) RemoveMirroringPatterns(mirroringPatternsOptions *MirroringPatternsOptions) (result *MirroringTopicSelection, err error) {
+	result, err = adminrest.RemoveMirroringPatternsWithContext(context.Background(), mirroringPatternsOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// RemoveMirroringPatternsWithContext is an alternate form of the RemoveMirroringPatterns method which supports a Context parameter
+func (adminrest *AdminrestV1) RemoveMirroringPatternsWithContext(ctx context.Context, mirroringPatternsOptions *MirroringPatternsOptions) (result *MirroringTopicSelection, err error) {
+	err = validateMirroringPatternsOptions(mirroringPatternsOptions)
+	if err != nil {
+		return
+	}
+
+	result, err = adminrest.updateMirroringSelection(ctx, mirroringPatternsOptions, func(includes []string) []string {
+		updated := []string{}
+		for _, include := range includes {
+			if !containsString(mirroringPatternsOptions.Patterns, include) {
+				updated = append(updated, include)
+			}
+		}
+		return updated
+	})
+	return
+}
+
+func validateMirroringPatternsOptions(mirroringPatternsOptions *MirroringPatternsOptions) (err error) {
+	err = core.ValidateNotNil(mirroringPatternsOptions, "mirroringPatternsOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(mirroringPatternsOptions, "mirroringPatternsOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+	if mirroringPatternsOptions.MaxRetries != nil && *mirroringPatternsOptions.MaxRetries < 0 {
+		err = core.SDKErrorf(nil, fmt.Sprintf("maxRetries must not be negative, got %d", *mirroringPatternsOptions.MaxRetries), "invalid-max-retries", common.GetComponentInfo())
+	}
+	return
+}
+
+// updateMirroringSelection performs an optimistic read-modify-write of the mirroring topic selection. The selection is
+// read a second time immediately before writing, and again after writing, and the attempt is retried if it changed
+// before the write or if the change was overwritten after it. The API has no conditional writes, so a write by
+// another client that lands between the second read and the write is still lost.
+func (adminrest *AdminrestV1) updateMirroringSelection(ctx context.Context, mirroringPatternsOptions *MirroringPatternsOptions, modify func([]string) []string) (result *MirroringTopicSelection, err error) {
+	maxRetries := int64(DefaultMirroringPatternsMaxRetries)
+	if mirroringPatternsOptions.MaxRetries != nil {
+		maxRetries = *mirroringPatternsOptions.MaxRetries
+	}
+	retryInterval := DefaultMirroringPatternsRetryInterval
+	if mirroringPatternsOptions.RetryInterval != nil && *mirroringPatternsOptions.RetryInterval > 0 {
+		retryInterval = *mirroringPatternsOptions.RetryInterval
+	}
+	retry := time.NewTicker(retryInterval)
+	defer retry.Stop()
+
+	getOptions := adminrest.NewGetMirroringTopicSelectionOptions()
+	getOptions.Headers = mirroringPatternsOptions.Headers
+
+	for attempt := int64(0); attempt <= maxRetries; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				err = core.SDKErrorf(ctx.Err(), "", "context-done", common.GetComponentInfo())
+				return
+			case <-retry.C:
+			}
+		}
+
+		var original *MirroringTopicSelection
+		original, _, err = adminrest.GetMirroringTopicSelectionWithContext(ctx, getOptions)
+		if err != nil {
+			err = core.SDKErrorf(err, "", "get-selection-error", common.GetComponentInfo())
+			return
+		}
+		includes := selectionIncludes(original)
+		updated := modify(includes)
+		if sameStrings(includes, updated) {
+			result = original
+			return
+		}
+
+		var latest *MirroringTopicSelection
+		latest, _, err = adminrest.GetMirroringTopicSelectionWithContext(ctx, getOptions)
+		if err != nil {
+			err = core.SDKErrorf(err, "", "get-selection-error", common.GetComponentInfo())
+			return
+		}
+		if !sameStrings(includes, selectionIncludes(latest)) {
+			continue
+		}
+
+		replaceOptions := adminrest.NewReplaceMirroringTopicSelectionOptions().SetIncludes(updated)
+		replaceOptions.Headers = mirroringPatternsOptions.Headers
+		_, _, err = adminrest.ReplaceMirroringTopicSelectionWithContext(ctx, replaceOptions)
+		if err != nil {
+			err = core.SDKErrorf(err, "", "replace-selection-error", common.GetComponentInfo())
+			return
+		}
+
+		var written *MirroringTopicSelection
+		written, _, err = adminrest.GetMirroringTopicSelectionWithContext(ctx, getOptions)
+		if err != nil {
+			err = core.SDKErrorf(err, "", "get-selection-error", common.GetComponentInfo())
+			return
+		}
+		if selectionChangeApplied(includes, updated, selectionIncludes(written)) {
+			result = written
+			return
+		}
+	}
+
+	err = core.SDKErrorf(nil, fmt.Sprintf("mirroring topic selection was modified concurrently %d times; giving up", maxRetries+1), "selection-conflict", common.GetComponentInfo())
+	return
+}
+
+// selectionChangeApplied returns whether the patterns added to and removed from includes to make updated are still
+// added to and removed from current.
+func selectionChangeApplied(includes []string, updated []string, current []string) bool {
+	for _, pattern := range updated {
+		if !containsString(includes, pattern) && !containsString(current, pattern) {
+			return false
+		}
+	}
+	for _, pattern := range includes {
+		if !containsString(updated, pattern) && containsString(current, pattern) {
+			return false
+		}
+	}
+	return true
+}
+
+// ValidateMirroringPattern checks that pattern is a valid mirroring include pattern. Include patterns are regular
+// expressions that must match the whole topic name.
+func ValidateMirroringPattern(pattern string) (err error) {
+	if pattern == "" {
+		err = core.SDKErrorf(nil, "mirroring pattern must not be empty", "invalid-pattern", common.GetComponentInfo())
+		return
+	}
+	_, err = compileMirroringPattern(pattern)
+	if err != nil {
+		err = core.SDKErrorf(err, fmt.Sprintf("invalid mirroring pattern '%s': %s", pattern, err.Error()), "invalid-pattern", common.GetComponentInfo())
+	}
+	return
+}
+
+func compileMirroringPattern(pattern string) (*regexp.Regexp, error) {
+	return regexp.Compile("^(?:" + pattern + ")$")
+}
+
+// MirroringPatternMatch : The topics matched by a single mirroring include pattern.
+type MirroringPatternMatch struct {
+	// The include pattern.
+	Pattern string `json:"pattern"`
+
+	// The names of the topics matched by the pattern, sorted.
+	Topics []string `json:"topics"`
+}
+
+// MirroringPatternPreview : The result of matching mirroring include patterns against the current topics.
+type MirroringPatternPreview struct {
+	// The topics matched by each pattern, in the order the patterns were given.
+	Matches []MirroringPatternMatch `json:"matches"`
+
+	// The names of all topics matched by at least one pattern, sorted.
+	SelectedTopics []string `json:"selected_topics"`
+
+	// The names of topics not matched by any pattern, sorted.
+	UnselectedTopics []string `json:"unselected_topics"`
+}
+
+// PreviewMirroringPatternsOptions : The PreviewMirroringPatterns options.
+type PreviewMirroringPatternsOptions struct {
+	// The include patterns to preview.
+	Patterns []string `validate:"required"`
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewPreviewMirroringPatternsOptions : Instantiate PreviewMirroringPatternsOptions
+func (*AdminrestV1) NewPreviewMirroringPatternsOptions(patterns []string) *PreviewMirroringPatternsOptions {
+	return &PreviewMirroringPatternsOptions{
+		Patterns: patterns,
+	}
+}
+
+// SetPatterns : Allow user to set Patterns
+func (_options *PreviewMirroringPatternsOptions) SetPatterns(patterns []string) *PreviewMirroringPatternsOptions {
+	_options.Patterns = patterns
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *PreviewMirroringPatternsOptions) SetHeaders(param map[string]string) *PreviewMirroringPatternsOptions {
+	options.Headers = param
+	return options
+}
+
+// PreviewMirroringPatterns : Preview which topics mirroring patterns select
+// Lists the topics of this instance, from every page of ListTopics, and reports which of them each pattern would
+// select. Call this on the client for the mirroring source instance to preview a selection before applying it to the
+// target.
+func (adminrest *AdminrestV1) PreviewMirroringPatterns(previewMirroringPatternsOptions *PreviewMirroringPatternsOptions) (result *MirroringPatternPreview, err error) {
+	result, err = adminrest.PreviewMirroringPatternsWithContext(context.Background(), previewMirroringPatternsOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// PreviewMirroringPatternsWithContext is an alternate form of the PreviewMirroringPatterns method which supports a Context parameter
+func (adminrest *AdminrestV1) PreviewMirroringPatternsWithContext(ctx context.Context, previewMirroringPatternsOptions *PreviewMirroringPatternsOptions) (result *MirroringPatternPreview, err error) {
+	err = core.ValidateNotNil(previewMirroringPatternsOptions, "previewMirroringPatternsOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(previewMirroringPatternsOptions, "previewMirroringPatternsOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+	topics, err := adminrest.topicDetails(ctx, nil, previewMirroringPatternsOptions.Headers)
+	if err != nil {
+		return
+	}
+	result, err = MatchMirroringPatterns(previewMirroringPatternsOptions.Patterns, topicNames(topics))
+	return
+}
+
+// MatchMirroringPatterns reports which of topicNames are selected by each of the mirroring include patterns.
+func MatchMirroringPatterns(patterns []string, topicNames []string) (result *MirroringPatternPreview, err error) {
+	result = &MirroringPatternPreview{
+		Matches:          []MirroringPatternMatch{},
+		SelectedTopics:   []string{},
+		UnselectedTopics: []string{},
+	}
+	selected := make(map[string]bool)
+	for _, pattern := range patterns {
+		err = ValidateMirroringPattern(pattern)
+		if err != nil {
+			result = nil
+			return
+		}
+		expression, _ := compileMirroringPattern(pattern)
+		match := MirroringPatternMatch{Pattern: pattern, Topics: []string{}}
+		for _, name := range topicNames {
+			if expression.MatchString(name) {
+				match.Topics = append(match.Topics, name)
+				selected[name] = true
+			}
+		}
+		sort.Strings(match.Topics)
+		result.Matches = append(result.Matches, match)
+	}
+	for _, name := range topicNames {
+		if selected[name] {
+			result.SelectedTopics = append(result.SelectedTopics, name)
+		} else {
+			result.UnselectedTopics = append(result.UnselectedTopics, name)
+		}
+	}
+	sort.Strings(result.SelectedTopics)
+	sort.Strings(result.UnselectedTopics)
+	return
+}
+
+// MirroringSelectionComparison : A comparison of the topics selected for mirroring with those actively mirrored.
+type MirroringSelectionComparison struct {
+	// The include patterns of the current mirroring topic selection.
+	Includes []string `json:"includes"`
+
+	// The source topics selected by the include patterns, sorted.
+	SelectedTopics []string `json:"selected_topics"`
+
+	// The topics that are being actively mirrored, sorted.
+	ActiveTopics []string `json:"active_topics"`
+
+	// The selected topics that are not yet actively mirrored, sorted.
+	InactiveTopics []string `json:"inactive_topics"`
+}
+
+// CompareMirroringSelection : Compare the mirroring topic selection with the active topics
+// Reads the mirroring topic selection and active topics from this (target) instance and matches the selection against
+// the topics listed by source, reporting the selected topics that are not yet active. If source is nil, the topics of
+// this instance are used.
+func (adminrest *AdminrestV1) CompareMirroringSelection(source *AdminrestV1) (result *MirroringSelectionComparison, err error) {
+	result, err = adminrest.CompareMirroringSelectionWithContext(context.Background(), source)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// CompareMirroringSelectionWithContext is an alternate form of the CompareMirroringSelection method which supports a Context parameter
+func (adminrest *AdminrestV1) CompareMirroringSelectionWithContext(ctx context.Context, source *AdminrestV1) (result *MirroringSelectionComparison, err error) {
+	if source == nil {
+		source = adminrest
+	}
+
+	selection, _, err := adminrest.GetMirroringTopicSelectionWithContext(ctx, adminrest.NewGetMirroringTopicSelectionOptions())
+	if err != nil {
+		err = core.SDKErrorf(err, "", "get-selection-error", common.GetComponentInfo())
+		return
+	}
+	active, _, err := adminrest.GetMirroringActiveTopicsWithContext(ctx, adminrest.NewGetMirroringActiveTopicsOptions())
+	if err != nil {
+		err = core.SDKErrorf(err, "", "get-active-topics-error", common.GetComponentInfo())
+		return
+	}
+	preview, err := source.PreviewMirroringPatternsWithContext(ctx, source.NewPreviewMirroringPatternsOptions(selectionIncludes(selection)))
+	if err != nil {
+		return
+	}
+
+	activeTopics := []string{}
+	if active != nil {
+		activeTopics = append(activeTopics, active.ActiveTopics...)
+	}
+	sort.Strings(activeTopics)
+
+	result = &MirroringSelectionComparison{
+		Includes:       selectionIncludes(selection),
+		SelectedTopics: preview.SelectedTopics,
+		ActiveTopics:   activeTopics,
+		InactiveTopics: []string{},
+	}
+	for _, name := range preview.SelectedTopics {
+		if !containsString(activeTopics, name) {
+			result.InactiveTopics = append(result.InactiveTopics, name)
+		}
+	}
+	return
+}
+
+func selectionIncludes(selection *MirroringTopicSelection) []string {
+	if selection == nil || selection.Includes == nil {
+		return []string{}
+	}
+	return selection.Includes
+}
+
+func topicNames(topics []TopicDetail) []string {
+	names := make([]string, 0, len(topics))
+	for _, topic := range topics {
+		if topic.Name != nil {
+			names = append(names, *topic.Name)
+		}
+	}
+	return names
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func sameStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
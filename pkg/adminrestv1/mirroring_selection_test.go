@@ -0,0 +1,209 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package adminrestv1_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"time"
+
+	"github.com/IBM/eventstreams-go-sdk/pkg/adminrestv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Mirroring topic selection management`, func() {
+	var testServer *httptest.Server
+	var adminrestService *adminrestv1.AdminrestV1
+	var mutex sync.Mutex
+	var includes []string
+	var getCount int
+	var interfereOnGet int
+	var overwriteOnGet int
+	var replaceCount int
+	var topicsHeader string
+
+	BeforeEach(func() {
+		includes = []string{"orders"}
+		getCount = 0
+		interfereOnGet = 0
+		overwriteOnGet = 0
+		replaceCount = 0
+		topicsHeader = ""
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			mutex.Lock()
+			defer mutex.Unlock()
+			res.Header().Set("Content-type", "application/json")
+			switch req.URL.EscapedPath() {
+			case "/admin/mirroring/topic-selection":
+				if req.Method == "POST" {
+					var body map[string][]string
+					Expect(json.NewDecoder(req.Body).Decode(&body)).To(Succeed())
+					includes = body["includes"]
+					replaceCount++
+				} else {
+					getCount++
+					if getCount == interfereOnGet {
+						includes = append(includes, fmt.Sprintf("other-%d", getCount))
+					}
+					if getCount == overwriteOnGet {
+						includes = []string{"orders", fmt.Sprintf("other-%d", getCount)}
+					}
+				}
+				res.WriteHeader(200)
+				Expect(json.NewEncoder(res).Encode(map[string][]string{"includes": includes})).To(Succeed())
+			case "/admin/mirroring/active-topics":
+				res.WriteHeader(200)
+				fmt.Fprintf(res, "%s", `{"active_topics": ["orders"]}`)
+			case "/admin/topics":
+				// The topics are listed on two pages
+				topicsHeader = req.Header.Get("X-Test")
+				res.Header().Set("X-Total-Count", "4")
+				res.WriteHeader(200)
+				if req.URL.Query().Get("page") == "2" {
+					fmt.Fprintf(res, "%s", `[{"name": "audit"}]`)
+				} else {
+					fmt.Fprintf(res, "%s", `[{"name": "orders"}, {"name": "payments.eu"}, {"name": "payments.us"}]`)
+				}
+			default:
+				Fail("unexpected request " + req.URL.EscapedPath())
+			}
+		}))
+		var serviceErr error
+		adminrestService, serviceErr = adminrestv1.NewAdminrestV1(&adminrestv1.AdminrestV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Invoke AddMirroringPatterns successfully`, func() {
+		options := adminrestService.NewMirroringPatternsOptions([]string{"payments\\..*", "orders"})
+		result, err := adminrestService.AddMirroringPatterns(options)
+		Expect(err).To(BeNil())
+		Expect(result.Includes).To(Equal([]string{"orders", "payments\\..*"}))
+		Expect(replaceCount).To(Equal(1))
+
+		// Adding patterns that are already present does not write the selection
+		result, err = adminrestService.AddMirroringPatterns(options)
+		Expect(err).To(BeNil())
+		Expect(result.Includes).To(Equal([]string{"orders", "payments\\..*"}))
+		Expect(replaceCount).To(Equal(1))
+	})
+
+	It(`Invoke RemoveMirroringPatterns successfully`, func() {
+		options := adminrestService.NewMirroringPatternsOptions([]string{"orders", "missing"})
+		result, err := adminrestService.RemoveMirroringPatterns(options)
+		Expect(err).To(BeNil())
+		Expect(result.Includes).To(BeEmpty())
+		Expect(replaceCount).To(Equal(1))
+	})
+
+	It(`Retries when the selection changes concurrently`, func() {
+		interfereOnGet = 2
+		options := adminrestService.NewMirroringPatternsOptions([]string{"audit"}).SetRetryInterval(time.Millisecond)
+		result, err := adminrestService.AddMirroringPatterns(options)
+		Expect(err).To(BeNil())
+		Expect(result.Includes).To(Equal([]string{"orders", "other-2", "audit"}))
+		Expect(replaceCount).To(Equal(1))
+	})
+
+	It(`Retries when the selection is overwritten after the update`, func() {
+		overwriteOnGet = 3
+		options := adminrestService.NewMirroringPatternsOptions([]string{"audit"}).SetRetryInterval(time.Millisecond)
+		result, err := adminrestService.AddMirroringPatterns(options)
+		Expect(err).To(BeNil())
+		Expect(result.Includes).To(Equal([]string{"orders", "other-3", "audit"}))
+		Expect(replaceCount).To(Equal(2))
+		Expect(getCount).To(Equal(6))
+
+		// A removed pattern that is added back is removed again
+		getCount = 0
+		overwriteOnGet = 0
+		interfereOnGet = 3
+		options = adminrestService.NewMirroringPatternsOptions([]string{"other-3"}).SetRetryInterval(time.Millisecond)
+		result, err = adminrestService.RemoveMirroringPatterns(options)
+		Expect(err).To(BeNil())
+		Expect(result.Includes).To(Equal([]string{"orders", "audit"}))
+		Expect(replaceCount).To(Equal(4))
+	})
+
+	It(`Gives up after the maximum number of conflicts`, func() {
+		interfereOnGet = 2
+		options := adminrestService.NewMirroringPatternsOptions([]string{"audit"}).SetMaxRetries(0)
+		result, err := adminrestService.AddMirroringPatterns(options)
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("modified concurrently"))
+		Expect(result).To(BeNil())
+		Expect(replaceCount).To(Equal(0))
+	})
+
+	It(`Rejects invalid patterns and options`, func() {
+		_, err := adminrestService.AddMirroringPatterns(nil)
+		Expect(err).ToNot(BeNil())
+		_, err = adminrestService.AddMirroringPatterns(adminrestService.NewMirroringPatternsOptions(nil))
+		Expect(err).ToNot(BeNil())
+		_, err = adminrestService.AddMirroringPatterns(adminrestService.NewMirroringPatternsOptions([]string{"orders("}))
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("invalid mirroring pattern"))
+		_, err = adminrestService.AddMirroringPatterns(adminrestService.NewMirroringPatternsOptions([]string{"orders"}).SetMaxRetries(-1))
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("maxRetries must not be negative"))
+		_, err = adminrestService.PreviewMirroringPatterns(nil)
+		Expect(err).ToNot(BeNil())
+		Expect(adminrestv1.ValidateMirroringPattern("")).ToNot(BeNil())
+		Expect(adminrestv1.ValidateMirroringPattern("orders.*")).To(BeNil())
+		Expect(getCount).To(Equal(0))
+	})
+
+	It(`Invoke PreviewMirroringPatterns successfully`, func() {
+		options := adminrestService.NewPreviewMirroringPatternsOptions([]string{"payments\\..*", "orders"}).
+			SetHeaders(map[string]string{"X-Test": "preview"})
+		result, err := adminrestService.PreviewMirroringPatterns(options)
+		Expect(err).To(BeNil())
+		Expect(topicsHeader).To(Equal("preview"))
+		Expect(result.Matches).To(HaveLen(2))
+		Expect(result.Matches[0].Topics).To(Equal([]string{"payments.eu", "payments.us"}))
+		Expect(result.Matches[1].Topics).To(Equal([]string{"orders"}))
+		Expect(result.SelectedTopics).To(Equal([]string{"orders", "payments.eu", "payments.us"}))
+		Expect(result.UnselectedTopics).To(Equal([]string{"audit"}))
+
+		// Patterns must match the whole topic name
+		preview, err := adminrestv1.MatchMirroringPatterns([]string{"payments"}, []string{"payments.eu"})
+		Expect(err).To(BeNil())
+		Expect(preview.SelectedTopics).To(BeEmpty())
+	})
+
+	It(`Invoke CompareMirroringSelection successfully`, func() {
+		includes = []string{"orders", "payments\\..*"}
+		result, err := adminrestService.CompareMirroringSelection(nil)
+		Expect(err).To(BeNil())
+		Expect(result.Includes).To(Equal([]string{"orders", "payments\\..*"}))
+		Expect(result.SelectedTopics).To(Equal([]string{"orders", "payments.eu", "payments.us"}))
+		Expect(result.ActiveTopics).To(Equal([]string{"orders"}))
+		Expect(result.InactiveTopics).To(Equal([]string{"payments.eu", "payments.us"}))
+	})
+})
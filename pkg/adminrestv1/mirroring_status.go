@@ -0,0 +1,362 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package adminrestv1
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	common "github.com/IBM/eventstreams-go-sdk/pkg/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Constants associated with the MirroringTopicStatus.Issues property.
+// A problem detected with the mirroring of a topic.
+const (
+	MirroringTopicStatusIssuesSelectedNotActiveConst = "selected_not_active"
+	MirroringTopicStatusIssuesMissingOnTargetConst   = "missing_on_target"
+	MirroringTopicStatusIssuesPartitionMismatchConst = "partition_mismatch"
+	MirroringTopicStatusIssuesConfigMismatchConst    = "config_mismatch"
+)
+
+// GetMirroringStatusReportOptions : The GetMirroringStatusReport options.
+type GetMirroringStatusReportOptions struct {
+	// The client for the mirroring source instance.
+	Source *AdminrestV1 `validate:"required"`
+
+	// The alias of the source instance. When set, a source topic named `t` is expected on the target as
+	// `<SourceAlias>.t`, matching the renaming applied by mirroring. When not set, topics are expected to have the same
+	// name on both instances.
+	SourceAlias *string
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewGetMirroringStatusReportOptions : Instantiate GetMirroringStatusReportOptions
+func (*AdminrestV1) NewGetMirroringStatusReportOptions(source *AdminrestV1) *GetMirroringStatusReportOptions {
+	return &GetMirroringStatusReportOptions{
+		Source: source,
+	}
+}
+
+// SetSource : Allow user to set Source
+func (_options *GetMirroringStatusReportOptions) SetSource(source *AdminrestV1) *GetMirroringStatusReportOptions {
+	_options.Source = source
+	return _options
+}
+
+// SetSourceAlias : Allow user to set SourceAlias
+func (_options *GetMirroringStatusReportOptions) SetSourceAlias(sourceAlias string) *GetMirroringStatusReportOptions {
+	_options.SourceAlias = core.StringPtr(sourceAlias)
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *GetMirroringStatusReportOptions) SetHeaders(param map[string]string) *GetMirroringStatusReportOptions {
+	options.Headers = param
+	return options
+}
+
+// MirroringConfigDifference : A topic config property whose value differs between the source and target topics.
+type MirroringConfigDifference struct {
+	// The name of the config property.
+	Name string `json:"name"`
+
+	// The value on the source topic.
+	SourceValue string `json:"source_value"`
+
+	// The value on the target topic.
+	TargetValue string `json:"target_value"`
+}
+
+// MirroringTopicStatus : The mirroring status of a single topic.
+type MirroringTopicStatus struct {
+	// The name of the topic on the source instance.
+	Name string `json:"name"`
+
+	// The name the topic is expected to have on the target instance.
+	TargetName string `json:"target_name"`
+
+	// Whether the topic is matched by the mirroring topic selection.
+	Selected bool `json:"selected"`
+
+	// Whether the topic is reported as actively mirrored.
+	Active bool `json:"active"`
+
+	// Whether the topic exists on the source instance.
+	OnSource bool `json:"on_source"`
+
+	// Whether the topic exists on the target instance.
+	OnTarget bool `json:"on_target"`
+
+	// The number of partitions of the source topic.
+	SourcePartitions *int64 `json:"source_partitions,omitempty"`
+
+	// The number of partitions of the target topic.
+	TargetPartitions *int64 `json:"target_partitions,omitempty"`
+
+	// The config properties that differ between the source and target topics.
+	ConfigDifferences []MirroringConfigDifference `json:"config_differences,omitempty"`
+
+	// The problems detected for the topic, using the MirroringTopicStatusIssues constants.
+	Issues []string `json:"issues,omitempty"`
+}
+
+// MirroringStatusReport : A report correlating the mirroring topic selection, the actively mirrored topics and the
+// topics of the source and target instances. It does not include mirroring lag.
+type MirroringStatusReport struct {
+	// The include patterns of the mirroring topic selection.
+	Includes []string `json:"includes"`
+
+	// The status of every topic that is selected or active, sorted by name.
+	Topics []MirroringTopicStatus `json:"topics"`
+
+	// The names of topics that are selected but not actively mirrored.
+	SelectedNotActive []string `json:"selected_not_active"`
+
+	// The names of topics that are actively mirrored but do not exist on the target.
+	MissingOnTarget []string `json:"missing_on_target"`
+
+	// The names of topics whose partition counts differ between source and target.
+	PartitionMismatches []string `json:"partition_mismatches"`
+
+	// The names of topics whose configs differ between source and target.
+	ConfigMismatches []string `json:"config_mismatches"`
+
+	// True when no issues were found.
+	Healthy bool `json:"healthy"`
+}
+
+// GetMirroringStatusReport : Get a mirroring health report
+// Reads the mirroring topic selection and active topics from this (target) instance, lists the topics of the source
+// and target instances from every page of ListTopics and reports selected topics that are not active, active topics that are missing on the target,
+// and topics whose partition counts or configs differ between source and target. Mirroring lag is not reported: the
+// admin REST API only exposes partition end offsets through the committed offsets of consumer groups, which do not
+// cover every partition, so the lag of a topic cannot be measured reliably.
+func (adminrest *AdminrestV1) GetMirroringStatusReport(getMirroringStatusReportOptions *GetMirroringStatusReportOptions) (result *MirroringStatusReport, err error) {
+	result, err = adminrest.GetMirroringStatusReportWithContext(context.Background(), getMirroringStatusReportOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetMirroringStatusReportWithContext is an alternate form of the GetMirroringStatusReport method which supports a Context parameter
+func (adminrest *AdminrestV1) GetMirroringStatusReportWithContext(ctx context.Context, getMirroringStatusReportOptions *GetMirroringStatusReportOptions) (result *MirroringStatusReport, err error) {
+	err = core.ValidateNotNil(getMirroringStatusReportOptions, "getMirroringStatusReportOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(getMirroringStatusReportOptions, "getMirroringStatusReportOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+	headers := getMirroringStatusReportOptions.Headers
+
+	selectionOptions := adminrest.NewGetMirroringTopicSelectionOptions().SetHeaders(headers)
+	selection, _, err := adminrest.GetMirroringTopicSelectionWithContext(ctx, selectionOptions)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "get-selection-error", common.GetComponentInfo())
+		return
+	}
+	activeOptions := adminrest.NewGetMirroringActiveTopicsOptions().SetHeaders(headers)
+	active, _, err := adminrest.GetMirroringActiveTopicsWithContext(ctx, activeOptions)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "get-active-topics-error", common.GetComponentInfo())
+		return
+	}
+	sourceTopics, err := getMirroringStatusReportOptions.Source.topicDetails(ctx, nil, headers)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "list-source-topics-error", common.GetComponentInfo())
+		return
+	}
+	targetTopics, err := adminrest.topicDetails(ctx, nil, headers)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "list-target-topics-error", common.GetComponentInfo())
+		return
+	}
+
+	var activeTopics []string
+	if active != nil {
+		activeTopics = active.ActiveTopics
+	}
+	result, err = BuildMirroringStatusReport(selectionIncludes(selection), activeTopics, sourceTopics, targetTopics, getMirroringStatusReportOptions.SourceAlias)
+	return
+}
+
+// BuildMirroringStatusReport correlates a mirroring topic selection and list of active topics with the topics of the
+// source and target instances. Active topic names may be given either as source or target topic names.
+func BuildMirroringStatusReport(includes []string, activeTopics []string, sourceTopics []TopicDetail, targetTopics []TopicDetail, sourceAlias *string) (result *MirroringStatusReport, err error) {
+	sourceNames := topicNames(sourceTopics)
+	preview, err := MatchMirroringPatterns(includes, sourceNames)
+	if err != nil {
+		return
+	}
+
+	targetName := func(name string) string {
+		if sourceAlias != nil && *sourceAlias != "" {
+			return *sourceAlias + "." + name
+		}
+		return name
+	}
+
+	sourceByName := topicsByName(sourceTopics)
+	targetByName := topicsByName(targetTopics)
+	activeByName := make(map[string]bool, len(activeTopics))
+	for _, name := range activeTopics {
+		activeByName[name] = true
+	}
+
+	// Active topics may be reported using target names; map them back to source names.
+	candidates := make(map[string]bool)
+	for _, name := range preview.SelectedTopics {
+		candidates[name] = true
+	}
+	for _, name := range activeTopics {
+		if sourceAlias != nil && *sourceAlias != "" {
+			name = strings.TrimPrefix(name, *sourceAlias+".")
+		}
+		candidates[name] = true
+	}
+	names := make([]string, 0, len(candidates))
+	for name := range candidates {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	result = &MirroringStatusReport{
+		Includes:            includes,
+		Topics:              []MirroringTopicStatus{},
+		SelectedNotActive:   []string{},
+		MissingOnTarget:     []string{},
+		PartitionMismatches: []string{},
+		ConfigMismatches:    []string{},
+	}
+	selected := make(map[string]bool, len(preview.SelectedTopics))
+	for _, name := range preview.SelectedTopics {
+		selected[name] = true
+	}
+
+	for _, name := range names {
+		status := MirroringTopicStatus{
+			Name:       name,
+			TargetName: targetName(name),
+			Selected:   selected[name],
+		}
+		status.Active = activeByName[name] || activeByName[status.TargetName]
+		source, onSource := sourceByName[name]
+		target, onTarget := targetByName[status.TargetName]
+		status.OnSource = onSource
+		status.OnTarget = onTarget
+		if onSource {
+			status.SourcePartitions = source.Partitions
+		}
+		if onTarget {
+			status.TargetPartitions = target.Partitions
+		}
+
+		if status.Selected && !status.Active {
+			status.Issues = append(status.Issues, MirroringTopicStatusIssuesSelectedNotActiveConst)
+			result.SelectedNotActive = append(result.SelectedNotActive, name)
+		}
+		if status.Active && !onTarget {
+			status.Issues = append(status.Issues, MirroringTopicStatusIssuesMissingOnTargetConst)
+			result.MissingOnTarget = append(result.MissingOnTarget, name)
+		}
+		if onSource && onTarget {
+			if int64Value(source.Partitions) != int64Value(target.Partitions) {
+				status.Issues = append(status.Issues, MirroringTopicStatusIssuesPartitionMismatchConst)
+				result.PartitionMismatches = append(result.PartitionMismatches, name)
+			}
+			status.ConfigDifferences = diffTopicConfigs(source, target)
+			if len(status.ConfigDifferences) > 0 {
+				status.Issues = append(status.Issues, MirroringTopicStatusIssuesConfigMismatchConst)
+				result.ConfigMismatches = append(result.ConfigMismatches, name)
+			}
+		}
+		result.Topics = append(result.Topics, status)
+	}
+
+	result.Healthy = len(result.SelectedNotActive) == 0 && len(result.MissingOnTarget) == 0 &&
+		len(result.PartitionMismatches) == 0 && len(result.ConfigMismatches) == 0
+	return
+}
+
+// topicConfigValues returns the config properties of topic that are reported by GetTopic and ListTopics, keyed by
+// their Kafka property names. Properties that are not set are omitted.
+func topicConfigValues(topic *TopicDetail) map[string]string {
+	values := make(map[string]string)
+	if topic.RetentionMs != nil {
+		values["retention.ms"] = fmt.Sprint(*topic.RetentionMs)
+	}
+	if topic.CleanupPolicy != nil {
+		values["cleanup.policy"] = *topic.CleanupPolicy
+	}
+	if topic.Configs != nil {
+		if topic.Configs.RetentionBytes != nil {
+			values["retention.bytes"] = *topic.Configs.RetentionBytes
+		}
+		if topic.Configs.SegmentBytes != nil {
+			values["segment.bytes"] = *topic.Configs.SegmentBytes
+		}
+		if topic.Configs.SegmentIndexBytes != nil {
+			values["segment.index.bytes"] = *topic.Configs.SegmentIndexBytes
+		}
+		if topic.Configs.SegmentMs != nil {
+			values["segment.ms"] = *topic.Configs.SegmentMs
+		}
+	}
+	return values
+}
+
+func diffTopicConfigs(source, target *TopicDetail) (differences []MirroringConfigDifference) {
+	sourceValues := topicConfigValues(source)
+	targetValues := topicConfigValues(target)
+	names := make([]string, 0, len(sourceValues)+len(targetValues))
+	for name := range sourceValues {
+		names = append(names, name)
+	}
+	for name := range targetValues {
+		if _, ok := sourceValues[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if sourceValues[name] != targetValues[name] {
+			differences = append(differences, MirroringConfigDifference{
+				Name:        name,
+				SourceValue: sourceValues[name],
+				TargetValue: targetValues[name],
+			})
+		}
+	}
+	return
+}
+
+func topicsByName(topics []TopicDetail) map[string]*TopicDetail {
+	result := make(map[string]*TopicDetail, len(topics))
+	for i := range topics {
+		if topics[i].Name != nil {
+			result[*topics[i].Name] = &topics[i]
+		}
+	}
+	return result
+}
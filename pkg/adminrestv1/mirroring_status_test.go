@@ -0,0 +1,139 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package adminrestv1_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/eventstreams-go-sdk/pkg/adminrestv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`GetMirroringStatusReport(getMirroringStatusReportOptions *GetMirroringStatusReportOptions)`, func() {
+	var sourceServer *httptest.Server
+	var targetServer *httptest.Server
+
+	BeforeEach(func() {
+		sourceServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			Expect(req.URL.EscapedPath()).To(Equal("/admin/topics"))
+			// The topics are listed on two pages
+			res.Header().Set("Content-type", "application/json")
+			res.Header().Set("X-Total-Count", "4")
+			res.WriteHeader(200)
+			if req.URL.Query().Get("page") == "2" {
+				fmt.Fprintf(res, "%s", `[{"name": "refunds", "partitions": 1}, {"name": "internal", "partitions": 1}]`)
+				return
+			}
+			fmt.Fprintf(res, "%s", `[
+				{"name": "orders", "partitions": 3, "retentionMs": 86400000},
+				{"name": "payments", "partitions": 6, "retentionMs": 86400000}
+			]`)
+		}))
+		targetServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			res.Header().Set("Content-type", "application/json")
+			if req.URL.EscapedPath() == "/admin/topics" {
+				res.Header().Set("X-Total-Count", "2")
+			}
+			res.WriteHeader(200)
+			switch req.URL.EscapedPath() {
+			case "/admin/mirroring/topic-selection":
+				fmt.Fprintf(res, "%s", `{"includes": ["orders", "payments", "refunds"]}`)
+			case "/admin/mirroring/active-topics":
+				fmt.Fprintf(res, "%s", `{"active_topics": ["orders", "payments", "legacy"]}`)
+			case "/admin/topics":
+				// The topics are listed one per page, so src.payments is only on the second page
+				if req.URL.Query().Get("page") == "2" {
+					fmt.Fprintf(res, "%s", `[{"name": "src.payments", "partitions": 3, "retentionMs": 3600000}]`)
+				} else {
+					fmt.Fprintf(res, "%s", `[{"name": "src.orders", "partitions": 3, "retentionMs": 86400000}]`)
+				}
+			default:
+				Fail("unexpected request " + req.URL.EscapedPath())
+			}
+		}))
+	})
+	AfterEach(func() {
+		sourceServer.Close()
+		targetServer.Close()
+	})
+
+	It(`Invoke GetMirroringStatusReport successfully`, func() {
+		sourceService, serviceErr := adminrestv1.NewAdminrestV1(&adminrestv1.AdminrestV1Options{
+			URL:           sourceServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+		targetService, serviceErr := adminrestv1.NewAdminrestV1(&adminrestv1.AdminrestV1Options{
+			URL:           targetServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+
+		// Invoke operation with invalid options (negative test)
+		_, err := targetService.GetMirroringStatusReport(nil)
+		Expect(err).ToNot(BeNil())
+		_, err = targetService.GetMirroringStatusReport(targetService.NewGetMirroringStatusReportOptions(nil))
+		Expect(err).ToNot(BeNil())
+
+		options := targetService.NewGetMirroringStatusReportOptions(sourceService).SetSourceAlias("src")
+		report, err := targetService.GetMirroringStatusReport(options)
+		Expect(err).To(BeNil())
+		Expect(report.Healthy).To(BeFalse())
+		Expect(report.Includes).To(Equal([]string{"orders", "payments", "refunds"}))
+		Expect(report.SelectedNotActive).To(Equal([]string{"refunds"}))
+		Expect(report.MissingOnTarget).To(Equal([]string{"legacy"}))
+		Expect(report.PartitionMismatches).To(Equal([]string{"payments"}))
+		Expect(report.ConfigMismatches).To(Equal([]string{"payments"}))
+
+		Expect(report.Topics).To(HaveLen(4))
+		orders := report.Topics[1]
+		Expect(orders.Name).To(Equal("orders"))
+		Expect(orders.TargetName).To(Equal("src.orders"))
+		Expect(orders.Selected && orders.Active && orders.OnSource && orders.OnTarget).To(BeTrue())
+		Expect(orders.Issues).To(BeEmpty())
+
+		payments := report.Topics[2]
+		Expect(*payments.SourcePartitions).To(Equal(int64(6)))
+		Expect(*payments.TargetPartitions).To(Equal(int64(3)))
+		Expect(payments.ConfigDifferences).To(Equal([]adminrestv1.MirroringConfigDifference{
+			{Name: "retention.ms", SourceValue: "86400000", TargetValue: "3600000"},
+		}))
+		Expect(payments.Issues).To(Equal([]string{
+			adminrestv1.MirroringTopicStatusIssuesPartitionMismatchConst,
+			adminrestv1.MirroringTopicStatusIssuesConfigMismatchConst,
+		}))
+	})
+
+	It(`Invoke BuildMirroringStatusReport() with matching names`, func() {
+		topics := []adminrestv1.TopicDetail{{Name: core.StringPtr("orders"), Partitions: core.Int64Ptr(1)}}
+		report, err := adminrestv1.BuildMirroringStatusReport([]string{"orders"}, []string{"orders"}, topics, topics, nil)
+		Expect(err).To(BeNil())
+		Expect(report.Healthy).To(BeTrue())
+		Expect(report.Topics[0].TargetName).To(Equal("orders"))
+
+		_, err = adminrestv1.BuildMirroringStatusReport([]string{"("}, nil, topics, topics, nil)
+		Expect(err).ToNot(BeNil())
+	})
+})
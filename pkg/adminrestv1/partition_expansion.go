@@ -0,0 +1,288 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package adminrestv1
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	common "github.com/IBM/eventstreams-go-sdk/pkg/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+const (
+	// DefaultPartitionExpansionTimeout is how long ExpandPartitions waits for the topic to report the new partition
+	// count.
+	DefaultPartitionExpansionTimeout = 2 * time.Minute
+
+	// DefaultPartitionExpansionPollInterval is the delay between the GetTopic requests made by ExpandPartitions while
+	// it waits.
+	DefaultPartitionExpansionPollInterval = time.Second
+)
+
+// ExpandPartitionsOptions : The PlanPartitionExpansion and ExpandPartitions options.
+type ExpandPartitionsOptions struct {
+	// The name of the topic.
+	TopicName *string `validate:"required,ne="`
+
+	// The new partition count, which must be greater than the current one.
+	Partitions *int64 `validate:"required"`
+
+	// How long to wait for the topic to report the new partition count. Defaults to
+	// DefaultPartitionExpansionTimeout.
+	Timeout *time.Duration
+
+	// The delay between checks of the partition count. Defaults to DefaultPartitionExpansionPollInterval when not
+	// positive.
+	PollInterval *time.Duration
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewExpandPartitionsOptions : Instantiate ExpandPartitionsOptions
+func (*AdminrestV1) NewExpandPartitionsOptions(topicName string, partitions int64) *ExpandPartitionsOptions {
+	return &ExpandPartitionsOptions{
+		TopicName:  core.StringPtr(topicName),
+		Partitions: core.Int64Ptr(partitions),
+	}
+}
+
+// SetTopicName : Allow user to set TopicName
+func (_options *ExpandPartitionsOptions) SetTopicName(topicName string) *ExpandPartitionsOptions {
+	_options.TopicName = core.StringPtr(topicName)
+	return _options
+}
+
+// SetPartitions : Allow user to set Partitions
+func (_options *ExpandPartitionsOptions) SetPartitions(partitions int64) *ExpandPartitionsOptions {
+	_options.Partitions = core.Int64Ptr(partitions)
+	return _options
+}
+
+// SetTimeout : Allow user to set Timeout
+func (_options *ExpandPartitionsOptions) SetTimeout(timeout time.Duration) *ExpandPartitionsOptions {
+	_options.Timeout = &timeout
+	return _options
+}
+
+// SetPollInterval : Allow user to set PollInterval
+func (_options *ExpandPartitionsOptions) SetPollInterval(pollInterval time.Duration) *ExpandPartitionsOptions {
+	_options.PollInterval = &pollInterval
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *ExpandPartitionsOptions) SetHeaders(param map[string]string) *ExpandPartitionsOptions {
+	options.Headers = param
+	return options
+}
+
+// PartitionExpansionGroup : A consumer group that reads a topic whose partitions are expanded.
+type PartitionExpansionGroup struct {
+	// The ID of the consumer group.
+	GroupID string `json:"group_id"`
+
+	// The state of the consumer group.
+	State string `json:"state,omitempty"`
+
+	// The number of members of the group that are assigned partitions of the topic.
+	Members int64 `json:"members"`
+
+	// Whether the group has members, and so will rebalance when the partitions are added.
+	Rebalances bool `json:"rebalances"`
+}
+
+// PartitionExpansionPlan : The effect of increasing the partition count of a topic.
+type PartitionExpansionPlan struct {
+	// The name of the topic.
+	TopicName string `json:"topic_name"`
+
+	// The current partition count.
+	CurrentPartitions int64 `json:"current_partitions"`
+
+	// The new partition count.
+	TargetPartitions int64 `json:"target_partitions"`
+
+	// Whether the topic is compacted, so that records with the same key written before and after the change may
+	// end up in different partitions and be kept as separate latest values.
+	Compacted bool `json:"compacted"`
+
+	// The consumer groups that have committed offsets on, or members assigned to, the topic, sorted by ID.
+	ConsumerGroups []PartitionExpansionGroup `json:"consumer_groups"`
+
+	// Descriptions of the risks of the change.
+	Warnings []string `json:"warnings"`
+
+	// Whether the partition count has been changed.
+	Applied bool `json:"applied"`
+}
+
+// PlanPartitionExpansion : Preview a partition count increase
+// Gets the topic, checks that the new partition count is an increase, and finds the consumer groups that read the
+// topic with ListConsumerGroups and GetConsumerGroup, without changing the topic.
+//
+// Adding partitions changes the partition that a key maps to, so records with the same key written before and after
+// the change may be consumed out of order, and are kept as separate latest values by compaction.
+func (adminrest *AdminrestV1) PlanPartitionExpansion(expandPartitionsOptions *ExpandPartitionsOptions) (result *PartitionExpansionPlan, err error) {
+	result, err = adminrest.PlanPartitionExpansionWithContext(context.Background(), expandPartitionsOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// PlanPartitionExpansionWithContext is an alternate form of the PlanPartitionExpansion method which supports a Context parameter
+func (adminrest *AdminrestV1) PlanPartitionExpansionWithContext(ctx context.Context, expandPartitionsOptions *ExpandPartitionsOptions) (result *PartitionExpansionPlan, err error) {
+	err = core.ValidateNotNil(expandPartitionsOptions, "expandPartitionsOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(expandPartitionsOptions, "expandPartitionsOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+	topicName := *expandPartitionsOptions.TopicName
+	headers := expandPartitionsOptions.Headers
+
+	topic, _, err := adminrest.GetTopicWithContext(ctx, adminrest.NewGetTopicOptions(topicName).SetHeaders(headers))
+	if err != nil {
+		err = core.SDKErrorf(err, "", "get-topic-error", common.GetComponentInfo())
+		return
+	}
+	result = &PartitionExpansionPlan{
+		TopicName:         topicName,
+		CurrentPartitions: int64Value(topic.Partitions),
+		TargetPartitions:  *expandPartitionsOptions.Partitions,
+		ConsumerGroups:    []PartitionExpansionGroup{},
+		Warnings:          []string{},
+	}
+	if result.TargetPartitions <= result.CurrentPartitions {
+		err = core.SDKErrorf(nil, fmt.Sprintf("topic '%s' has %d partitions; the partition count can only be increased", topicName, result.CurrentPartitions), "partition-count-not-increased", common.GetComponentInfo())
+		result = nil
+		return
+	}
+
+	groups, err := adminrest.consumerGroupDetails(ctx, headers)
+	if err != nil {
+		result = nil
+		return
+	}
+	for _, group := range groups {
+		if entry, reads := partitionExpansionGroup(group, topicName); reads {
+			result.ConsumerGroups = append(result.ConsumerGroups, entry)
+		}
+	}
+
+	result.Compacted = strings.Contains(stringValue(topic.CleanupPolicy), "compact")
+	if result.Compacted {
+		result.Warnings = append(result.Warnings, fmt.Sprintf("topic '%s' is compacted: records with the same key written before and after the change will be in different partitions, so compaction will keep a latest value for the key in each of them", topicName))
+	}
+	var rebalancing []string
+	for _, group := range result.ConsumerGroups {
+		if group.Rebalances {
+			rebalancing = append(rebalancing, group.GroupID)
+		}
+	}
+	if len(rebalancing) > 0 {
+		result.Warnings = append(result.Warnings, fmt.Sprintf("consumer groups %s will rebalance; consumers with auto.offset.reset=latest may skip records written to the new partitions before they are assigned", strings.Join(rebalancing, ", ")))
+	}
+	return
+}
+
+// ExpandPartitions : Increase the partition count of a topic
+// Computes the plan returned by PlanPartitionExpansion, updates the partition count with UpdateTopic and waits until
+// GetTopic reports the new count. If the wait times out, the applied plan is returned together with the error.
+func (adminrest *AdminrestV1) ExpandPartitions(expandPartitionsOptions *ExpandPartitionsOptions) (result *PartitionExpansionPlan, err error) {
+	result, err = adminrest.ExpandPartitionsWithContext(context.Background(), expandPartitionsOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// ExpandPartitionsWithContext is an alternate form of the ExpandPartitions method which supports a Context parameter
+func (adminrest *AdminrestV1) ExpandPartitionsWithContext(ctx context.Context, expandPartitionsOptions *ExpandPartitionsOptions) (result *PartitionExpansionPlan, err error) {
+	result, err = adminrest.PlanPartitionExpansionWithContext(ctx, expandPartitionsOptions)
+	if err != nil {
+		return
+	}
+	headers := expandPartitionsOptions.Headers
+
+	updateTopicOptions := adminrest.NewUpdateTopicOptions(result.TopicName).SetNewTotalPartitionCount(result.TargetPartitions).SetHeaders(headers)
+	_, err = adminrest.UpdateTopicWithContext(ctx, updateTopicOptions)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "update-topic-error", common.GetComponentInfo())
+		return
+	}
+	result.Applied = true
+
+	timeout := DefaultPartitionExpansionTimeout
+	if expandPartitionsOptions.Timeout != nil {
+		timeout = *expandPartitionsOptions.Timeout
+	}
+	pollInterval := DefaultPartitionExpansionPollInterval
+	if expandPartitionsOptions.PollInterval != nil && *expandPartitionsOptions.PollInterval > 0 {
+		pollInterval = *expandPartitionsOptions.PollInterval
+	}
+	deadline := time.NewTimer(timeout)
+	defer deadline.Stop()
+	poll := time.NewTicker(pollInterval)
+	defer poll.Stop()
+	getTopicOptions := adminrest.NewGetTopicOptions(result.TopicName).SetHeaders(headers)
+	for {
+		var topic *TopicDetail
+		topic, _, err = adminrest.GetTopicWithContext(ctx, getTopicOptions)
+		if err != nil {
+			err = core.SDKErrorf(err, "", "get-topic-error", common.GetComponentInfo())
+			return
+		}
+		if int64Value(topic.Partitions) >= result.TargetPartitions {
+			return
+		}
+		select {
+		case <-ctx.Done():
+			err = core.SDKErrorf(ctx.Err(), "", "context-done", common.GetComponentInfo())
+			return
+		case <-deadline.C:
+			err = core.SDKErrorf(nil, fmt.Sprintf("topic '%s' still has %d partitions after %s", result.TopicName, int64Value(topic.Partitions), timeout), "partition-expansion-timeout", common.GetComponentInfo())
+			return
+		case <-poll.C:
+		}
+	}
+}
+
+// partitionExpansionGroup describes how a consumer group reads a topic, and returns whether it reads it at all.
+func partitionExpansionGroup(group *GroupDetail, topicName string) (entry PartitionExpansionGroup, reads bool) {
+	entry = PartitionExpansionGroup{GroupID: stringValue(group.GroupID), State: stringValue(group.State)}
+	for _, member := range group.Members {
+		for _, assignment := range member.Assignments {
+			if stringValue(assignment.Topic) == topicName {
+				entry.Members++
+				reads = true
+				break
+			}
+		}
+	}
+	for _, offset := range group.Offsets {
+		if stringValue(offset.Topic) == topicName {
+			reads = true
+		}
+	}
+	entry.Rebalances = len(group.Members) > 0
+	return
+}
@@ -0,0 +1,169 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package adminrestv1_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	"github.com/IBM/eventstreams-go-sdk/pkg/adminrestv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`ExpandPartitions(expandPartitionsOptions *ExpandPartitionsOptions)`, func() {
+	var testServer *httptest.Server
+	var adminrestService *adminrestv1.AdminrestV1
+	var partitions int64
+	var cleanupPolicy string
+	var reported int64
+	var updates []string
+
+	BeforeEach(func() {
+		partitions = 3
+		cleanupPolicy = "delete"
+		reported = 0
+		updates = nil
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			body, _ := io.ReadAll(req.Body)
+			res.Header().Set("Content-type", "application/json")
+			switch fmt.Sprintf("%s %s", req.Method, req.URL.EscapedPath()) {
+			case "GET /admin/topics/orders":
+				// The new partition count is only reported by the second GetTopic after the update.
+				count := partitions
+				if len(updates) > 0 {
+					reported++
+					if reported < 2 {
+						count = 3
+					}
+				}
+				res.WriteHeader(200)
+				fmt.Fprintf(res, `{"name": "orders", "partitions": %d, "cleanupPolicy": %q}`, count, cleanupPolicy)
+			case "GET /admin/consumergroups":
+				// The first page is full and has no total count, so billing is only found on the second page
+				res.WriteHeader(200)
+				if req.URL.Query().Get("page") == "1" {
+					groupIDs := []string{"idle", "other"}
+					for i := 0; i < 98; i++ {
+						groupIDs = append(groupIDs, fmt.Sprintf("filler-%02d", i))
+					}
+					Expect(json.NewEncoder(res).Encode(groupIDs)).To(Succeed())
+				} else {
+					Expect(req.URL.Query().Get("page")).To(Equal("2"))
+					fmt.Fprintf(res, "%s", `["billing"]`)
+				}
+			case "GET /admin/consumergroups/billing":
+				res.WriteHeader(200)
+				fmt.Fprintf(res, "%s", `{"group_id": "billing", "state": "Stable", "members": [
+					{"consumer_id": "c1", "assignments": [{"topic": "orders", "partition": 0}, {"topic": "orders", "partition": 1}]},
+					{"consumer_id": "c2", "assignments": [{"topic": "orders", "partition": 2}]}
+				], "offsets": [{"topic": "orders", "partition": 0, "current_offset": 5, "end_offset": 10}]}`)
+			case "GET /admin/consumergroups/idle":
+				res.WriteHeader(200)
+				fmt.Fprintf(res, "%s", `{"group_id": "idle", "state": "Empty", "offsets": [
+					{"topic": "orders", "partition": 0, "current_offset": 10, "end_offset": 10}
+				]}`)
+			case "GET /admin/consumergroups/other":
+				res.WriteHeader(200)
+				fmt.Fprintf(res, "%s", `{"group_id": "other", "state": "Stable", "members": [
+					{"consumer_id": "c3", "assignments": [{"topic": "payments", "partition": 0}]}
+				]}`)
+			case "PATCH /admin/topics/orders":
+				updates = append(updates, string(bytes.TrimSpace(body)))
+				partitions = 6
+				res.WriteHeader(202)
+			default:
+				if strings.HasPrefix(req.URL.EscapedPath(), "/admin/consumergroups/filler-") {
+					res.WriteHeader(200)
+					fmt.Fprintf(res, `{"group_id": %q, "state": "Empty"}`, strings.TrimPrefix(req.URL.EscapedPath(), "/admin/consumergroups/"))
+					return
+				}
+				Fail("unexpected request " + req.Method + " " + req.URL.EscapedPath())
+			}
+		}))
+		var serviceErr error
+		adminrestService, serviceErr = adminrestv1.NewAdminrestV1(&adminrestv1.AdminrestV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Previews the consumer groups that read the topic`, func() {
+		plan, err := adminrestService.PlanPartitionExpansion(adminrestService.NewExpandPartitionsOptions("orders", 6))
+		Expect(err).To(BeNil())
+		Expect(plan.CurrentPartitions).To(Equal(int64(3)))
+		Expect(plan.TargetPartitions).To(Equal(int64(6)))
+		Expect(plan.Compacted).To(BeFalse())
+		Expect(plan.ConsumerGroups).To(Equal([]adminrestv1.PartitionExpansionGroup{
+			{GroupID: "billing", State: "Stable", Members: 2, Rebalances: true},
+			{GroupID: "idle", State: "Empty", Members: 0, Rebalances: false},
+		}))
+		Expect(plan.Warnings).To(HaveLen(1))
+		Expect(plan.Warnings[0]).To(ContainSubstring("consumer groups billing will rebalance"))
+		Expect(plan.Applied).To(BeFalse())
+		Expect(updates).To(BeEmpty())
+	})
+	It(`Warns for compacted topics`, func() {
+		cleanupPolicy = "compact,delete"
+		plan, err := adminrestService.PlanPartitionExpansion(adminrestService.NewExpandPartitionsOptions("orders", 4))
+		Expect(err).To(BeNil())
+		Expect(plan.Compacted).To(BeTrue())
+		Expect(plan.Warnings).To(HaveLen(2))
+		Expect(plan.Warnings[0]).To(ContainSubstring("topic 'orders' is compacted"))
+	})
+	It(`Applies the change and waits for the new partition count`, func() {
+		options := adminrestService.NewExpandPartitionsOptions("orders", 6).SetPollInterval(time.Millisecond)
+		plan, err := adminrestService.ExpandPartitions(options)
+		Expect(err).To(BeNil())
+		Expect(plan.Applied).To(BeTrue())
+		Expect(updates).To(Equal([]string{`{"new_total_partition_count":6}`}))
+		Expect(reported).To(Equal(int64(2)))
+	})
+	It(`Times out when the new partition count is not reported`, func() {
+		options := adminrestService.NewExpandPartitionsOptions("orders", 6).
+			SetPollInterval(time.Millisecond).
+			SetTimeout(0)
+		reported = -1000
+		plan, err := adminrestService.ExpandPartitions(options)
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("still has 3 partitions"))
+		Expect(plan.Applied).To(BeTrue())
+	})
+	It(`Rejects invalid options`, func() {
+		_, err := adminrestService.PlanPartitionExpansion(nil)
+		Expect(err).ToNot(BeNil())
+		_, err = adminrestService.PlanPartitionExpansion(adminrestService.NewExpandPartitionsOptions("", 6))
+		Expect(err).ToNot(BeNil())
+		_, err = adminrestService.ExpandPartitions(adminrestService.NewExpandPartitionsOptions("orders", 3))
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("can only be increased"))
+		Expect(updates).To(BeEmpty())
+	})
+})
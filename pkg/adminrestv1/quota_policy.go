@@ -0,0 +1,364 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package adminrestv1
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	common "github.com/IBM/eventstreams-go-sdk/pkg/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+const (
+	// QuotaEntityNameDefault is the entity name of the quota applied to entities that have no quota of their own.
+	QuotaEntityNameDefault = "default"
+
+	// QuotaEntityNameServiceIDPrefix is the prefix of entity names that identify an IAM Service ID.
+	QuotaEntityNameServiceIDPrefix = "iam-ServiceId-"
+)
+
+// Constants associated with the QuotaChange.Action property.
+// The operation needed to bring an entity's quota to the desired state.
+const (
+	QuotaChangeActionCreateConst = "create"
+	QuotaChangeActionUpdateConst = "update"
+	QuotaChangeActionDeleteConst = "delete"
+)
+
+var serviceIDPattern = regexp.MustCompile(`^` + QuotaEntityNameServiceIDPrefix + `[A-Za-z0-9][A-Za-z0-9-]*$`)
+
+var byteRatePattern = regexp.MustCompile(`^([0-9]+(?:\.[0-9]+)?)\s*([A-Za-z]*)(?:/s)?$`)
+
+var byteRateUnits = map[string]float64{
+	"":    1,
+	"b":   1,
+	"kb":  1e3,
+	"mb":  1e6,
+	"gb":  1e9,
+	"kib": 1 << 10,
+	"mib": 1 << 20,
+	"gib": 1 << 30,
+}
+
+// ParseByteRate parses a human-readable byte rate such as "10MiB/s", "512 KB/s" or "1048576" into bytes per second.
+// Decimal (KB, MB, GB) and binary (KiB, MiB, GiB) units are accepted, case-insensitively, with an optional "/s"
+// suffix. A value with no unit is a number of bytes per second.
+func ParseByteRate(rate string) (bytesPerSecond int64, err error) {
+	matches := byteRatePattern.FindStringSubmatch(strings.TrimSpace(rate))
+	if matches == nil {
+		err = core.SDKErrorf(nil, fmt.Sprintf("invalid byte rate '%s'", rate), "invalid-byte-rate", common.GetComponentInfo())
+		return
+	}
+	multiplier, ok := byteRateUnits[strings.ToLower(matches[2])]
+	if !ok {
+		err = core.SDKErrorf(nil, fmt.Sprintf("invalid byte rate '%s': unknown unit '%s'", rate, matches[2]), "invalid-byte-rate", common.GetComponentInfo())
+		return
+	}
+	value, err := strconv.ParseFloat(matches[1], 64)
+	if err != nil {
+		err = core.SDKErrorf(err, fmt.Sprintf("invalid byte rate '%s'", rate), "invalid-byte-rate", common.GetComponentInfo())
+		return
+	}
+	bytes := value * multiplier
+	// float64(math.MaxInt64) rounds up to 2^63, which does not fit in an int64.
+	if bytes >= math.MaxInt64 {
+		err = core.SDKErrorf(nil, fmt.Sprintf("invalid byte rate '%s': too large", rate), "invalid-byte-rate", common.GetComponentInfo())
+		return
+	}
+	if bytes != math.Trunc(bytes) {
+		err = core.SDKErrorf(nil, fmt.Sprintf("invalid byte rate '%s': not a whole number of bytes", rate), "invalid-byte-rate", common.GetComponentInfo())
+		return
+	}
+	bytesPerSecond = int64(bytes)
+	return
+}
+
+// FormatByteRate renders bytesPerSecond using the largest binary unit that represents it exactly, e.g. "10MiB/s".
+func FormatByteRate(bytesPerSecond int64) string {
+	for _, unit := range []struct {
+		name string
+		size int64
+	}{{"GiB", 1 << 30}, {"MiB", 1 << 20}, {"KiB", 1 << 10}} {
+		if bytesPerSecond != 0 && bytesPerSecond%unit.size == 0 {
+			return fmt.Sprintf("%d%s/s", bytesPerSecond/unit.size, unit.name)
+		}
+	}
+	return fmt.Sprintf("%dB/s", bytesPerSecond)
+}
+
+// ValidateQuotaEntityName checks that entityName is either `default` or an IAM Service ID with the `iam-ServiceId-`
+// prefix.
+func ValidateQuotaEntityName(entityName string) (err error) {
+	if entityName == QuotaEntityNameDefault || serviceIDPattern.MatchString(entityName) {
+		return
+	}
+	err = core.SDKErrorf(nil, fmt.Sprintf("invalid quota entity name '%s': must be '%s' or start with '%s'", entityName, QuotaEntityNameDefault, QuotaEntityNameServiceIDPrefix), "invalid-entity-name", common.GetComponentInfo())
+	return
+}
+
+// QuotaRates : The producer and consumer byte rates of a quota. A nil rate is not managed and is left unchanged.
+type QuotaRates struct {
+	// The producer byte rate quota value.
+	ProducerByteRate *int64 `json:"producer_byte_rate,omitempty"`
+
+	// The consumer byte rate quota value.
+	ConsumerByteRate *int64 `json:"consumer_byte_rate,omitempty"`
+}
+
+// NewQuotaRates parses human-readable producer and consumer byte rates (see ParseByteRate). An empty string leaves the
+// corresponding rate unset.
+func NewQuotaRates(producerByteRate string, consumerByteRate string) (rates *QuotaRates, err error) {
+	rates = &QuotaRates{}
+	if producerByteRate != "" {
+		var value int64
+		value, err = ParseByteRate(producerByteRate)
+		if err != nil {
+			rates = nil
+			return
+		}
+		rates.ProducerByteRate = core.Int64Ptr(value)
+	}
+	if consumerByteRate != "" {
+		var value int64
+		value, err = ParseByteRate(consumerByteRate)
+		if err != nil {
+			rates = nil
+			return
+		}
+		rates.ConsumerByteRate = core.Int64Ptr(value)
+	}
+	return
+}
+
+// String renders the rates using FormatByteRate.
+func (rates *QuotaRates) String() string {
+	format := func(rate *int64) string {
+		if rate == nil {
+			return "-"
+		}
+		return FormatByteRate(*rate)
+	}
+	return fmt.Sprintf("producer=%s consumer=%s", format(rates.ProducerByteRate), format(rates.ConsumerByteRate))
+}
+
+// QuotaChange : A single change needed to bring an entity's quota to the desired state.
+type QuotaChange struct {
+	// The operation, one of the QuotaChangeAction constants.
+	Action string `json:"action"`
+
+	// The entity whose quota changes.
+	EntityName string `json:"entity_name"`
+
+	// The current quota, nil when the entity has no quota.
+	Current *QuotaRates `json:"current,omitempty"`
+
+	// The desired quota, nil when the quota is deleted.
+	Desired *QuotaRates `json:"desired,omitempty"`
+
+	// Whether the change has been applied.
+	Applied bool `json:"applied"`
+}
+
+// QuotaPlan : The changes needed to reconcile the quotas of an instance with a desired policy.
+type QuotaPlan struct {
+	// The changes, ordered by entity name.
+	Changes []QuotaChange `json:"changes"`
+}
+
+// ApplyQuotasOptions : The PlanQuotas and ApplyQuotas options.
+type ApplyQuotasOptions struct {
+	// The desired quotas, keyed by entity name (`default` or an `iam-ServiceId-` Service ID).
+	Desired map[string]QuotaRates `validate:"required"`
+
+	// When true, quotas of entities that are not in Desired are deleted.
+	Prune *bool
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewApplyQuotasOptions : Instantiate ApplyQuotasOptions
+func (*AdminrestV1) NewApplyQuotasOptions(desired map[string]QuotaRates) *ApplyQuotasOptions {
+	return &ApplyQuotasOptions{
+		Desired: desired,
+	}
+}
+
+// SetDesired : Allow user to set Desired
+func (_options *ApplyQuotasOptions) SetDesired(desired map[string]QuotaRates) *ApplyQuotasOptions {
+	_options.Desired = desired
+	return _options
+}
+
+// SetPrune : Allow user to set Prune
+func (_options *ApplyQuotasOptions) SetPrune(prune bool) *ApplyQuotasOptions {
+	_options.Prune = core.BoolPtr(prune)
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *ApplyQuotasOptions) SetHeaders(param map[string]string) *ApplyQuotasOptions {
+	options.Headers = param
+	return options
+}
+
+// PlanQuotas : Compute the quota changes needed to match a desired policy
+// Validates every entity name in the policy, lists the current quotas and returns the changes that ApplyQuotas would
+// make, without making them.
+func (adminrest *AdminrestV1) PlanQuotas(applyQuotasOptions *ApplyQuotasOptions) (result *QuotaPlan, err error) {
+	result, err = adminrest.PlanQuotasWithContext(context.Background(), applyQuotasOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// PlanQuotasWithContext is an alternate form of the PlanQuotas method which supports a Context parameter
+func (adminrest *AdminrestV1) PlanQuotasWithContext(ctx context.Context, applyQuotasOptions *ApplyQuotasOptions) (result *QuotaPlan, err error) {
+	err = core.ValidateNotNil(applyQuotasOptions, "applyQuotasOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(applyQuotasOptions, "applyQuotasOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+	for entityName := range applyQuotasOptions.Desired {
+		err = ValidateQuotaEntityName(entityName)
+		if err != nil {
+			return
+		}
+	}
+
+	listQuotasOptions := adminrest.NewListQuotasOptions().SetHeaders(applyQuotasOptions.Headers)
+	quotas, _, err := adminrest.ListQuotasWithContext(ctx, listQuotasOptions)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "list-quotas-error", common.GetComponentInfo())
+		return
+	}
+	var current []EntityQuotaDetail
+	if quotas != nil {
+		current = quotas.Data
+	}
+	prune := applyQuotasOptions.Prune != nil && *applyQuotasOptions.Prune
+	result = DiffQuotas(current, applyQuotasOptions.Desired, prune)
+	return
+}
+
+// ApplyQuotas : Reconcile quotas with a desired policy
+// Computes the plan returned by PlanQuotas and applies it with CreateQuota, UpdateQuota and DeleteQuota. Changes are
+// applied in plan order; if one fails, the error is returned together with the plan, in which the changes made so far
+// are marked as applied.
+func (adminrest *AdminrestV1) ApplyQuotas(applyQuotasOptions *ApplyQuotasOptions) (result *QuotaPlan, err error) {
+	result, err = adminrest.ApplyQuotasWithContext(context.Background(), applyQuotasOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// ApplyQuotasWithContext is an alternate form of the ApplyQuotas method which supports a Context parameter
+func (adminrest *AdminrestV1) ApplyQuotasWithContext(ctx context.Context, applyQuotasOptions *ApplyQuotasOptions) (result *QuotaPlan, err error) {
+	result, err = adminrest.PlanQuotasWithContext(ctx, applyQuotasOptions)
+	if err != nil {
+		return
+	}
+	headers := applyQuotasOptions.Headers
+
+	for i := range result.Changes {
+		change := &result.Changes[i]
+		switch change.Action {
+		case QuotaChangeActionCreateConst:
+			createQuotaOptions := adminrest.NewCreateQuotaOptions(change.EntityName).SetHeaders(headers)
+			createQuotaOptions.ProducerByteRate = change.Desired.ProducerByteRate
+			createQuotaOptions.ConsumerByteRate = change.Desired.ConsumerByteRate
+			_, err = adminrest.CreateQuotaWithContext(ctx, createQuotaOptions)
+		case QuotaChangeActionUpdateConst:
+			updateQuotaOptions := adminrest.NewUpdateQuotaOptions(change.EntityName).SetHeaders(headers)
+			updateQuotaOptions.ProducerByteRate = change.Desired.ProducerByteRate
+			updateQuotaOptions.ConsumerByteRate = change.Desired.ConsumerByteRate
+			_, err = adminrest.UpdateQuotaWithContext(ctx, updateQuotaOptions)
+		case QuotaChangeActionDeleteConst:
+			deleteQuotaOptions := adminrest.NewDeleteQuotaOptions(change.EntityName).SetHeaders(headers)
+			_, err = adminrest.DeleteQuotaWithContext(ctx, deleteQuotaOptions)
+		}
+		if err != nil {
+			err = core.SDKErrorf(err, fmt.Sprintf("failed to %s quota for '%s'", change.Action, change.EntityName), "apply-quota-error", common.GetComponentInfo())
+			return
+		}
+		change.Applied = true
+	}
+	return
+}
+
+// DiffQuotas returns the changes needed to turn the current quotas into the desired ones. A desired rate that is nil is
+// not managed and never causes a change. When prune is true, quotas of entities missing from desired are deleted.
+func DiffQuotas(current []EntityQuotaDetail, desired map[string]QuotaRates, prune bool) (plan *QuotaPlan) {
+	plan = &QuotaPlan{Changes: []QuotaChange{}}
+
+	currentByName := make(map[string]*QuotaRates, len(current))
+	for _, quota := range current {
+		if quota.EntityName == nil {
+			continue
+		}
+		currentByName[*quota.EntityName] = &QuotaRates{
+			ProducerByteRate: quota.ProducerByteRate,
+			ConsumerByteRate: quota.ConsumerByteRate,
+		}
+	}
+
+	names := make([]string, 0, len(currentByName)+len(desired))
+	for name := range desired {
+		names = append(names, name)
+	}
+	for name := range currentByName {
+		if _, ok := desired[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		existing, exists := currentByName[name]
+		wanted, managed := desired[name]
+		switch {
+		case managed && !exists:
+			if wanted.ProducerByteRate == nil && wanted.ConsumerByteRate == nil {
+				continue
+			}
+			plan.Changes = append(plan.Changes, QuotaChange{Action: QuotaChangeActionCreateConst, EntityName: name, Desired: &wanted})
+		case managed && exists:
+			if rateDiffers(wanted.ProducerByteRate, existing.ProducerByteRate) || rateDiffers(wanted.ConsumerByteRate, existing.ConsumerByteRate) {
+				plan.Changes = append(plan.Changes, QuotaChange{Action: QuotaChangeActionUpdateConst, EntityName: name, Current: existing, Desired: &wanted})
+			}
+		case prune:
+			plan.Changes = append(plan.Changes, QuotaChange{Action: QuotaChangeActionDeleteConst, EntityName: name, Current: existing})
+		}
+	}
+	return
+}
+
+func rateDiffers(desired *int64, current *int64) bool {
+	if desired == nil {
+		return false
+	}
+	return current == nil || *current != *desired
+}
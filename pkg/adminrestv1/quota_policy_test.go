@@ -0,0 +1,171 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package adminrestv1_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/eventstreams-go-sdk/pkg/adminrestv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Quota policy helpers`, func() {
+	Describe(`ParseByteRate() and FormatByteRate()`, func() {
+		It(`Parses human-readable byte rates`, func() {
+			for input, expected := range map[string]int64{
+				"1048576":    1048576,
+				"10MiB/s":    10 * 1024 * 1024,
+				"512 KB/s":   512000,
+				"1.5GiB/s":   3 * 512 * 1024 * 1024,
+				"2 mb":       2000000,
+				" 100B/s ":   100,
+				"0":          0,
+				"64kib/s":    64 * 1024,
+				"1.25 KiB/s": 1280,
+				// The largest float64 below 2^63
+				"9223372036854774784": 9223372036854774784,
+			} {
+				value, err := adminrestv1.ParseByteRate(input)
+				Expect(err).To(BeNil(), input)
+				Expect(value).To(Equal(expected), input)
+			}
+		})
+		It(`Rejects invalid byte rates`, func() {
+			for _, input := range []string{"", "fast", "10 XB/s", "-1", "1.0001KB", "10MiB/m", "9223372036854775807", "9223372036854775808", "8589934592GiB/s"} {
+				_, err := adminrestv1.ParseByteRate(input)
+				Expect(err).ToNot(BeNil(), input)
+			}
+		})
+		It(`Formats byte rates`, func() {
+			Expect(adminrestv1.FormatByteRate(10 * 1024 * 1024)).To(Equal("10MiB/s"))
+			Expect(adminrestv1.FormatByteRate(1536)).To(Equal("1536B/s"))
+			Expect(adminrestv1.FormatByteRate(2048)).To(Equal("2KiB/s"))
+			Expect(adminrestv1.FormatByteRate(0)).To(Equal("0B/s"))
+			rates, err := adminrestv1.NewQuotaRates("1GiB/s", "")
+			Expect(err).To(BeNil())
+			Expect(rates.String()).To(Equal("producer=1GiB/s consumer=-"))
+			_, err = adminrestv1.NewQuotaRates("", "slow")
+			Expect(err).ToNot(BeNil())
+		})
+	})
+
+	Describe(`ValidateQuotaEntityName()`, func() {
+		It(`Accepts default and Service IDs only`, func() {
+			Expect(adminrestv1.ValidateQuotaEntityName("default")).To(BeNil())
+			Expect(adminrestv1.ValidateQuotaEntityName("iam-ServiceId-1234abcd-12ab-34cd-56ef-1234567890ab")).To(BeNil())
+			Expect(adminrestv1.ValidateQuotaEntityName("Default")).ToNot(BeNil())
+			Expect(adminrestv1.ValidateQuotaEntityName("iam-ServiceId-")).ToNot(BeNil())
+			Expect(adminrestv1.ValidateQuotaEntityName("ServiceId-1234")).ToNot(BeNil())
+			Expect(adminrestv1.ValidateQuotaEntityName("")).ToNot(BeNil())
+		})
+	})
+
+	Describe(`ApplyQuotas(applyQuotasOptions *ApplyQuotasOptions)`, func() {
+		var testServer *httptest.Server
+		var requests []string
+
+		BeforeEach(func() {
+			requests = nil
+			testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				if req.URL.EscapedPath() == "/admin/quotas" {
+					res.Header().Set("Content-type", "application/json")
+					res.WriteHeader(200)
+					fmt.Fprintf(res, "%s", `{"data": [
+						{"entity_name": "default", "producer_byte_rate": 1048576, "consumer_byte_rate": 1048576},
+						{"entity_name": "iam-ServiceId-aaa", "producer_byte_rate": 2048},
+						{"entity_name": "iam-ServiceId-old", "consumer_byte_rate": 2048}
+					]}`)
+					return
+				}
+				body := map[string]int64{}
+				if req.Method != "DELETE" {
+					Expect(json.NewDecoder(req.Body).Decode(&body)).To(Succeed())
+				}
+				requests = append(requests, fmt.Sprintf("%s %s %v", req.Method, req.URL.EscapedPath(), body))
+				res.WriteHeader(202)
+			}))
+		})
+		AfterEach(func() {
+			testServer.Close()
+		})
+
+		It(`Plans and applies the changes needed to reach the desired quotas`, func() {
+			adminrestService, serviceErr := adminrestv1.NewAdminrestV1(&adminrestv1.AdminrestV1Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+
+			defaultRates, err := adminrestv1.NewQuotaRates("1MiB/s", "1MiB/s")
+			Expect(err).To(BeNil())
+			aaaRates, err := adminrestv1.NewQuotaRates("4KiB/s", "")
+			Expect(err).To(BeNil())
+			bbbRates, err := adminrestv1.NewQuotaRates("10MiB/s", "20MiB/s")
+			Expect(err).To(BeNil())
+			desired := map[string]adminrestv1.QuotaRates{
+				"default":           *defaultRates,
+				"iam-ServiceId-aaa": *aaaRates,
+				"iam-ServiceId-bbb": *bbbRates,
+			}
+
+			options := adminrestService.NewApplyQuotasOptions(desired)
+			plan, err := adminrestService.PlanQuotas(options)
+			Expect(err).To(BeNil())
+			Expect(plan.Changes).To(HaveLen(2))
+			Expect(requests).To(BeEmpty())
+
+			plan, err = adminrestService.ApplyQuotas(options.SetPrune(true))
+			Expect(err).To(BeNil())
+			Expect(plan.Changes).To(HaveLen(3))
+			Expect(plan.Changes[0].Action).To(Equal(adminrestv1.QuotaChangeActionUpdateConst))
+			Expect(plan.Changes[0].EntityName).To(Equal("iam-ServiceId-aaa"))
+			Expect(plan.Changes[1].Action).To(Equal(adminrestv1.QuotaChangeActionCreateConst))
+			Expect(plan.Changes[2].Action).To(Equal(adminrestv1.QuotaChangeActionDeleteConst))
+			for _, change := range plan.Changes {
+				Expect(change.Applied).To(BeTrue())
+			}
+			Expect(requests).To(Equal([]string{
+				"PATCH /admin/quotas/iam-ServiceId-aaa map[producer_byte_rate:4096]",
+				"POST /admin/quotas/iam-ServiceId-bbb map[consumer_byte_rate:20971520 producer_byte_rate:10485760]",
+				"DELETE /admin/quotas/iam-ServiceId-old map[]",
+			}))
+		})
+
+		It(`Validates entity names before making any request`, func() {
+			adminrestService, serviceErr := adminrestv1.NewAdminrestV1(&adminrestv1.AdminrestV1Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+
+			_, err := adminrestService.ApplyQuotas(nil)
+			Expect(err).ToNot(BeNil())
+			options := adminrestService.NewApplyQuotasOptions(map[string]adminrestv1.QuotaRates{"my-app": {}})
+			_, err = adminrestService.ApplyQuotas(options)
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("invalid quota entity name"))
+			Expect(requests).To(BeEmpty())
+		})
+	})
+})
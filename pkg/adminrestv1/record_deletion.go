@@ -0,0 +1,407 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package adminrestv1
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	common "github.com/IBM/eventstreams-go-sdk/pkg/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Constants associated with the TopicRecordDeletionOptions.Mode property.
+// How the offsets before which records are deleted are chosen.
+const (
+	// TopicRecordDeletionOptionsModeAllConst deletes every record, up to the end offset of each partition.
+	TopicRecordDeletionOptionsModeAllConst = "all"
+
+	// TopicRecordDeletionOptionsModeBeforeTimeConst deletes the records with a timestamp before Before.
+	TopicRecordDeletionOptionsModeBeforeTimeConst = "before_time"
+
+	// TopicRecordDeletionOptionsModeCommittedConst deletes the records before the committed offsets of GroupID.
+	TopicRecordDeletionOptionsModeCommittedConst = "committed"
+)
+
+// The modes of UpdateConsumerGroup requests, and the format of the value of a request with mode 'datetime'.
+const (
+	consumerGroupModeEarliest   = "earliest"
+	consumerGroupModeLatest     = "latest"
+	consumerGroupModeDatetime   = "datetime"
+	consumerGroupDatetimeFormat = "2006-01-02T15:04:05.000Z07:00"
+)
+
+// TopicRecordDeletionOptions : The PlanTopicRecordDeletion and ApplyTopicRecordDeletion options.
+type TopicRecordDeletionOptions struct {
+	// The name of the topic whose records are deleted.
+	TopicName *string `validate:"required,ne="`
+
+	// How the offsets before which records are deleted are chosen, one of the TopicRecordDeletionOptionsMode constants.
+	Mode *string `validate:"required,oneof=all before_time committed"`
+
+	// The time before which records are deleted, required in before_time mode.
+	Before *time.Time
+
+	// The consumer group whose committed offsets the topic is truncated to, required in committed mode. In the other
+	// modes, the group used to look up the offsets of the topic; when not set, the first group by ID that has committed
+	// offsets on the topic is used.
+	GroupID *string
+
+	// When true, records are deleted even past the committed offset of a consumer group.
+	Force *bool
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewTopicRecordDeletionOptions : Instantiate TopicRecordDeletionOptions
+func (*AdminrestV1) NewTopicRecordDeletionOptions(topicName string, mode string) *TopicRecordDeletionOptions {
+	return &TopicRecordDeletionOptions{
+		TopicName: core.StringPtr(topicName),
+		Mode:      core.StringPtr(mode),
+	}
+}
+
+// SetTopicName : Allow user to set TopicName
+func (_options *TopicRecordDeletionOptions) SetTopicName(topicName string) *TopicRecordDeletionOptions {
+	_options.TopicName = core.StringPtr(topicName)
+	return _options
+}
+
+// SetMode : Allow user to set Mode
+func (_options *TopicRecordDeletionOptions) SetMode(mode string) *TopicRecordDeletionOptions {
+	_options.Mode = core.StringPtr(mode)
+	return _options
+}
+
+// SetBefore : Allow user to set Before
+func (_options *TopicRecordDeletionOptions) SetBefore(before time.Time) *TopicRecordDeletionOptions {
+	_options.Before = &before
+	return _options
+}
+
+// SetGroupID : Allow user to set GroupID
+func (_options *TopicRecordDeletionOptions) SetGroupID(groupID string) *TopicRecordDeletionOptions {
+	_options.GroupID = core.StringPtr(groupID)
+	return _options
+}
+
+// SetForce : Allow user to set Force
+func (_options *TopicRecordDeletionOptions) SetForce(force bool) *TopicRecordDeletionOptions {
+	_options.Force = core.BoolPtr(force)
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *TopicRecordDeletionOptions) SetHeaders(param map[string]string) *TopicRecordDeletionOptions {
+	options.Headers = param
+	return options
+}
+
+// RecordDeletionPartition : The records of a partition that a deletion removes.
+type RecordDeletionPartition struct {
+	// The ID of the partition.
+	Partition int64 `json:"partition"`
+
+	// The offset of the first record of the partition.
+	StartOffset int64 `json:"start_offset"`
+
+	// The offset after the last record of the partition.
+	EndOffset int64 `json:"end_offset"`
+
+	// The offset before which records are deleted.
+	BeforeOffset int64 `json:"before_offset"`
+
+	// The number of records deleted, ignoring gaps left by compaction or transactions.
+	Records int64 `json:"records"`
+}
+
+// RecordDeletionViolation : A partition where a deletion would remove records that a consumer group has not consumed.
+type RecordDeletionViolation struct {
+	// The ID of the partition.
+	Partition int64 `json:"partition"`
+
+	// The ID of the consumer group.
+	GroupID string `json:"group_id"`
+
+	// The committed offset of the group.
+	CommittedOffset int64 `json:"committed_offset"`
+
+	// The offset before which records would be deleted.
+	BeforeOffset int64 `json:"before_offset"`
+}
+
+// RecordDeletionPlan : The records that a deletion removes from a topic.
+type RecordDeletionPlan struct {
+	// The name of the topic.
+	TopicName string `json:"topic_name"`
+
+	// The deletion mode.
+	Mode string `json:"mode"`
+
+	// The partitions, ordered by ID.
+	Partitions []RecordDeletionPartition `json:"partitions"`
+
+	// The total number of records deleted.
+	Records int64 `json:"records"`
+
+	// The partitions where records not yet consumed by a consumer group would be deleted.
+	Violations []RecordDeletionViolation `json:"violations"`
+
+	// Whether the records have been deleted.
+	Applied bool `json:"applied"`
+}
+
+// PlanTopicRecordDeletion : Preview a record deletion
+// Looks up the start and end offsets of the partitions of the topic, the offsets that the deletion mode deletes up
+// to and the committed offsets of the consumer groups that read the topic, and returns the records that
+// ApplyTopicRecordDeletion would delete, without deleting them.
+//
+// The committed offsets come from GetConsumerGroup for every group returned by ListConsumerGroups. The start, end and
+// time-based offsets come from UpdateConsumerGroup requests that are not executed, on the group given by GroupID or
+// on the first group that has committed offsets on the topic, so at least one group must read the topic.
+func (adminrest *AdminrestV1) PlanTopicRecordDeletion(topicRecordDeletionOptions *TopicRecordDeletionOptions) (result *RecordDeletionPlan, err error) {
+	result, err = adminrest.PlanTopicRecordDeletionWithContext(context.Background(), topicRecordDeletionOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// PlanTopicRecordDeletionWithContext is an alternate form of the PlanTopicRecordDeletion method which supports a Context parameter
+func (adminrest *AdminrestV1) PlanTopicRecordDeletionWithContext(ctx context.Context, topicRecordDeletionOptions *TopicRecordDeletionOptions) (result *RecordDeletionPlan, err error) {
+	err = core.ValidateNotNil(topicRecordDeletionOptions, "topicRecordDeletionOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(topicRecordDeletionOptions, "topicRecordDeletionOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+	topicName := *topicRecordDeletionOptions.TopicName
+	mode := *topicRecordDeletionOptions.Mode
+	groupID := stringValue(topicRecordDeletionOptions.GroupID)
+	if mode == TopicRecordDeletionOptionsModeBeforeTimeConst && topicRecordDeletionOptions.Before == nil {
+		err = core.SDKErrorf(nil, "Before must be set in before_time mode", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+	if mode == TopicRecordDeletionOptionsModeCommittedConst && groupID == "" {
+		err = core.SDKErrorf(nil, "GroupID must be set in committed mode", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+	headers := topicRecordDeletionOptions.Headers
+
+	committed, err := adminrest.committedTopicOffsets(ctx, topicName, headers)
+	if err != nil {
+		return
+	}
+	if mode == TopicRecordDeletionOptionsModeCommittedConst && committed[groupID] == nil {
+		err = core.SDKErrorf(nil, fmt.Sprintf("consumer group '%s' has no committed offsets on topic '%s'", groupID, topicName), "record-deletion-offsets-error", common.GetComponentInfo())
+		return
+	}
+	if groupID == "" {
+		groupIDs := make([]string, 0, len(committed))
+		for id := range committed {
+			groupIDs = append(groupIDs, id)
+		}
+		if len(groupIDs) == 0 {
+			err = core.SDKErrorf(nil, fmt.Sprintf("no consumer group has committed offsets on topic '%s' to look up its offsets with", topicName), "record-deletion-offsets-error", common.GetComponentInfo())
+			return
+		}
+		sort.Strings(groupIDs)
+		groupID = groupIDs[0]
+	}
+
+	startOffsets, err := adminrest.lookupTopicOffsets(ctx, groupID, topicName, consumerGroupModeEarliest, "", headers)
+	if err != nil {
+		return
+	}
+	endOffsets, err := adminrest.lookupTopicOffsets(ctx, groupID, topicName, consumerGroupModeLatest, "", headers)
+	if err != nil {
+		return
+	}
+	var beforeOffsets map[int64]int64
+	switch mode {
+	case TopicRecordDeletionOptionsModeAllConst:
+		beforeOffsets = endOffsets
+	case TopicRecordDeletionOptionsModeBeforeTimeConst:
+		value := topicRecordDeletionOptions.Before.UTC().Format(consumerGroupDatetimeFormat)
+		beforeOffsets, err = adminrest.lookupTopicOffsets(ctx, groupID, topicName, consumerGroupModeDatetime, value, headers)
+		if err != nil {
+			return
+		}
+	case TopicRecordDeletionOptionsModeCommittedConst:
+		beforeOffsets = committed[groupID]
+	}
+
+	result = BuildRecordDeletionPlan(topicName, mode, startOffsets, endOffsets, beforeOffsets, committed)
+	return
+}
+
+// ApplyTopicRecordDeletion : Delete records from a topic
+// Computes the plan returned by PlanTopicRecordDeletion and deletes its records with a single DeleteTopicRecords
+// request. Unless Force is set, nothing is deleted if the plan has violations, that is if records that a consumer
+// group has not consumed would be deleted; the plan is returned with the error.
+func (adminrest *AdminrestV1) ApplyTopicRecordDeletion(topicRecordDeletionOptions *TopicRecordDeletionOptions) (result *RecordDeletionPlan, err error) {
+	result, err = adminrest.ApplyTopicRecordDeletionWithContext(context.Background(), topicRecordDeletionOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// ApplyTopicRecordDeletionWithContext is an alternate form of the ApplyTopicRecordDeletion method which supports a Context parameter
+func (adminrest *AdminrestV1) ApplyTopicRecordDeletionWithContext(ctx context.Context, topicRecordDeletionOptions *TopicRecordDeletionOptions) (result *RecordDeletionPlan, err error) {
+	result, err = adminrest.PlanTopicRecordDeletionWithContext(ctx, topicRecordDeletionOptions)
+	if err != nil {
+		return
+	}
+	force := topicRecordDeletionOptions.Force != nil && *topicRecordDeletionOptions.Force
+	if len(result.Violations) > 0 && !force {
+		var groups []string
+		for _, violation := range result.Violations {
+			if !containsString(groups, violation.GroupID) {
+				groups = append(groups, violation.GroupID)
+			}
+		}
+		err = core.SDKErrorf(nil, fmt.Sprintf("deleting records of topic '%s' would delete records not consumed by consumer groups %s", result.TopicName, strings.Join(groups, ", ")), "record-deletion-guard", common.GetComponentInfo())
+		return
+	}
+
+	var recordsToDelete []RecordDeleteRequestRecordsToDeleteItem
+	for _, partition := range result.Partitions {
+		if partition.Records > 0 {
+			recordsToDelete = append(recordsToDelete, RecordDeleteRequestRecordsToDeleteItem{
+				Partition:    core.Int64Ptr(partition.Partition),
+				BeforeOffset: core.Int64Ptr(partition.BeforeOffset),
+			})
+		}
+	}
+	if len(recordsToDelete) > 0 {
+		deleteTopicRecordsOptions := adminrest.NewDeleteTopicRecordsOptions(result.TopicName).SetRecordsToDelete(recordsToDelete).SetHeaders(topicRecordDeletionOptions.Headers)
+		_, err = adminrest.DeleteTopicRecordsWithContext(ctx, deleteTopicRecordsOptions)
+		if err != nil {
+			err = core.SDKErrorf(err, "", "delete-records-error", common.GetComponentInfo())
+			return
+		}
+	}
+	result.Applied = true
+	return
+}
+
+// BuildRecordDeletionPlan computes the records deleted from every partition that has start and end offsets, given
+// the offsets before which records are deleted and the committed offsets of consumer groups, keyed by group ID and
+// then by partition. Partitions missing from beforeOffsets are left untouched, and offsets beyond the end of a
+// partition are capped.
+func BuildRecordDeletionPlan(topicName string, mode string, startOffsets map[int64]int64, endOffsets map[int64]int64, beforeOffsets map[int64]int64, committed map[string]map[int64]int64) (plan *RecordDeletionPlan) {
+	plan = &RecordDeletionPlan{
+		TopicName:  topicName,
+		Mode:       mode,
+		Partitions: []RecordDeletionPartition{},
+		Violations: []RecordDeletionViolation{},
+	}
+	partitions := make([]int64, 0, len(endOffsets))
+	for partition := range endOffsets {
+		if _, ok := startOffsets[partition]; ok {
+			partitions = append(partitions, partition)
+		}
+	}
+	sort.Slice(partitions, func(i, j int) bool { return partitions[i] < partitions[j] })
+	groupIDs := make([]string, 0, len(committed))
+	for groupID := range committed {
+		groupIDs = append(groupIDs, groupID)
+	}
+	sort.Strings(groupIDs)
+
+	for _, partition := range partitions {
+		entry := RecordDeletionPartition{
+			Partition:    partition,
+			StartOffset:  startOffsets[partition],
+			EndOffset:    endOffsets[partition],
+			BeforeOffset: startOffsets[partition],
+		}
+		if before, ok := beforeOffsets[partition]; ok {
+			entry.BeforeOffset = minInt64(before, entry.EndOffset)
+		}
+		if entry.BeforeOffset > entry.StartOffset {
+			entry.Records = entry.BeforeOffset - entry.StartOffset
+		}
+		plan.Records += entry.Records
+		plan.Partitions = append(plan.Partitions, entry)
+
+		for _, groupID := range groupIDs {
+			if offset, ok := committed[groupID][partition]; ok && entry.Records > 0 && offset < entry.BeforeOffset {
+				plan.Violations = append(plan.Violations, RecordDeletionViolation{
+					Partition:       partition,
+					GroupID:         groupID,
+					CommittedOffset: offset,
+					BeforeOffset:    entry.BeforeOffset,
+				})
+			}
+		}
+	}
+	return
+}
+
+// committedTopicOffsets returns the committed offsets on a topic of every consumer group, keyed by group ID and then
+// by partition. Groups without committed offsets on the topic are omitted.
+func (adminrest *AdminrestV1) committedTopicOffsets(ctx context.Context, topicName string, headers map[string]string) (committed map[string]map[int64]int64, err error) {
+	groups, err := adminrest.consumerGroupDetails(ctx, headers)
+	if err != nil {
+		return
+	}
+	committed = make(map[string]map[int64]int64)
+	for _, group := range groups {
+		groupID := stringValue(group.GroupID)
+		for _, offset := range group.Offsets {
+			if stringValue(offset.Topic) != topicName || offset.Partition == nil || offset.CurrentOffset == nil || *offset.CurrentOffset < 0 {
+				continue
+			}
+			if committed[groupID] == nil {
+				committed[groupID] = make(map[int64]int64)
+			}
+			committed[groupID][*offset.Partition] = *offset.CurrentOffset
+		}
+	}
+	return
+}
+
+// lookupTopicOffsets returns the offsets, keyed by partition, that resetting the offsets of a consumer group on a
+// topic would set, without resetting them.
+func (adminrest *AdminrestV1) lookupTopicOffsets(ctx context.Context, groupID string, topicName string, mode string, value string, headers map[string]string) (offsets map[int64]int64, err error) {
+	updateConsumerGroupOptions := adminrest.NewUpdateConsumerGroupOptions(groupID).
+		SetTopic(topicName).
+		SetMode(mode).
+		SetExecute(false).
+		SetHeaders(headers)
+	if value != "" {
+		updateConsumerGroupOptions.SetValue(value)
+	}
+	results, _, err := adminrest.UpdateConsumerGroupWithContext(ctx, updateConsumerGroupOptions)
+	if err != nil {
+		err = core.SDKErrorf(err, fmt.Sprintf("failed to look up %s offsets of topic '%s' with consumer group '%s'", mode, topicName, groupID), "record-deletion-offsets-error", common.GetComponentInfo())
+		return
+	}
+	offsets = make(map[int64]int64, len(results))
+	for _, item := range results {
+		if stringValue(item.Topic) == topicName && item.Partition != nil && item.Offset != nil {
+			offsets[*item.Partition] = *item.Offset
+		}
+	}
+	return
+}
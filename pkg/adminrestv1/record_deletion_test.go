@@ -0,0 +1,176 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package adminrestv1_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/IBM/eventstreams-go-sdk/pkg/adminrestv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`ApplyTopicRecordDeletion(topicRecordDeletionOptions *TopicRecordDeletionOptions)`, func() {
+	var testServer *httptest.Server
+	var adminrestService *adminrestv1.AdminrestV1
+	var deletes []string
+	var resets []map[string]interface{}
+
+	BeforeEach(func() {
+		deletes = nil
+		resets = nil
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			body, _ := io.ReadAll(req.Body)
+			res.Header().Set("Content-type", "application/json")
+			switch fmt.Sprintf("%s %s", req.Method, req.URL.EscapedPath()) {
+			case "GET /admin/consumergroups":
+				// The groups are listed two per page, so g1 is only on the second page
+				Expect(req.URL.Query().Get("per_page")).To(Equal("100"))
+				res.Header().Set("X-Total-Count", "3")
+				res.WriteHeader(200)
+				switch req.URL.Query().Get("page") {
+				case "1":
+					fmt.Fprintf(res, "%s", `["g2", "other"]`)
+				case "2":
+					fmt.Fprintf(res, "%s", `["g1"]`)
+				default:
+					Fail("unexpected page " + req.URL.Query().Get("page"))
+				}
+			case "GET /admin/consumergroups/g1":
+				res.WriteHeader(200)
+				fmt.Fprintf(res, "%s", `{"group_id": "g1", "offsets": [
+					{"topic": "orders", "partition": 0, "current_offset": 50, "end_offset": 100},
+					{"topic": "orders", "partition": 1, "current_offset": 80, "end_offset": 120}
+				]}`)
+			case "GET /admin/consumergroups/g2":
+				res.WriteHeader(200)
+				fmt.Fprintf(res, "%s", `{"group_id": "g2", "offsets": [
+					{"topic": "orders", "partition": 0, "current_offset": 100, "end_offset": 100},
+					{"topic": "orders", "partition": 1, "current_offset": 100, "end_offset": 120}
+				]}`)
+			case "GET /admin/consumergroups/other":
+				res.WriteHeader(200)
+				fmt.Fprintf(res, "%s", `{"group_id": "other", "offsets": [
+					{"topic": "payments", "partition": 0, "current_offset": 0, "end_offset": 10}
+				]}`)
+			case "PATCH /admin/consumergroups/g1":
+				var reset map[string]interface{}
+				Expect(json.Unmarshal(body, &reset)).To(Succeed())
+				resets = append(resets, reset)
+				offsets := map[interface{}][2]int64{"earliest": {10, 0}, "latest": {100, 120}, "datetime": {40, 60}}[reset["mode"]]
+				res.WriteHeader(200)
+				fmt.Fprintf(res, `[{"topic": "orders", "partition": 0, "offset": %d}, {"topic": "orders", "partition": 1, "offset": %d}]`, offsets[0], offsets[1])
+			case "DELETE /admin/topics/orders/records":
+				deletes = append(deletes, string(bytes.TrimSpace(body)))
+				res.WriteHeader(202)
+			default:
+				Fail("unexpected request " + req.Method + " " + req.URL.EscapedPath())
+			}
+		}))
+		var serviceErr error
+		adminrestService, serviceErr = adminrestv1.NewAdminrestV1(&adminrestv1.AdminrestV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Guards against deleting unconsumed records in all mode`, func() {
+		options := adminrestService.NewTopicRecordDeletionOptions("orders", adminrestv1.TopicRecordDeletionOptionsModeAllConst)
+		plan, err := adminrestService.PlanTopicRecordDeletion(options)
+		Expect(err).To(BeNil())
+		Expect(plan.Partitions).To(Equal([]adminrestv1.RecordDeletionPartition{
+			{Partition: 0, StartOffset: 10, EndOffset: 100, BeforeOffset: 100, Records: 90},
+			{Partition: 1, StartOffset: 0, EndOffset: 120, BeforeOffset: 120, Records: 120},
+		}))
+		Expect(plan.Records).To(Equal(int64(210)))
+		Expect(plan.Violations).To(Equal([]adminrestv1.RecordDeletionViolation{
+			{Partition: 0, GroupID: "g1", CommittedOffset: 50, BeforeOffset: 100},
+			{Partition: 1, GroupID: "g1", CommittedOffset: 80, BeforeOffset: 120},
+			{Partition: 1, GroupID: "g2", CommittedOffset: 100, BeforeOffset: 120},
+		}))
+		for _, reset := range resets {
+			Expect(reset["execute"]).To(BeFalse())
+			Expect(reset["topic"]).To(Equal("orders"))
+		}
+
+		plan, err = adminrestService.ApplyTopicRecordDeletion(options)
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("not consumed by consumer groups g1, g2"))
+		Expect(plan.Applied).To(BeFalse())
+		Expect(deletes).To(BeEmpty())
+
+		plan, err = adminrestService.ApplyTopicRecordDeletion(options.SetForce(true))
+		Expect(err).To(BeNil())
+		Expect(plan.Applied).To(BeTrue())
+		Expect(deletes).To(Equal([]string{`{"records_to_delete":[{"partition":0,"before_offset":100},{"partition":1,"before_offset":120}]}`}))
+	})
+	It(`Deletes records older than a time`, func() {
+		before := time.Date(2026, 1, 1, 1, 0, 0, 0, time.FixedZone("CET", 3600))
+		options := adminrestService.NewTopicRecordDeletionOptions("orders", adminrestv1.TopicRecordDeletionOptionsModeBeforeTimeConst).SetBefore(before)
+		plan, err := adminrestService.ApplyTopicRecordDeletion(options)
+		Expect(err).To(BeNil())
+		Expect(plan.Violations).To(BeEmpty())
+		Expect(plan.Records).To(Equal(int64(90)))
+		Expect(resets[2]).To(Equal(map[string]interface{}{"topic": "orders", "mode": "datetime", "value": "2026-01-01T00:00:00.000Z", "execute": false}))
+		Expect(deletes).To(Equal([]string{`{"records_to_delete":[{"partition":0,"before_offset":40},{"partition":1,"before_offset":60}]}`}))
+	})
+	It(`Truncates to the committed offsets of a group`, func() {
+		options := adminrestService.NewTopicRecordDeletionOptions("orders", adminrestv1.TopicRecordDeletionOptionsModeCommittedConst).SetGroupID("g1")
+		plan, err := adminrestService.ApplyTopicRecordDeletion(options)
+		Expect(err).To(BeNil())
+		Expect(plan.Partitions[0].Records).To(Equal(int64(40)))
+		Expect(plan.Partitions[1].Records).To(Equal(int64(80)))
+		Expect(deletes).To(Equal([]string{`{"records_to_delete":[{"partition":0,"before_offset":50},{"partition":1,"before_offset":80}]}`}))
+
+		_, err = adminrestService.PlanTopicRecordDeletion(options.SetGroupID("other"))
+		Expect(err).ToNot(BeNil())
+	})
+	It(`Rejects invalid options`, func() {
+		_, err := adminrestService.PlanTopicRecordDeletion(nil)
+		Expect(err).ToNot(BeNil())
+		_, err = adminrestService.PlanTopicRecordDeletion(adminrestService.NewTopicRecordDeletionOptions("orders", "everything"))
+		Expect(err).ToNot(BeNil())
+		_, err = adminrestService.PlanTopicRecordDeletion(adminrestService.NewTopicRecordDeletionOptions("orders", adminrestv1.TopicRecordDeletionOptionsModeBeforeTimeConst))
+		Expect(err).ToNot(BeNil())
+		_, err = adminrestService.PlanTopicRecordDeletion(adminrestService.NewTopicRecordDeletionOptions("orders", adminrestv1.TopicRecordDeletionOptionsModeCommittedConst))
+		Expect(err).ToNot(BeNil())
+	})
+	It(`Leaves partitions without a target offset untouched`, func() {
+		plan := adminrestv1.BuildRecordDeletionPlan("t", adminrestv1.TopicRecordDeletionOptionsModeCommittedConst,
+			map[int64]int64{0: 5, 1: 5},
+			map[int64]int64{0: 10, 1: 10},
+			map[int64]int64{0: 20},
+			nil)
+		Expect(plan.Partitions).To(Equal([]adminrestv1.RecordDeletionPartition{
+			{Partition: 0, StartOffset: 5, EndOffset: 10, BeforeOffset: 10, Records: 5},
+			{Partition: 1, StartOffset: 5, EndOffset: 10, BeforeOffset: 5, Records: 0},
+		}))
+	})
+})
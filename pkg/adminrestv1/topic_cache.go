@@ -0,0 +1,394 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package adminrestv1
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"sync"
+	"time"
+
+	common "github.com/IBM/eventstreams-go-sdk/pkg/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// DefaultTopicCacheRefreshInterval is the refresh interval used by a TopicCache when none is specified.
+const DefaultTopicCacheRefreshInterval = 30 * time.Second
+
+// Constants associated with the TopicEvent.Type property.
+// The kind of change that was detected between two refreshes of a TopicCache.
+const (
+	TopicEventTypeAddedConst               = "added"
+	TopicEventTypeRemovedConst             = "removed"
+	TopicEventTypePartitionsIncreasedConst = "partitions_increased"
+	TopicEventTypeConfigChangedConst       = "config_changed"
+)
+
+// TopicEvent : A change in topic metadata detected by a TopicCache.
+type TopicEvent struct {
+	// The kind of change, one of the TopicEventType constants.
+	Type string
+
+	// The name of the topic that changed.
+	TopicName string
+
+	// The topic metadata before the change. Nil for `added` events.
+	Previous *TopicDetail
+
+	// The topic metadata after the change. Nil for `removed` events.
+	Current *TopicDetail
+}
+
+// TopicCacheOptions : The options used to construct a TopicCache.
+type TopicCacheOptions struct {
+	// How often the cache is refreshed in the background. Defaults to DefaultTopicCacheRefreshInterval.
+	RefreshInterval time.Duration
+
+	// Restricts the cached topics to those matching the filter, using the same syntax as ListTopicsOptions.TopicFilter.
+	TopicFilter *string
+
+	// When greater than zero, events are also delivered on the channel returned by Events. Events are dropped rather
+	// than blocking a refresh when the channel is full.
+	EventBufferSize int
+
+	// Invoked synchronously for every change detected during a refresh.
+	OnEvent func(TopicEvent)
+
+	// Invoked when a background refresh fails. The cache keeps serving the last successfully loaded metadata.
+	OnError func(error)
+
+	// Allows users to set headers on the API requests made by the cache.
+	Headers map[string]string
+}
+
+// NewTopicCacheOptions : Instantiate TopicCacheOptions
+func (*AdminrestV1) NewTopicCacheOptions() *TopicCacheOptions {
+	return &TopicCacheOptions{}
+}
+
+// SetRefreshInterval : Allow user to set RefreshInterval
+func (_options *TopicCacheOptions) SetRefreshInterval(refreshInterval time.Duration) *TopicCacheOptions {
+	_options.RefreshInterval = refreshInterval
+	return _options
+}
+
+// SetTopicFilter : Allow user to set TopicFilter
+func (_options *TopicCacheOptions) SetTopicFilter(topicFilter string) *TopicCacheOptions {
+	_options.TopicFilter = core.StringPtr(topicFilter)
+	return _options
+}
+
+// SetEventBufferSize : Allow user to set EventBufferSize
+func (_options *TopicCacheOptions) SetEventBufferSize(eventBufferSize int) *TopicCacheOptions {
+	_options.EventBufferSize = eventBufferSize
+	return _options
+}
+
+// SetOnEvent : Allow user to set OnEvent
+func (_options *TopicCacheOptions) SetOnEvent(onEvent func(TopicEvent)) *TopicCacheOptions {
+	_options.OnEvent = onEvent
+	return _options
+}
+
+// SetOnError : Allow user to set OnError
+func (_options *TopicCacheOptions) SetOnError(onError func(error)) *TopicCacheOptions {
+	_options.OnError = onError
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *TopicCacheOptions) SetHeaders(param map[string]string) *TopicCacheOptions {
+	options.Headers = param
+	return options
+}
+
+// TopicCache : An in-memory view of topic metadata that is refreshed in the background and reports changes
+// between refreshes.
+type TopicCache struct {
+	adminrest *AdminrestV1
+	options   TopicCacheOptions
+	events    chan TopicEvent
+
+	refreshMutex sync.Mutex
+
+	mutex       sync.RWMutex
+	topics      map[string]*TopicDetail
+	loaded      bool
+	lastRefresh time.Time
+	lastErr     error
+
+	runMutex sync.Mutex
+	cancel   context.CancelFunc
+	done     chan struct{}
+}
+
+// NewTopicCache : constructs a TopicCache backed by this service instance. The cache is empty until Refresh or
+// Start is called.
+func (adminrest *AdminrestV1) NewTopicCache(options *TopicCacheOptions) *TopicCache {
+	cache := &TopicCache{
+		adminrest: adminrest,
+		topics:    make(map[string]*TopicDetail),
+	}
+	if options != nil {
+		cache.options = *options
+	}
+	if cache.options.RefreshInterval <= 0 {
+		cache.options.RefreshInterval = DefaultTopicCacheRefreshInterval
+	}
+	if cache.options.EventBufferSize > 0 {
+		cache.events = make(chan TopicEvent, cache.options.EventBufferSize)
+	}
+	return cache
+}
+
+// Start loads the cache and then keeps refreshing it in the background until Stop is called or ctx is cancelled.
+// The initial load is synchronous so that lookups succeed as soon as Start returns without error.
+func (cache *TopicCache) Start(ctx context.Context) (err error) {
+	cache.runMutex.Lock()
+	defer cache.runMutex.Unlock()
+
+	if cache.cancel != nil {
+		err = core.SDKErrorf(nil, "topic cache is already started", "cache-already-started", common.GetComponentInfo())
+		return
+	}
+
+	err = cache.Refresh(ctx)
+	if err != nil {
+		return
+	}
+
+	runCtx, cancel := context.WithCancel(ctx)
+	cache.cancel = cancel
+	cache.done = make(chan struct{})
+	go cache.run(runCtx, cache.done)
+	return
+}
+
+// Stop halts background refreshes and waits for any refresh in progress to finish.
+func (cache *TopicCache) Stop() {
+	cache.runMutex.Lock()
+	defer cache.runMutex.Unlock()
+
+	if cache.cancel == nil {
+		return
+	}
+	cache.cancel()
+	<-cache.done
+	cache.cancel = nil
+	cache.done = nil
+}
+
+func (cache *TopicCache) run(ctx context.Context, done chan struct{}) {
+	defer close(done)
+
+	ticker := time.NewTicker(cache.options.RefreshInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			err := cache.Refresh(ctx)
+			if err != nil && ctx.Err() == nil && cache.options.OnError != nil {
+				cache.options.OnError(err)
+			}
+		}
+	}
+}
+
+// Refresh reloads topic metadata from every page of ListTopics and emits an event for every difference from the previous load.
+// No events are emitted for the first successful load.
+func (cache *TopicCache) Refresh(ctx context.Context) (err error) {
+	cache.refreshMutex.Lock()
+	defer cache.refreshMutex.Unlock()
+
+	result, err := cache.adminrest.topicDetails(ctx, cache.options.TopicFilter, cache.options.Headers)
+	if err != nil {
+		cache.mutex.Lock()
+		cache.lastErr = err
+		cache.mutex.Unlock()
+		err = core.SDKErrorf(err, "", "cache-refresh-error", common.GetComponentInfo())
+		return
+	}
+
+	current := make(map[string]*TopicDetail, len(result))
+	for i := range result {
+		if result[i].Name != nil {
+			current[*result[i].Name] = &result[i]
+		}
+	}
+
+	cache.mutex.Lock()
+	var events []TopicEvent
+	if cache.loaded {
+		events = diffTopics(cache.topics, current)
+	}
+	cache.topics = current
+	cache.loaded = true
+	cache.lastRefresh = time.Now()
+	cache.lastErr = nil
+	cache.mutex.Unlock()
+
+	for _, event := range events {
+		cache.emit(event)
+	}
+	return
+}
+
+func (cache *TopicCache) emit(event TopicEvent) {
+	if cache.options.OnEvent != nil {
+		cache.options.OnEvent(event)
+	}
+	if cache.events != nil {
+		select {
+		case cache.events <- event:
+		default:
+		}
+	}
+}
+
+// Events returns the channel on which change events are delivered, or nil when EventBufferSize was not set.
+func (cache *TopicCache) Events() <-chan TopicEvent {
+	return cache.events
+}
+
+// GetTopic returns a copy of the cached metadata for the named topic.
+func (cache *TopicCache) GetTopic(topicName string) (result *TopicDetail, ok bool) {
+	cache.mutex.RLock()
+	defer cache.mutex.RUnlock()
+
+	topic, ok := cache.topics[topicName]
+	if ok {
+		result = copyTopicDetail(topic)
+	}
+	return
+}
+
+// GetPartitionCount returns the cached partition count for the named topic.
+func (cache *TopicCache) GetPartitionCount(topicName string) (partitions int64, ok bool) {
+	cache.mutex.RLock()
+	defer cache.mutex.RUnlock()
+
+	topic, ok := cache.topics[topicName]
+	if ok && topic.Partitions != nil {
+		partitions = *topic.Partitions
+	}
+	return
+}
+
+// ListTopics returns copies of all cached topics, sorted by name.
+func (cache *TopicCache) ListTopics() (result []TopicDetail) {
+	cache.mutex.RLock()
+	defer cache.mutex.RUnlock()
+
+	result = make([]TopicDetail, 0, len(cache.topics))
+	for _, topic := range cache.topics {
+		result = append(result, *copyTopicDetail(topic))
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return *result[i].Name < *result[j].Name
+	})
+	return
+}
+
+// LastRefresh returns the time of the last successful refresh, or the zero time if the cache has never loaded.
+func (cache *TopicCache) LastRefresh() time.Time {
+	cache.mutex.RLock()
+	defer cache.mutex.RUnlock()
+	return cache.lastRefresh
+}
+
+// LastError returns the error from the most recent refresh, or nil if it succeeded.
+func (cache *TopicCache) LastError() error {
+	cache.mutex.RLock()
+	defer cache.mutex.RUnlock()
+	return cache.lastErr
+}
+
+// diffTopics returns the events describing how previous became current, ordered by topic name.
+func diffTopics(previous, current map[string]*TopicDetail) (events []TopicEvent) {
+	names := make([]string, 0, len(previous)+len(current))
+	for name := range previous {
+		names = append(names, name)
+	}
+	for name := range current {
+		if _, ok := previous[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		before, hadBefore := previous[name]
+		after, hasAfter := current[name]
+		switch {
+		case !hadBefore:
+			events = append(events, TopicEvent{Type: TopicEventTypeAddedConst, TopicName: name, Current: copyTopicDetail(after)})
+		case !hasAfter:
+			events = append(events, TopicEvent{Type: TopicEventTypeRemovedConst, TopicName: name, Previous: copyTopicDetail(before)})
+		default:
+			if int64Value(after.Partitions) > int64Value(before.Partitions) {
+				events = append(events, TopicEvent{Type: TopicEventTypePartitionsIncreasedConst, TopicName: name, Previous: copyTopicDetail(before), Current: copyTopicDetail(after)})
+			}
+			if topicConfigChanged(before, after) {
+				events = append(events, TopicEvent{Type: TopicEventTypeConfigChangedConst, TopicName: name, Previous: copyTopicDetail(before), Current: copyTopicDetail(after)})
+			}
+		}
+	}
+	return
+}
+
+func topicConfigChanged(before, after *TopicDetail) bool {
+	return !reflect.DeepEqual(before.ReplicationFactor, after.ReplicationFactor) ||
+		!reflect.DeepEqual(before.RetentionMs, after.RetentionMs) ||
+		!reflect.DeepEqual(before.CleanupPolicy, after.CleanupPolicy) ||
+		!reflect.DeepEqual(before.Configs, after.Configs)
+}
+
+// copyTopicDetail returns a deep copy of topic so that cached values cannot be modified by callers.
+func copyTopicDetail(topic *TopicDetail) *TopicDetail {
+	if topic == nil {
+		return nil
+	}
+	clone := *topic
+	if topic.Configs != nil {
+		configs := *topic.Configs
+		clone.Configs = &configs
+	}
+	if topic.ReplicaAssignments != nil {
+		clone.ReplicaAssignments = make([]TopicDetailReplicaAssignmentsItem, len(topic.ReplicaAssignments))
+		for i, assignment := range topic.ReplicaAssignments {
+			clone.ReplicaAssignments[i] = assignment
+			if assignment.Brokers != nil {
+				brokers := TopicDetailReplicaAssignmentsItemBrokers{
+					Replicas: append([]int64(nil), assignment.Brokers.Replicas...),
+				}
+				clone.ReplicaAssignments[i].Brokers = &brokers
+			}
+		}
+	}
+	return &clone
+}
+
+func int64Value(value *int64) int64 {
+	if value == nil {
+		return 0
+	}
+	return *value
+}
@@ -0,0 +1,193 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package adminrestv1_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"time"
+
+	"github.com/IBM/eventstreams-go-sdk/pkg/adminrestv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`TopicCache`, func() {
+	var testServer *httptest.Server
+	var mutex sync.Mutex
+	var responseBody string
+	var secondPageBody string
+	var statusCode int
+
+	setResponse := func(code int, body string) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		statusCode = code
+		responseBody = body
+	}
+
+	BeforeEach(func() {
+		setResponse(200, `[{"name": "orders", "partitions": 3, "retentionMs": 1000}, {"name": "payments", "partitions": 1}]`)
+		secondPageBody = ""
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			Expect(req.URL.EscapedPath()).To(Equal("/admin/topics"))
+			Expect(req.Method).To(Equal("GET"))
+
+			mutex.Lock()
+			defer mutex.Unlock()
+			res.Header().Set("Content-type", "application/json")
+			body := responseBody
+			if secondPageBody != "" {
+				// The topics are listed on two pages
+				res.Header().Set("X-Total-Count", "3")
+				if req.URL.Query().Get("page") == "2" {
+					body = secondPageBody
+				}
+			}
+			res.WriteHeader(statusCode)
+			fmt.Fprintf(res, "%s", body)
+		}))
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	newService := func() *adminrestv1.AdminrestV1 {
+		adminrestService, serviceErr := adminrestv1.NewAdminrestV1(&adminrestv1.AdminrestV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+		return adminrestService
+	}
+
+	It(`Serves lookups from memory after Refresh`, func() {
+		adminrestService := newService()
+		cache := adminrestService.NewTopicCache(nil)
+
+		_, ok := cache.GetTopic("orders")
+		Expect(ok).To(BeFalse())
+		Expect(cache.LastRefresh().IsZero()).To(BeTrue())
+
+		Expect(cache.Refresh(context.Background())).To(BeNil())
+
+		topic, ok := cache.GetTopic("orders")
+		Expect(ok).To(BeTrue())
+		Expect(*topic.Partitions).To(Equal(int64(3)))
+
+		partitions, ok := cache.GetPartitionCount("payments")
+		Expect(ok).To(BeTrue())
+		Expect(partitions).To(Equal(int64(1)))
+
+		topics := cache.ListTopics()
+		Expect(topics).To(HaveLen(2))
+		Expect(*topics[0].Name).To(Equal("orders"))
+		Expect(cache.LastRefresh().IsZero()).To(BeFalse())
+	})
+
+	It(`Loads every page of topics`, func() {
+		mutex.Lock()
+		secondPageBody = `[{"name": "refunds", "partitions": 2}]`
+		mutex.Unlock()
+		adminrestService := newService()
+		var received []adminrestv1.TopicEvent
+		cache := adminrestService.NewTopicCache(adminrestService.NewTopicCacheOptions().SetOnEvent(func(event adminrestv1.TopicEvent) {
+			received = append(received, event)
+		}))
+
+		Expect(cache.Refresh(context.Background())).To(BeNil())
+		Expect(cache.ListTopics()).To(HaveLen(3))
+		partitions, ok := cache.GetPartitionCount("refunds")
+		Expect(ok).To(BeTrue())
+		Expect(partitions).To(Equal(int64(2)))
+
+		Expect(cache.Refresh(context.Background())).To(BeNil())
+		Expect(received).To(BeEmpty())
+	})
+
+	It(`Emits change events between refreshes`, func() {
+		adminrestService := newService()
+		var received []adminrestv1.TopicEvent
+		cacheOptions := adminrestService.NewTopicCacheOptions().
+			SetEventBufferSize(10).
+			SetOnEvent(func(event adminrestv1.TopicEvent) {
+				received = append(received, event)
+			})
+		cache := adminrestService.NewTopicCache(cacheOptions)
+
+		Expect(cache.Refresh(context.Background())).To(BeNil())
+		Expect(received).To(BeEmpty())
+
+		setResponse(200, `[{"name": "orders", "partitions": 6, "retentionMs": 2000}, {"name": "refunds", "partitions": 1}]`)
+		Expect(cache.Refresh(context.Background())).To(BeNil())
+
+		Expect(received).To(HaveLen(4))
+		Expect(received[0].Type).To(Equal(adminrestv1.TopicEventTypePartitionsIncreasedConst))
+		Expect(received[0].TopicName).To(Equal("orders"))
+		Expect(*received[0].Previous.Partitions).To(Equal(int64(3)))
+		Expect(*received[0].Current.Partitions).To(Equal(int64(6)))
+		Expect(received[1].Type).To(Equal(adminrestv1.TopicEventTypeConfigChangedConst))
+		Expect(received[2].Type).To(Equal(adminrestv1.TopicEventTypeRemovedConst))
+		Expect(received[2].TopicName).To(Equal("payments"))
+		Expect(received[3].Type).To(Equal(adminrestv1.TopicEventTypeAddedConst))
+		Expect(received[3].TopicName).To(Equal("refunds"))
+
+		Expect(cache.Events()).To(HaveLen(4))
+	})
+
+	It(`Keeps serving cached data when a refresh fails`, func() {
+		adminrestService := newService()
+		cache := adminrestService.NewTopicCache(nil)
+		Expect(cache.Refresh(context.Background())).To(BeNil())
+
+		setResponse(503, `{"error_code": 50301, "message": "Unknown Kafka Error"}`)
+		err := cache.Refresh(context.Background())
+		Expect(err).ToNot(BeNil())
+		Expect(cache.LastError()).ToNot(BeNil())
+
+		_, ok := cache.GetTopic("orders")
+		Expect(ok).To(BeTrue())
+	})
+
+	It(`Refreshes in the background until stopped`, func() {
+		adminrestService := newService()
+		cacheOptions := adminrestService.NewTopicCacheOptions().
+			SetRefreshInterval(10 * time.Millisecond).
+			SetEventBufferSize(10)
+		cache := adminrestService.NewTopicCache(cacheOptions)
+
+		Expect(cache.Start(context.Background())).To(BeNil())
+		defer cache.Stop()
+		Expect(cache.Start(context.Background())).ToNot(BeNil())
+
+		setResponse(200, `[{"name": "orders", "partitions": 3, "retentionMs": 1000}, {"name": "payments", "partitions": 1}, {"name": "audit", "partitions": 1}]`)
+
+		var event adminrestv1.TopicEvent
+		Eventually(cache.Events(), time.Second).Should(Receive(&event))
+		Expect(event.Type).To(Equal(adminrestv1.TopicEventTypeAddedConst))
+		Expect(event.TopicName).To(Equal("audit"))
+
+		cache.Stop()
+		cache.Stop()
+	})
+})
@@ -0,0 +1,191 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package adminrestv1
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+
+	common "github.com/IBM/eventstreams-go-sdk/pkg/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// CloneTopicOptions : The CloneTopic options.
+type CloneTopicOptions struct {
+	// The name of the topic to clone.
+	TopicName *string `validate:"required,ne="`
+
+	// The name of the new topic. Defaults to TopicName, which requires a different target instance.
+	TargetTopicName *string
+
+	// The instance to create the new topic on. Defaults to the instance of the topic.
+	Target *AdminrestV1
+
+	// The instance that mirrors the target instance. When set, the new topic is added to its mirroring topic
+	// selection with AddMirroringPatterns.
+	MirroringTarget *AdminrestV1
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewCloneTopicOptions : Instantiate CloneTopicOptions
+func (*AdminrestV1) NewCloneTopicOptions(topicName string) *CloneTopicOptions {
+	return &CloneTopicOptions{
+		TopicName: core.StringPtr(topicName),
+	}
+}
+
+// SetTopicName : Allow user to set TopicName
+func (_options *CloneTopicOptions) SetTopicName(topicName string) *CloneTopicOptions {
+	_options.TopicName = core.StringPtr(topicName)
+	return _options
+}
+
+// SetTargetTopicName : Allow user to set TargetTopicName
+func (_options *CloneTopicOptions) SetTargetTopicName(targetTopicName string) *CloneTopicOptions {
+	_options.TargetTopicName = core.StringPtr(targetTopicName)
+	return _options
+}
+
+// SetTarget : Allow user to set Target
+func (_options *CloneTopicOptions) SetTarget(target *AdminrestV1) *CloneTopicOptions {
+	_options.Target = target
+	return _options
+}
+
+// SetMirroringTarget : Allow user to set MirroringTarget
+func (_options *CloneTopicOptions) SetMirroringTarget(mirroringTarget *AdminrestV1) *CloneTopicOptions {
+	_options.MirroringTarget = mirroringTarget
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *CloneTopicOptions) SetHeaders(param map[string]string) *CloneTopicOptions {
+	options.Headers = param
+	return options
+}
+
+// TopicClone : A topic created by CloneTopic.
+type TopicClone struct {
+	// The name of the cloned topic.
+	TopicName string `json:"topic_name"`
+
+	// The name of the new topic.
+	TargetTopicName string `json:"target_topic_name"`
+
+	// The partition count of both topics.
+	Partitions int64 `json:"partitions"`
+
+	// The replication factor of the cloned topic. CreateTopic cannot set it, so the new topic has the replication
+	// factor of its instance.
+	ReplicationFactor int64 `json:"replication_factor"`
+
+	// The config properties copied to the new topic.
+	Configs map[string]string `json:"configs"`
+
+	// Whether the new topic was added to the mirroring topic selection.
+	Mirrored bool `json:"mirrored"`
+}
+
+// CloneTopic : Create a topic like an existing one
+// Reads the partition count and the config properties returned by GetTopic, such as retention.ms and cleanup.policy,
+// and creates a topic with the same settings with CreateTopic, on the same instance under another name or on another
+// instance. The new topic can then be added to the mirroring topic selection of the instance that mirrors it. If the
+// selection cannot be updated, the clone is returned together with the error.
+func (adminrest *AdminrestV1) CloneTopic(cloneTopicOptions *CloneTopicOptions) (result *TopicClone, err error) {
+	result, err = adminrest.CloneTopicWithContext(context.Background(), cloneTopicOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// CloneTopicWithContext is an alternate form of the CloneTopic method which supports a Context parameter
+func (adminrest *AdminrestV1) CloneTopicWithContext(ctx context.Context, cloneTopicOptions *CloneTopicOptions) (result *TopicClone, err error) {
+	err = core.ValidateNotNil(cloneTopicOptions, "cloneTopicOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(cloneTopicOptions, "cloneTopicOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+	topicName := *cloneTopicOptions.TopicName
+	targetTopicName := topicName
+	if cloneTopicOptions.TargetTopicName != nil && *cloneTopicOptions.TargetTopicName != "" {
+		targetTopicName = *cloneTopicOptions.TargetTopicName
+	}
+	target := cloneTopicOptions.Target
+	if target == nil {
+		target = adminrest
+	}
+	if target == adminrest && targetTopicName == topicName {
+		err = core.SDKErrorf(nil, fmt.Sprintf("topic '%s' cannot be cloned to itself; set a target topic name or instance", topicName), "clone-topic-to-itself", common.GetComponentInfo())
+		return
+	}
+	headers := cloneTopicOptions.Headers
+
+	topic, _, err := adminrest.GetTopicWithContext(ctx, adminrest.NewGetTopicOptions(topicName).SetHeaders(headers))
+	if err != nil {
+		err = core.SDKErrorf(err, "", "get-topic-error", common.GetComponentInfo())
+		return
+	}
+	clone := &TopicClone{
+		TopicName:         topicName,
+		TargetTopicName:   targetTopicName,
+		Partitions:        int64Value(topic.Partitions),
+		ReplicationFactor: int64Value(topic.ReplicationFactor),
+		Configs:           topicConfigValues(topic),
+	}
+
+	names := make([]string, 0, len(clone.Configs))
+	for name := range clone.Configs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	configs := make([]TopicCreateRequestConfigsItem, 0, len(names))
+	for _, name := range names {
+		configs = append(configs, TopicCreateRequestConfigsItem{Name: core.StringPtr(name), Value: core.StringPtr(clone.Configs[name])})
+	}
+	createTopicOptions := target.NewCreateTopicOptions().
+		SetName(targetTopicName).
+		SetPartitionCount(clone.Partitions).
+		SetHeaders(headers)
+	if len(configs) > 0 {
+		createTopicOptions.SetConfigs(configs)
+	}
+	_, err = target.CreateTopicWithContext(ctx, createTopicOptions)
+	if err != nil {
+		err = core.SDKErrorf(err, fmt.Sprintf("failed to create topic '%s'", targetTopicName), "create-topic-error", common.GetComponentInfo())
+		return
+	}
+	result = clone
+
+	if cloneTopicOptions.MirroringTarget != nil {
+		mirroringPatternsOptions := cloneTopicOptions.MirroringTarget.NewMirroringPatternsOptions([]string{regexp.QuoteMeta(targetTopicName)}).SetHeaders(headers)
+		_, err = cloneTopicOptions.MirroringTarget.AddMirroringPatternsWithContext(ctx, mirroringPatternsOptions)
+		if err != nil {
+			err = core.SDKErrorf(err, fmt.Sprintf("topic '%s' was cloned but could not be added to the mirroring selection", targetTopicName), "add-mirroring-patterns-error", common.GetComponentInfo())
+			return
+		}
+		result.Mirrored = true
+	}
+	return
+}
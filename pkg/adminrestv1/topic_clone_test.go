@@ -0,0 +1,154 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package adminrestv1_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/eventstreams-go-sdk/pkg/adminrestv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`CloneTopic(cloneTopicOptions *CloneTopicOptions)`, func() {
+	var sourceServer, targetServer *httptest.Server
+	var source, target *adminrestv1.AdminrestV1
+	var sourceCreates, targetCreates []string
+	var includes []string
+	var failSelection bool
+
+	newServer := func(creates *[]string) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			body, _ := io.ReadAll(req.Body)
+			res.Header().Set("Content-type", "application/json")
+			switch fmt.Sprintf("%s %s", req.Method, req.URL.EscapedPath()) {
+			case "GET /admin/topics/orders":
+				res.WriteHeader(200)
+				fmt.Fprintf(res, "%s", `{"name": "orders", "partitions": 6, "replicationFactor": 3, "retentionMs": 86400000,
+					"cleanupPolicy": "compact", "configs": {"segment.bytes": "536870912"}}`)
+			case "GET /admin/topics/plain":
+				res.WriteHeader(200)
+				fmt.Fprintf(res, "%s", `{"name": "plain", "partitions": 1}`)
+			case "GET /admin/topics/missing":
+				res.WriteHeader(404)
+				fmt.Fprintf(res, "%s", `{"error_code": 404, "message": "topic not found"}`)
+			case "POST /admin/topics":
+				*creates = append(*creates, string(bytes.TrimSpace(body)))
+				res.WriteHeader(202)
+			case "GET /admin/mirroring/topic-selection":
+				if failSelection {
+					res.WriteHeader(500)
+					fmt.Fprintf(res, "%s", `{"error_code": 500, "message": "unavailable"}`)
+					return
+				}
+				res.WriteHeader(200)
+				Expect(json.NewEncoder(res).Encode(map[string][]string{"includes": includes})).To(Succeed())
+			case "POST /admin/mirroring/topic-selection":
+				var selection map[string][]string
+				Expect(json.Unmarshal(body, &selection)).To(Succeed())
+				includes = selection["includes"]
+				res.WriteHeader(200)
+				Expect(json.NewEncoder(res).Encode(map[string][]string{"includes": includes})).To(Succeed())
+			default:
+				Fail("unexpected request " + req.Method + " " + req.URL.EscapedPath())
+			}
+		}))
+	}
+
+	BeforeEach(func() {
+		sourceCreates = nil
+		targetCreates = nil
+		includes = []string{"audit"}
+		failSelection = false
+		sourceServer = newServer(&sourceCreates)
+		targetServer = newServer(&targetCreates)
+		var serviceErr error
+		source, serviceErr = adminrestv1.NewAdminrestV1(&adminrestv1.AdminrestV1Options{
+			URL:           sourceServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+		target, serviceErr = adminrestv1.NewAdminrestV1(&adminrestv1.AdminrestV1Options{
+			URL:           targetServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		sourceServer.Close()
+		targetServer.Close()
+	})
+
+	It(`Clones a topic under another name on the same instance`, func() {
+		clone, err := source.CloneTopic(source.NewCloneTopicOptions("orders").SetTargetTopicName("orders.v2"))
+		Expect(err).To(BeNil())
+		Expect(clone).To(Equal(&adminrestv1.TopicClone{
+			TopicName:         "orders",
+			TargetTopicName:   "orders.v2",
+			Partitions:        6,
+			ReplicationFactor: 3,
+			Configs: map[string]string{
+				"cleanup.policy": "compact",
+				"retention.ms":   "86400000",
+				"segment.bytes":  "536870912",
+			},
+		}))
+		Expect(sourceCreates).To(Equal([]string{`{"configs":[{"name":"cleanup.policy","value":"compact"},{"name":"retention.ms","value":"86400000"},{"name":"segment.bytes","value":"536870912"}],"name":"orders.v2","partition_count":6}`}))
+		Expect(targetCreates).To(BeEmpty())
+	})
+	It(`Clones a topic to another instance and mirrors it`, func() {
+		options := source.NewCloneTopicOptions("plain").SetTarget(target).SetMirroringTarget(target)
+		clone, err := source.CloneTopic(options)
+		Expect(err).To(BeNil())
+		Expect(clone.Mirrored).To(BeTrue())
+		Expect(sourceCreates).To(BeEmpty())
+		Expect(targetCreates).To(Equal([]string{`{"name":"plain","partition_count":1}`}))
+		Expect(includes).To(Equal([]string{"audit", "plain"}))
+
+		_, err = source.CloneTopic(source.NewCloneTopicOptions("orders").SetTargetTopicName("orders.v2").SetMirroringTarget(target))
+		Expect(err).To(BeNil())
+		Expect(includes).To(Equal([]string{"audit", "plain", `orders\.v2`}))
+	})
+	It(`Reports a clone that could not be mirrored`, func() {
+		failSelection = true
+		clone, err := source.CloneTopic(source.NewCloneTopicOptions("plain").SetTarget(target).SetMirroringTarget(target))
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("topic 'plain' was cloned but could not be added to the mirroring selection"))
+		Expect(clone.Mirrored).To(BeFalse())
+		Expect(targetCreates).To(Equal([]string{`{"name":"plain","partition_count":1}`}))
+	})
+	It(`Rejects invalid options`, func() {
+		_, err := source.CloneTopic(nil)
+		Expect(err).ToNot(BeNil())
+		_, err = source.CloneTopic(source.NewCloneTopicOptions(""))
+		Expect(err).ToNot(BeNil())
+		_, err = source.CloneTopic(source.NewCloneTopicOptions("orders"))
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("cannot be cloned to itself"))
+		_, err = source.CloneTopic(source.NewCloneTopicOptions("missing").SetTarget(target))
+		Expect(err).ToNot(BeNil())
+		Expect(targetCreates).To(BeEmpty())
+	})
+})
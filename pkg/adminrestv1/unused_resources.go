@@ -0,0 +1,386 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package adminrestv1
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	common "github.com/IBM/eventstreams-go-sdk/pkg/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// DefaultUnusedResourcesIdlePeriod is how long a consumer group must be idle before GetUnusedResourcesReport reports
+// it as stale, when the options do not set a period.
+const DefaultUnusedResourcesIdlePeriod = 7 * 24 * time.Hour
+
+// Constants associated with the UnusedResourceDeletion.Kind property.
+// The kind of resource that is deleted.
+const (
+	UnusedResourceDeletionKindTopicConst         = "topic"
+	UnusedResourceDeletionKindConsumerGroupConst = "consumer_group"
+)
+
+// Constants associated with the UnusedResourceDeletion.Reason property.
+// Why the resource is deleted.
+const (
+	UnusedResourceDeletionReasonUnusedTopicConst   = "unused_topic"
+	UnusedResourceDeletionReasonOrphanedGroupConst = "orphaned_group"
+	UnusedResourceDeletionReasonStaleGroupConst    = "stale_group"
+)
+
+// GetUnusedResourcesReportOptions : The GetUnusedResourcesReport options.
+type GetUnusedResourcesReportOptions struct {
+	// How long a consumer group must be idle to be stale. Defaults to DefaultUnusedResourcesIdlePeriod.
+	IdlePeriod *time.Duration
+
+	// The previous report, from which the time that idle groups were first seen idle is carried over. Without it,
+	// groups are first seen idle when the report is made.
+	Previous *UnusedResourcesReport
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewGetUnusedResourcesReportOptions : Instantiate GetUnusedResourcesReportOptions
+func (*AdminrestV1) NewGetUnusedResourcesReportOptions() *GetUnusedResourcesReportOptions {
+	return &GetUnusedResourcesReportOptions{}
+}
+
+// SetIdlePeriod : Allow user to set IdlePeriod
+func (_options *GetUnusedResourcesReportOptions) SetIdlePeriod(idlePeriod time.Duration) *GetUnusedResourcesReportOptions {
+	_options.IdlePeriod = &idlePeriod
+	return _options
+}
+
+// SetPrevious : Allow user to set Previous
+func (_options *GetUnusedResourcesReportOptions) SetPrevious(previous *UnusedResourcesReport) *GetUnusedResourcesReportOptions {
+	_options.Previous = previous
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *GetUnusedResourcesReportOptions) SetHeaders(param map[string]string) *GetUnusedResourcesReportOptions {
+	options.Headers = param
+	return options
+}
+
+// OrphanedGroup : An inactive consumer group with committed offsets on topics that no longer exist.
+type OrphanedGroup struct {
+	// The ID of the consumer group.
+	GroupID string `json:"group_id"`
+
+	// The state of the consumer group, Empty or Dead.
+	State string `json:"state"`
+
+	// The deleted topics, sorted by name.
+	DeletedTopics []string `json:"deleted_topics"`
+}
+
+// IdleGroup : A consumer group with no members that has consumed every record of its topics.
+type IdleGroup struct {
+	// The ID of the consumer group.
+	GroupID string `json:"group_id"`
+
+	// The state of the consumer group.
+	State string `json:"state,omitempty"`
+
+	// When the group was first seen idle.
+	IdleSince time.Time `json:"idle_since"`
+
+	// Whether the group has been idle for at least the idle period.
+	Stale bool `json:"stale"`
+}
+
+// UnusedResourcesReport : The topics and consumer groups of an instance that appear to be abandoned.
+type UnusedResourcesReport struct {
+	// When the report was made.
+	Time time.Time `json:"time"`
+
+	// The topics that no consumer group has committed offsets on or members assigned to, sorted by name.
+	UnusedTopics []string `json:"unused_topics"`
+
+	// The Empty or Dead consumer groups with offsets on deleted topics, sorted by ID.
+	OrphanedGroups []OrphanedGroup `json:"orphaned_groups"`
+
+	// The consumer groups, other than orphaned ones, with no members and no lag, sorted by ID.
+	IdleGroups []IdleGroup `json:"idle_groups"`
+}
+
+// GetUnusedResourcesReport : Find abandoned topics and consumer groups
+// Combines every page of ListTopics with the offsets and members returned by GetConsumerGroup for every group on every
+// page of ListConsumerGroups.
+// The admin REST API does not report when groups were last active, so a group is stale once it has been reported
+// idle for the idle period by successive reports, each given the previous one.
+func (adminrest *AdminrestV1) GetUnusedResourcesReport(getUnusedResourcesReportOptions *GetUnusedResourcesReportOptions) (result *UnusedResourcesReport, err error) {
+	result, err = adminrest.GetUnusedResourcesReportWithContext(context.Background(), getUnusedResourcesReportOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetUnusedResourcesReportWithContext is an alternate form of the GetUnusedResourcesReport method which supports a Context parameter
+func (adminrest *AdminrestV1) GetUnusedResourcesReportWithContext(ctx context.Context, getUnusedResourcesReportOptions *GetUnusedResourcesReportOptions) (result *UnusedResourcesReport, err error) {
+	err = core.ValidateNotNil(getUnusedResourcesReportOptions, "getUnusedResourcesReportOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	headers := getUnusedResourcesReportOptions.Headers
+
+	topics, err := adminrest.topicDetails(ctx, nil, headers)
+	if err != nil {
+		return
+	}
+	groupDetails, err := adminrest.consumerGroupDetails(ctx, headers)
+	if err != nil {
+		return
+	}
+	groups := make([]GroupDetail, 0, len(groupDetails))
+	for _, group := range groupDetails {
+		groups = append(groups, *group)
+	}
+
+	idlePeriod := DefaultUnusedResourcesIdlePeriod
+	if getUnusedResourcesReportOptions.IdlePeriod != nil {
+		idlePeriod = *getUnusedResourcesReportOptions.IdlePeriod
+	}
+	result = BuildUnusedResourcesReport(topicNames(topics), groups, getUnusedResourcesReportOptions.Previous, idlePeriod, time.Now())
+	return
+}
+
+// BuildUnusedResourcesReport computes the report returned by GetUnusedResourcesReport from the names of the topics and
+// the details of the consumer groups of an instance, at time now. A group with no offsets and no members counts as
+// idle.
+func BuildUnusedResourcesReport(topics []string, groups []GroupDetail, previous *UnusedResourcesReport, idlePeriod time.Duration, now time.Time) (report *UnusedResourcesReport) {
+	report = &UnusedResourcesReport{
+		Time:           now,
+		UnusedTopics:   []string{},
+		OrphanedGroups: []OrphanedGroup{},
+		IdleGroups:     []IdleGroup{},
+	}
+	idleSince := map[string]time.Time{}
+	if previous != nil {
+		for _, group := range previous.IdleGroups {
+			idleSince[group.GroupID] = group.IdleSince
+		}
+	}
+	exists := map[string]bool{}
+	for _, topic := range topics {
+		exists[topic] = true
+	}
+
+	consumed := map[string]bool{}
+	for _, group := range groups {
+		groupID := stringValue(group.GroupID)
+		state := stringValue(group.State)
+		for _, member := range group.Members {
+			for _, assignment := range member.Assignments {
+				consumed[stringValue(assignment.Topic)] = true
+			}
+		}
+
+		deleted := map[string]bool{}
+		lagging := false
+		for _, offset := range group.Offsets {
+			topic := stringValue(offset.Topic)
+			consumed[topic] = true
+			if !exists[topic] {
+				deleted[topic] = true
+			}
+			if int64Value(offset.CurrentOffset) < int64Value(offset.EndOffset) {
+				lagging = true
+			}
+		}
+
+		switch {
+		case len(deleted) > 0 && (state == GroupDetailStateEmptyConst || state == GroupDetailStateDeadConst):
+			orphaned := OrphanedGroup{GroupID: groupID, State: state, DeletedTopics: make([]string, 0, len(deleted))}
+			for topic := range deleted {
+				orphaned.DeletedTopics = append(orphaned.DeletedTopics, topic)
+			}
+			sort.Strings(orphaned.DeletedTopics)
+			report.OrphanedGroups = append(report.OrphanedGroups, orphaned)
+		case len(group.Members) == 0 && !lagging:
+			idle := IdleGroup{GroupID: groupID, State: state, IdleSince: now}
+			if since, ok := idleSince[groupID]; ok && since.Before(now) {
+				idle.IdleSince = since
+			}
+			idle.Stale = now.Sub(idle.IdleSince) >= idlePeriod
+			report.IdleGroups = append(report.IdleGroups, idle)
+		}
+	}
+
+	for _, topic := range topics {
+		if !consumed[topic] {
+			report.UnusedTopics = append(report.UnusedTopics, topic)
+		}
+	}
+	sort.Strings(report.UnusedTopics)
+	sort.Slice(report.OrphanedGroups, func(i, j int) bool { return report.OrphanedGroups[i].GroupID < report.OrphanedGroups[j].GroupID })
+	sort.Slice(report.IdleGroups, func(i, j int) bool { return report.IdleGroups[i].GroupID < report.IdleGroups[j].GroupID })
+	return
+}
+
+// UnusedResourceDeletion : A topic or consumer group deleted by CleanupUnusedResources.
+type UnusedResourceDeletion struct {
+	// The kind of resource, one of the UnusedResourceDeletionKind constants.
+	Kind string `json:"kind"`
+
+	// The name of the topic or the ID of the consumer group.
+	Name string `json:"name"`
+
+	// Why the resource is deleted, one of the UnusedResourceDeletionReason constants.
+	Reason string `json:"reason"`
+
+	// Whether the deletion was confirmed.
+	Confirmed bool `json:"confirmed"`
+
+	// Whether the resource has been deleted.
+	Applied bool `json:"applied"`
+
+	// The consumer groups found reading a topic when it was checked again before its deletion, in which case the topic
+	// was not deleted.
+	InUseBy []string `json:"in_use_by,omitempty"`
+}
+
+// UnusedResourcesCleanup : The deletions made by CleanupUnusedResources.
+type UnusedResourcesCleanup struct {
+	// The deletions, consumer groups first, then topics, in report order.
+	Deletions []UnusedResourceDeletion `json:"deletions"`
+}
+
+// CleanupUnusedResourcesOptions : The CleanupUnusedResources options.
+type CleanupUnusedResourcesOptions struct {
+	// The report that lists the resources to delete.
+	Report *UnusedResourcesReport `validate:"required"`
+
+	// Called before each deletion; the resource is only deleted when it returns true.
+	Confirm func(deletion UnusedResourceDeletion) bool `validate:"required"`
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewCleanupUnusedResourcesOptions : Instantiate CleanupUnusedResourcesOptions
+func (*AdminrestV1) NewCleanupUnusedResourcesOptions(report *UnusedResourcesReport, confirm func(deletion UnusedResourceDeletion) bool) *CleanupUnusedResourcesOptions {
+	return &CleanupUnusedResourcesOptions{
+		Report:  report,
+		Confirm: confirm,
+	}
+}
+
+// SetReport : Allow user to set Report
+func (_options *CleanupUnusedResourcesOptions) SetReport(report *UnusedResourcesReport) *CleanupUnusedResourcesOptions {
+	_options.Report = report
+	return _options
+}
+
+// SetConfirm : Allow user to set Confirm
+func (_options *CleanupUnusedResourcesOptions) SetConfirm(confirm func(deletion UnusedResourceDeletion) bool) *CleanupUnusedResourcesOptions {
+	_options.Confirm = confirm
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *CleanupUnusedResourcesOptions) SetHeaders(param map[string]string) *CleanupUnusedResourcesOptions {
+	options.Headers = param
+	return options
+}
+
+// CleanupUnusedResources : Delete abandoned topics and consumer groups
+// Deletes the orphaned groups and the stale idle groups of a report with DeleteConsumerGroup, then its unused topics
+// with DeleteTopic, each only once Confirm returns true for it. Just before a topic is deleted, the consumer groups are
+// read again and the topic is skipped if any of them now reads it; groups are not checked again, so the report should
+// be recent. If a deletion fails, the error is returned together with the deletions made so far.
+func (adminrest *AdminrestV1) CleanupUnusedResources(cleanupUnusedResourcesOptions *CleanupUnusedResourcesOptions) (result *UnusedResourcesCleanup, err error) {
+	result, err = adminrest.CleanupUnusedResourcesWithContext(context.Background(), cleanupUnusedResourcesOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// CleanupUnusedResourcesWithContext is an alternate form of the CleanupUnusedResources method which supports a Context parameter
+func (adminrest *AdminrestV1) CleanupUnusedResourcesWithContext(ctx context.Context, cleanupUnusedResourcesOptions *CleanupUnusedResourcesOptions) (result *UnusedResourcesCleanup, err error) {
+	err = core.ValidateNotNil(cleanupUnusedResourcesOptions, "cleanupUnusedResourcesOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(cleanupUnusedResourcesOptions, "cleanupUnusedResourcesOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+	report := cleanupUnusedResourcesOptions.Report
+	headers := cleanupUnusedResourcesOptions.Headers
+
+	result = &UnusedResourcesCleanup{Deletions: []UnusedResourceDeletion{}}
+	for _, group := range report.OrphanedGroups {
+		result.Deletions = append(result.Deletions, UnusedResourceDeletion{Kind: UnusedResourceDeletionKindConsumerGroupConst, Name: group.GroupID, Reason: UnusedResourceDeletionReasonOrphanedGroupConst})
+	}
+	for _, group := range report.IdleGroups {
+		if group.Stale {
+			result.Deletions = append(result.Deletions, UnusedResourceDeletion{Kind: UnusedResourceDeletionKindConsumerGroupConst, Name: group.GroupID, Reason: UnusedResourceDeletionReasonStaleGroupConst})
+		}
+	}
+	for _, topic := range report.UnusedTopics {
+		result.Deletions = append(result.Deletions, UnusedResourceDeletion{Kind: UnusedResourceDeletionKindTopicConst, Name: topic, Reason: UnusedResourceDeletionReasonUnusedTopicConst})
+	}
+
+	for i := range result.Deletions {
+		deletion := &result.Deletions[i]
+		deletion.Confirmed = cleanupUnusedResourcesOptions.Confirm(*deletion)
+		if !deletion.Confirmed {
+			continue
+		}
+		switch deletion.Kind {
+		case UnusedResourceDeletionKindConsumerGroupConst:
+			_, err = adminrest.DeleteConsumerGroupWithContext(ctx, adminrest.NewDeleteConsumerGroupOptions(deletion.Name).SetHeaders(headers))
+		case UnusedResourceDeletionKindTopicConst:
+			deletion.InUseBy, err = adminrest.topicConsumerGroups(ctx, deletion.Name, headers)
+			if err != nil {
+				return
+			}
+			if len(deletion.InUseBy) > 0 {
+				continue
+			}
+			_, err = adminrest.DeleteTopicWithContext(ctx, adminrest.NewDeleteTopicOptions(deletion.Name).SetHeaders(headers))
+		}
+		if err != nil {
+			err = core.SDKErrorf(err, fmt.Sprintf("failed to delete %s '%s'", deletion.Kind, deletion.Name), "cleanup-unused-resource-error", common.GetComponentInfo())
+			return
+		}
+		deletion.Applied = true
+	}
+	return
+}
+
+// topicConsumerGroups returns the IDs of the consumer groups that have members assigned to or committed offsets on a
+// topic, sorted.
+func (adminrest *AdminrestV1) topicConsumerGroups(ctx context.Context, topicName string, headers map[string]string) (groupIDs []string, err error) {
+	groups, err := adminrest.consumerGroupDetails(ctx, headers)
+	if err != nil {
+		return
+	}
+	for _, group := range groups {
+		if _, reads := partitionExpansionGroup(group, topicName); reads {
+			groupIDs = append(groupIDs, stringValue(group.GroupID))
+		}
+	}
+	return
+}
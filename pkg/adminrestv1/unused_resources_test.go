@@ -0,0 +1,188 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package adminrestv1_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/IBM/eventstreams-go-sdk/pkg/adminrestv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`GetUnusedResourcesReport(getUnusedResourcesReportOptions *GetUnusedResourcesReportOptions)`, func() {
+	var testServer *httptest.Server
+	var adminrestService *adminrestv1.AdminrestV1
+	var deletes []string
+	var failDelete string
+	var billingReadsScratch bool
+
+	BeforeEach(func() {
+		deletes = nil
+		failDelete = ""
+		billingReadsScratch = false
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			res.Header().Set("Content-type", "application/json")
+			request := fmt.Sprintf("%s %s", req.Method, req.URL.EscapedPath())
+			switch request {
+			case "GET /admin/topics":
+				// The topics are listed two per page
+				res.Header().Set("X-Total-Count", "4")
+				res.WriteHeader(200)
+				switch req.URL.Query().Get("page") {
+				case "1":
+					fmt.Fprintf(res, "%s", `[{"name": "orders"}, {"name": "payments"}]`)
+				case "2":
+					fmt.Fprintf(res, "%s", `[{"name": "scratch"}, {"name": "audit"}]`)
+				default:
+					Fail("unexpected page " + req.URL.Query().Get("page"))
+				}
+			case "GET /admin/consumergroups":
+				res.WriteHeader(200)
+				fmt.Fprintf(res, "%s", `["billing", "old-app", "reports", "replayer"]`)
+			case "GET /admin/consumergroups/billing":
+				topic := "orders"
+				if billingReadsScratch {
+					topic = "scratch"
+				}
+				res.WriteHeader(200)
+				fmt.Fprintf(res, `{"group_id": "billing", "state": "Stable",
+					"members": [{"consumer_id": "c1", "assignments": [{"topic": "orders", "partition": 0}]}],
+					"offsets": [{"topic": %q, "partition": 0, "current_offset": 10, "end_offset": 10}]}`, topic)
+			case "GET /admin/consumergroups/old-app":
+				res.WriteHeader(200)
+				fmt.Fprintf(res, "%s", `{"group_id": "old-app", "state": "Empty", "offsets": [
+					{"topic": "legacy", "partition": 0, "current_offset": 5, "end_offset": 5},
+					{"topic": "payments", "partition": 0, "current_offset": 5, "end_offset": 9}
+				]}`)
+			case "GET /admin/consumergroups/reports":
+				res.WriteHeader(200)
+				fmt.Fprintf(res, "%s", `{"group_id": "reports", "state": "Empty", "offsets": [
+					{"topic": "payments", "partition": 0, "current_offset": 9, "end_offset": 9}
+				]}`)
+			case "GET /admin/consumergroups/replayer":
+				res.WriteHeader(200)
+				fmt.Fprintf(res, "%s", `{"group_id": "replayer", "state": "Empty", "offsets": [
+					{"topic": "audit", "partition": 0, "current_offset": 1, "end_offset": 9}
+				]}`)
+			case "DELETE /admin/consumergroups/old-app", "DELETE /admin/consumergroups/reports", "DELETE /admin/topics/scratch":
+				if request == failDelete {
+					res.WriteHeader(500)
+					fmt.Fprintf(res, "%s", `{"error_code": 500, "message": "failed"}`)
+					return
+				}
+				deletes = append(deletes, request)
+				res.WriteHeader(202)
+			default:
+				Fail("unexpected request " + request)
+			}
+		}))
+		var serviceErr error
+		adminrestService, serviceErr = adminrestv1.NewAdminrestV1(&adminrestv1.AdminrestV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Reports unused topics, orphaned groups and idle groups`, func() {
+		report, err := adminrestService.GetUnusedResourcesReport(adminrestService.NewGetUnusedResourcesReportOptions())
+		Expect(err).To(BeNil())
+		Expect(report.UnusedTopics).To(Equal([]string{"scratch"}))
+		Expect(report.OrphanedGroups).To(Equal([]adminrestv1.OrphanedGroup{
+			{GroupID: "old-app", State: "Empty", DeletedTopics: []string{"legacy"}},
+		}))
+		Expect(report.IdleGroups).To(HaveLen(1))
+		Expect(report.IdleGroups[0].GroupID).To(Equal("reports"))
+		Expect(report.IdleGroups[0].IdleSince).To(Equal(report.Time))
+		Expect(report.IdleGroups[0].Stale).To(BeFalse())
+
+		_, err = adminrestService.GetUnusedResourcesReport(nil)
+		Expect(err).ToNot(BeNil())
+	})
+	It(`Marks groups that stay idle for the idle period as stale`, func() {
+		start := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+		groups := []adminrestv1.GroupDetail{{GroupID: core.StringPtr("reports"), State: core.StringPtr("Empty")}}
+		first := adminrestv1.BuildUnusedResourcesReport(nil, groups, nil, time.Hour, start)
+		Expect(first.IdleGroups[0].Stale).To(BeFalse())
+		second := adminrestv1.BuildUnusedResourcesReport(nil, groups, first, time.Hour, start.Add(30*time.Minute))
+		Expect(second.IdleGroups[0].IdleSince).To(Equal(start))
+		Expect(second.IdleGroups[0].Stale).To(BeFalse())
+		third := adminrestv1.BuildUnusedResourcesReport(nil, groups, second, time.Hour, start.Add(time.Hour))
+		Expect(third.IdleGroups[0].Stale).To(BeTrue())
+
+		report, err := adminrestService.GetUnusedResourcesReport(adminrestService.NewGetUnusedResourcesReportOptions().SetIdlePeriod(0))
+		Expect(err).To(BeNil())
+		Expect(report.IdleGroups[0].Stale).To(BeTrue())
+	})
+	It(`Deletes confirmed resources`, func() {
+		report, err := adminrestService.GetUnusedResourcesReport(adminrestService.NewGetUnusedResourcesReportOptions().SetIdlePeriod(0))
+		Expect(err).To(BeNil())
+
+		var asked []string
+		cleanup, err := adminrestService.CleanupUnusedResources(adminrestService.NewCleanupUnusedResourcesOptions(report, func(deletion adminrestv1.UnusedResourceDeletion) bool {
+			asked = append(asked, deletion.Name)
+			return deletion.Name != "reports"
+		}))
+		Expect(err).To(BeNil())
+		Expect(asked).To(Equal([]string{"old-app", "reports", "scratch"}))
+		Expect(cleanup.Deletions).To(Equal([]adminrestv1.UnusedResourceDeletion{
+			{Kind: "consumer_group", Name: "old-app", Reason: "orphaned_group", Confirmed: true, Applied: true},
+			{Kind: "consumer_group", Name: "reports", Reason: "stale_group", Confirmed: false, Applied: false},
+			{Kind: "topic", Name: "scratch", Reason: "unused_topic", Confirmed: true, Applied: true},
+		}))
+		Expect(deletes).To(Equal([]string{"DELETE /admin/consumergroups/old-app", "DELETE /admin/topics/scratch"}))
+	})
+	It(`Skips topics that are read again before their deletion`, func() {
+		report, err := adminrestService.GetUnusedResourcesReport(adminrestService.NewGetUnusedResourcesReportOptions())
+		Expect(err).To(BeNil())
+		Expect(report.UnusedTopics).To(Equal([]string{"scratch"}))
+		billingReadsScratch = true
+
+		cleanup, err := adminrestService.CleanupUnusedResources(adminrestService.NewCleanupUnusedResourcesOptions(report, func(deletion adminrestv1.UnusedResourceDeletion) bool {
+			return deletion.Kind == adminrestv1.UnusedResourceDeletionKindTopicConst
+		}))
+		Expect(err).To(BeNil())
+		Expect(cleanup.Deletions).To(Equal([]adminrestv1.UnusedResourceDeletion{
+			{Kind: "consumer_group", Name: "old-app", Reason: "orphaned_group", Confirmed: false, Applied: false},
+			{Kind: "topic", Name: "scratch", Reason: "unused_topic", Confirmed: true, Applied: false, InUseBy: []string{"billing"}},
+		}))
+		Expect(deletes).To(BeEmpty())
+	})
+	It(`Stops at the first failed deletion`, func() {
+		report, err := adminrestService.GetUnusedResourcesReport(adminrestService.NewGetUnusedResourcesReportOptions())
+		Expect(err).To(BeNil())
+		failDelete = "DELETE /admin/consumergroups/old-app"
+
+		cleanup, err := adminrestService.CleanupUnusedResources(adminrestService.NewCleanupUnusedResourcesOptions(report, func(adminrestv1.UnusedResourceDeletion) bool { return true }))
+		Expect(err).ToNot(BeNil())
+		Expect(cleanup.Deletions[0].Applied).To(BeFalse())
+		Expect(deletes).To(BeEmpty())
+
+		_, err = adminrestService.CleanupUnusedResources(adminrestService.NewCleanupUnusedResourcesOptions(report, nil))
+		Expect(err).ToNot(BeNil())
+	})
+})
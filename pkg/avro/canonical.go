@@ -0,0 +1,140 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package avro
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"strings"
+)
+
+// crc64AvroEmpty is the fingerprint of the empty string under the CRC-64-AVRO algorithm.
+const crc64AvroEmpty uint64 = 0xc15d213aa4d7a795
+
+var crc64AvroTable = func() (table [256]uint64) {
+	for i := range table {
+		fingerprint := uint64(i)
+		for j := 0; j < 8; j++ {
+			fingerprint = (fingerprint >> 1) ^ (crc64AvroEmpty & -(fingerprint & 1))
+		}
+		table[i] = fingerprint
+	}
+	return
+}()
+
+// CanonicalForm returns the Parsing Canonical Form of the schema, as defined by the Avro specification. Two schemas
+// with the same canonical form read and write data in the same way, even if their documentation, aliases, defaults or
+// logical types differ.
+func (schema *Schema) CanonicalForm() string {
+	var out strings.Builder
+	schema.writeCanonical(&out, map[*Schema]bool{})
+	return out.String()
+}
+
+// Fingerprint64 returns the CRC-64-AVRO fingerprint of the canonical form of the schema, the fingerprint used by
+// Avro single-object encoding.
+func (schema *Schema) Fingerprint64() uint64 {
+	fingerprint := crc64AvroEmpty
+	for _, b := range []byte(schema.CanonicalForm()) {
+		fingerprint = (fingerprint >> 8) ^ crc64AvroTable[byte(fingerprint)^b]
+	}
+	return fingerprint
+}
+
+// FingerprintSHA256 returns the SHA-256 fingerprint of the canonical form of the schema.
+func (schema *Schema) FingerprintSHA256() [32]byte {
+	return sha256.Sum256([]byte(schema.CanonicalForm()))
+}
+
+// FingerprintSHA256Hex returns the SHA-256 fingerprint of the canonical form of the schema as a hexadecimal string.
+func (schema *Schema) FingerprintSHA256Hex() string {
+	fingerprint := schema.FingerprintSHA256()
+	return hex.EncodeToString(fingerprint[:])
+}
+
+// writeCanonical writes the canonical form, keeping only the attributes that affect parsing, in the order given by
+// the specification, with full names and without whitespace.
+func (schema *Schema) writeCanonical(out *strings.Builder, defined map[*Schema]bool) {
+	switch {
+	case schema.Type == TypeUnion:
+		out.WriteByte('[')
+		for i, branch := range schema.Types {
+			if i > 0 {
+				out.WriteByte(',')
+			}
+			branch.writeCanonical(out, defined)
+		}
+		out.WriteByte(']')
+		return
+	case primitiveTypes[schema.Type]:
+		out.WriteString(strconv.Quote(string(schema.Type)))
+		return
+	case schema.IsNamed() && defined[schema]:
+		out.WriteString(strconv.Quote(schema.FullName()))
+		return
+	}
+	if schema.IsNamed() {
+		defined[schema] = true
+		out.WriteString(`{"name":`)
+		out.WriteString(strconv.Quote(schema.FullName()))
+		out.WriteString(`,"type":`)
+	} else {
+		out.WriteString(`{"type":`)
+	}
+	// Error types are records as far as parsing is concerned.
+	if schema.Type == TypeError {
+		out.WriteString(`"record"`)
+	} else {
+		out.WriteString(strconv.Quote(string(schema.Type)))
+	}
+
+	switch schema.Type {
+	case TypeRecord, TypeError:
+		out.WriteString(`,"fields":[`)
+		for i, field := range schema.Fields {
+			if i > 0 {
+				out.WriteByte(',')
+			}
+			out.WriteString(`{"name":`)
+			out.WriteString(strconv.Quote(field.Name))
+			out.WriteString(`,"type":`)
+			field.Type.writeCanonical(out, defined)
+			out.WriteByte('}')
+		}
+		out.WriteByte(']')
+	case TypeEnum:
+		out.WriteString(`,"symbols":[`)
+		for i, symbol := range schema.Symbols {
+			if i > 0 {
+				out.WriteByte(',')
+			}
+			out.WriteString(strconv.Quote(symbol))
+		}
+		out.WriteByte(']')
+	case TypeArray:
+		out.WriteString(`,"items":`)
+		schema.Items.writeCanonical(out, defined)
+	case TypeMap:
+		out.WriteString(`,"values":`)
+		schema.Values.writeCanonical(out, defined)
+	case TypeFixed:
+		out.WriteString(`,"size":`)
+		out.WriteString(strconv.Itoa(schema.Size))
+	}
+	out.WriteByte('}')
+}
@@ -0,0 +1,69 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package avro
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCanonicalForm(t *testing.T) {
+	for document, expected := range map[string]string{
+		`{"type": "int"}`: `"int"`,
+		`{"type": "long", "logicalType": "timestamp-millis"}`:                                    `"long"`,
+		`["null", {"type": "string"}]`:                                                           `["null","string"]`,
+		`{"type": "fixed", "name": "MD5", "namespace": "x.y", "size": 16, "doc": "d"}`:           `{"name":"x.y.MD5","type":"fixed","size":16}`,
+		`{"type": "map", "values": {"type": "array", "items": "bytes"}}`:                         `{"type":"map","values":{"type":"array","items":"bytes"}}`,
+		`{"namespace": "n", "name": "E", "type": "enum", "symbols": ["A", "B"], "default": "A"}`: `{"name":"n.E","type":"enum","symbols":["A","B"]}`,
+		`{"type": "record", "name": "R", "namespace": "n", "doc": "x", "aliases": ["Q"], "fields": [
+			{"name": "next", "type": ["null", "R"], "default": null, "doc": "y"},
+			{"name": "kind", "type": {"type": "enum", "name": "Kind", "symbols": ["K"]}},
+			{"name": "other", "type": "Kind", "order": "descending"}
+		]}`: `{"name":"n.R","type":"record","fields":[{"name":"next","type":["null","n.R"]},{"name":"kind","type":{"name":"n.Kind","type":"enum","symbols":["K"]}},{"name":"other","type":"n.Kind"}]}`,
+	} {
+		schema, err := Parse(document)
+		assert.Nil(t, err, document)
+		assert.Equal(t, expected, schema.CanonicalForm(), document)
+	}
+}
+
+func TestFingerprints(t *testing.T) {
+	// Test vectors from the Avro specification test suite
+	for document, expected := range map[string]int64{
+		`"null"`:    7195948357588979594,
+		`"boolean"`: -6970731678124411036,
+		`"int"`:     8247732601305521295,
+		`{"type": "fixed", "name": "foo", "size": 15}`: 1756455273707447556,
+	} {
+		schema, err := Parse(document)
+		assert.Nil(t, err)
+		assert.Equal(t, expected, int64(schema.Fingerprint64()), document)
+	}
+
+	schema, err := Parse(`"int"`)
+	assert.Nil(t, err)
+	assert.Equal(t, "3f2b87a9fe7cc9b13835598c3981cd45e3e355309e5090aa0933d7becb6fba45", schema.FingerprintSHA256Hex())
+
+	// Documentation and defaults do not change the fingerprint
+	first, err := Parse(`{"type": "record", "name": "R", "fields": [{"name": "a", "type": "int"}]}`)
+	assert.Nil(t, err)
+	second, err := Parse(`{"type": "record", "name": "R", "doc": "A record.", "fields": [{"name": "a", "type": "int", "default": 0}]}`)
+	assert.Nil(t, err)
+	assert.Equal(t, first.Fingerprint64(), second.Fingerprint64())
+	assert.Equal(t, first.FingerprintSHA256(), second.FingerprintSHA256())
+}
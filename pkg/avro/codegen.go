@@ -0,0 +1,487 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package avro
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	common "github.com/IBM/eventstreams-go-sdk/pkg/common"
+	"github.com/IBM/eventstreams-go-sdk/pkg/schemaregistryv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// registryVersionHeader is the response header in which the schema registry returns the version of a schema.
+const registryVersionHeader = "X-Registry-Version"
+
+// GeneratorSource : An Avro schema to generate Go types from, together with where it came from.
+type GeneratorSource struct {
+	// The parsed schema.
+	Schema *Schema
+
+	// The schema document, embedded in the generated code.
+	JSON string
+
+	// The ID of the schema in the registry. Empty for schemas read from files.
+	SchemaID string
+
+	// The version of the schema in the registry. Zero for schemas read from files.
+	Version int64
+
+	// The file the schema was read from, if any.
+	File string
+}
+
+// Generator : Generates Go types with `avro` struct tags from Avro schemas.
+//
+// Records become structs, enums become string types with a constant per symbol, fixed types become byte arrays,
+// optional values (unions of null and one other type) become pointers, and other unions become interface{} fields.
+// The timestamp, date and time logical types map to time.Time and time.Duration, and decimals map to *big.Rat.
+//
+// For each top-level schema the generated code also contains the schema document and, for schemas pulled from the
+// registry, the schema ID and version, so that an application can check with VerifyRegistrySchema at startup that
+// its generated types still match the registry.
+type Generator struct {
+	packageName string
+	sources     []*GeneratorSource
+}
+
+// NewGenerator : Instantiate Generator
+func NewGenerator(packageName string) *Generator {
+	return &Generator{packageName: packageName}
+}
+
+// AddSource adds a schema to generate types for.
+func (generator *Generator) AddSource(source *GeneratorSource) {
+	generator.sources = append(generator.sources, source)
+}
+
+// AddFiles reads and parses `.avsc` files, in order, and adds them to the generator. Named types defined in one file
+// can be referenced by the files that follow it.
+func (generator *Generator) AddFiles(paths ...string) error {
+	documents := make([]string, 0, len(paths))
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return core.SDKErrorf(err, "", "read-file-error", common.GetComponentInfo())
+		}
+		documents = append(documents, string(data))
+	}
+	schemas, err := ParseMultiple(documents...)
+	if err != nil {
+		return err
+	}
+	for i, path := range paths {
+		generator.AddSource(&GeneratorSource{
+			Schema: schemas[i],
+			JSON:   compactJSON(documents[i]),
+			File:   path,
+		})
+	}
+	return nil
+}
+
+// FetchRegistrySchema retrieves a schema from the registry and parses it. When version is nil the latest version is
+// retrieved with GetLatestSchema, otherwise that version is retrieved with GetVersion.
+func FetchRegistrySchema(ctx context.Context, registry *schemaregistryv1.SchemaregistryV1, schemaID string, version *int64) (source *GeneratorSource, err error) {
+	var avroSchema *schemaregistryv1.AvroSchema
+	var response *core.DetailedResponse
+	if version == nil {
+		avroSchema, response, err = registry.GetLatestSchemaWithContext(ctx, registry.NewGetLatestSchemaOptions(schemaID))
+	} else {
+		avroSchema, response, err = registry.GetVersionWithContext(ctx, registry.NewGetVersionOptions(schemaID, *version))
+	}
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "fetch-schema-error")
+		return
+	}
+
+	source = &GeneratorSource{SchemaID: schemaID}
+	if version != nil {
+		source.Version = *version
+	} else {
+		source.Version, err = latestVersion(ctx, registry, schemaID, response)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	data, err := json.Marshal(avroSchema.Schema)
+	if err != nil {
+		return nil, core.SDKErrorf(err, "", "avro-marshal-error", common.GetComponentInfo())
+	}
+	source.JSON = string(data)
+	source.Schema, err = Parse(source.JSON)
+	if err != nil {
+		return nil, err
+	}
+	return source, nil
+}
+
+// latestVersion returns the version of a schema returned by GetLatestSchema, from the response header when the
+// registry provides it and from the list of versions otherwise.
+func latestVersion(ctx context.Context, registry *schemaregistryv1.SchemaregistryV1, schemaID string, response *core.DetailedResponse) (int64, error) {
+	if response != nil {
+		if header := response.Headers.Get(registryVersionHeader); header != "" {
+			if version, err := strconv.ParseInt(header, 10, 64); err == nil {
+				return version, nil
+			}
+		}
+	}
+	versions, _, err := registry.ListVersionsWithContext(ctx, registry.NewListVersionsOptions(schemaID))
+	if err != nil {
+		return 0, core.RepurposeSDKProblem(err, "list-versions-error")
+	}
+	var latest int64
+	for _, version := range versions {
+		if version > latest {
+			latest = version
+		}
+	}
+	return latest, nil
+}
+
+// VerifyRegistrySchema checks that a version of a schema in the registry is the same as the schema document that
+// code was generated from, typically the <Type>SchemaJSON constant of a generated type. An error is returned if the
+// schema cannot be retrieved or if it differs.
+func VerifyRegistrySchema(ctx context.Context, registry *schemaregistryv1.SchemaregistryV1, schemaID string, version int64, schemaJSON string) error {
+	avroSchema, _, err := registry.GetVersionWithContext(ctx, registry.NewGetVersionOptions(schemaID, version))
+	if err != nil {
+		return core.RepurposeSDKProblem(err, "fetch-schema-error")
+	}
+	var expected interface{}
+	if err := json.Unmarshal([]byte(schemaJSON), &expected); err != nil {
+		return core.SDKErrorf(err, "", "avro-parse-error", common.GetComponentInfo())
+	}
+	// Maps are marshaled with sorted keys, so the comparison does not depend on the order of properties.
+	expectedData, err := json.Marshal(expected)
+	if err != nil {
+		return core.SDKErrorf(err, "", "avro-marshal-error", common.GetComponentInfo())
+	}
+	actualData, err := json.Marshal(avroSchema.Schema)
+	if err != nil {
+		return core.SDKErrorf(err, "", "avro-marshal-error", common.GetComponentInfo())
+	}
+	if !bytes.Equal(expectedData, actualData) {
+		return core.SDKErrorf(nil, fmt.Sprintf("schema %s version %d in the registry does not match the generated code", schemaID, version), "schema-mismatch", common.GetComponentInfo())
+	}
+	return nil
+}
+
+// Generate returns the formatted Go source for the types of all of the schemas added to the generator.
+func (generator *Generator) Generate() ([]byte, error) {
+	if generator.packageName == "" {
+		return nil, core.SDKErrorf(nil, "a package name is required", "codegen-error", common.GetComponentInfo())
+	}
+	state := &codegen{
+		imports: map[string]bool{},
+		emitted: map[*Schema]bool{},
+		goNames: map[string]string{},
+	}
+	for _, source := range generator.sources {
+		if err := state.source(source); err != nil {
+			return nil, err
+		}
+	}
+
+	var out bytes.Buffer
+	out.WriteString("// Code generated by avrogen. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&out, "package %s\n\n", generator.packageName)
+	if len(state.imports) > 0 {
+		imports := make([]string, 0, len(state.imports))
+		for path := range state.imports {
+			imports = append(imports, strconv.Quote(path))
+		}
+		sort.Strings(imports)
+		fmt.Fprintf(&out, "import (\n%s\n)\n\n", strings.Join(imports, "\n"))
+	}
+	out.Write(state.body.Bytes())
+
+	formatted, err := format.Source(out.Bytes())
+	if err != nil {
+		return nil, core.SDKErrorf(err, "", "codegen-format-error", common.GetComponentInfo())
+	}
+	return formatted, nil
+}
+
+type codegen struct {
+	body    bytes.Buffer
+	imports map[string]bool
+	emitted map[*Schema]bool
+
+	// goNames maps Go type names to the full Avro names they were generated for, to detect collisions.
+	goNames map[string]string
+}
+
+func (state *codegen) errorf(format string, args ...interface{}) error {
+	return core.SDKErrorf(nil, fmt.Sprintf(format, args...), "codegen-error", common.GetComponentInfo())
+}
+
+func (state *codegen) source(source *GeneratorSource) error {
+	schema := source.Schema
+	if !schema.IsNamed() {
+		return state.errorf("top-level schema must be a record, enum or fixed type, not %s", schema.Type)
+	}
+	if err := state.named(schema); err != nil {
+		return err
+	}
+
+	typeName := exportedName(schema.Name)
+	if source.File != "" {
+		fmt.Fprintf(&state.body, "// %sSchemaJSON is the Avro schema that %s was generated from, read from %s.\n", typeName, typeName, filepath.Base(source.File))
+	} else {
+		fmt.Fprintf(&state.body, "// %sSchemaJSON is the Avro schema that %s was generated from.\n", typeName, typeName)
+	}
+	fmt.Fprintf(&state.body, "const %sSchemaJSON = %s\n\n", typeName, goString(source.JSON))
+	if source.SchemaID != "" {
+		fmt.Fprintf(&state.body, "// %sSchemaID is the ID of the registry schema that %s was generated from.\n", typeName, typeName)
+		fmt.Fprintf(&state.body, "const %sSchemaID = %s\n\n", typeName, strconv.Quote(source.SchemaID))
+		fmt.Fprintf(&state.body, "// %sSchemaVersion is the version of the registry schema that %s was generated from.\n", typeName, typeName)
+		fmt.Fprintf(&state.body, "const %sSchemaVersion int64 = %d\n\n", typeName, source.Version)
+	}
+	return nil
+}
+
+// named emits the declaration of a named type and of the named types it uses, once each.
+func (state *codegen) named(schema *Schema) error {
+	if state.emitted[schema] {
+		return nil
+	}
+	state.emitted[schema] = true
+
+	typeName := exportedName(schema.Name)
+	if fullName, exists := state.goNames[typeName]; exists && fullName != schema.FullName() {
+		return state.errorf("types %s and %s both map to the Go type name %s", fullName, schema.FullName(), typeName)
+	}
+	state.goNames[typeName] = schema.FullName()
+
+	switch schema.Type {
+	case TypeEnum:
+		writeDoc(&state.body, typeName, schema.Doc, schema.FullName())
+		fmt.Fprintf(&state.body, "type %s string\n\n", typeName)
+		fmt.Fprintf(&state.body, "// Constants associated with the %s type.\nconst (\n", typeName)
+		for _, symbol := range schema.Symbols {
+			fmt.Fprintf(&state.body, "\t%s%s %s = %s\n", typeName, exportedName(symbol), typeName, strconv.Quote(symbol))
+		}
+		state.body.WriteString(")\n\n")
+		return nil
+	case TypeFixed:
+		writeDoc(&state.body, typeName, schema.Doc, schema.FullName())
+		fmt.Fprintf(&state.body, "type %s [%d]byte\n\n", typeName, schema.Size)
+		return nil
+	}
+
+	// Build the struct before emitting it so that nested named types are declared after it.
+	var fields bytes.Buffer
+	var nested []*Schema
+	for _, field := range schema.Fields {
+		goType, err := state.goType(field.Type, &nested)
+		if err != nil {
+			return err
+		}
+		if field.Doc != "" {
+			for _, line := range strings.Split(field.Doc, "\n") {
+				fmt.Fprintf(&fields, "\t// %s\n", strings.TrimSpace(line))
+			}
+		}
+		fmt.Fprintf(&fields, "\t%s %s `avro:%s`\n", exportedName(field.Name), goType, strconv.Quote(field.Name))
+	}
+	writeDoc(&state.body, typeName, schema.Doc, schema.FullName())
+	fmt.Fprintf(&state.body, "type %s struct {\n%s}\n\n", typeName, fields.String())
+
+	for _, child := range nested {
+		if err := state.named(child); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// goType returns the Go type used for a schema, collecting the named types it refers to.
+func (state *codegen) goType(schema *Schema, nested *[]*Schema) (string, error) {
+	if schema.Type == TypeFixed && schema.LogicalType == LogicalTypeDecimal {
+		state.imports["math/big"] = true
+		return "*big.Rat", nil
+	}
+	if schema.IsNamed() {
+		*nested = append(*nested, schema)
+		return exportedName(schema.Name), nil
+	}
+
+	switch schema.LogicalType {
+	case LogicalTypeTimestampMillis, LogicalTypeTimestampMicros, LogicalTypeLocalTimestampMillis, LogicalTypeLocalTimestampMicros:
+		if schema.Type == TypeLong {
+			state.imports["time"] = true
+			return "time.Time", nil
+		}
+	case LogicalTypeDate:
+		if schema.Type == TypeInt {
+			state.imports["time"] = true
+			return "time.Time", nil
+		}
+	case LogicalTypeTimeMillis, LogicalTypeTimeMicros:
+		if schema.Type == TypeInt || schema.Type == TypeLong {
+			state.imports["time"] = true
+			return "time.Duration", nil
+		}
+	case LogicalTypeDecimal:
+		if schema.Type == TypeBytes {
+			state.imports["math/big"] = true
+			return "*big.Rat", nil
+		}
+	}
+
+	switch schema.Type {
+	case TypeNull:
+		return "interface{}", nil
+	case TypeBoolean:
+		return "bool", nil
+	case TypeInt:
+		return "int32", nil
+	case TypeLong:
+		return "int64", nil
+	case TypeFloat:
+		return "float32", nil
+	case TypeDouble:
+		return "float64", nil
+	case TypeBytes:
+		return "[]byte", nil
+	case TypeString:
+		// The uuid logical type is carried as a string.
+		return "string", nil
+	case TypeArray:
+		items, err := state.goType(schema.Items, nested)
+		return "[]" + items, err
+	case TypeMap:
+		values, err := state.goType(schema.Values, nested)
+		return "map[string]" + values, err
+	case TypeUnion:
+		return state.unionType(schema, nested)
+	}
+	return "", state.errorf("unsupported schema type %s", schema.Type)
+}
+
+// unionType maps ["null", T] to a pointer to T, or to T itself when T is already nillable, and any other union to
+// interface{}.
+func (state *codegen) unionType(schema *Schema, nested *[]*Schema) (string, error) {
+	var nonNull []*Schema
+	for _, branch := range schema.Types {
+		if branch.Type != TypeNull {
+			nonNull = append(nonNull, branch)
+		}
+	}
+	if len(nonNull) != 1 {
+		for _, branch := range nonNull {
+			if _, err := state.goType(branch, nested); err != nil {
+				return "", err
+			}
+		}
+		return "interface{}", nil
+	}
+	goType, err := state.goType(nonNull[0], nested)
+	if err != nil || len(schema.Types) == 1 {
+		return goType, err
+	}
+	if strings.HasPrefix(goType, "*") || strings.HasPrefix(goType, "[]") || strings.HasPrefix(goType, "map[") || goType == "interface{}" {
+		return goType, nil
+	}
+	return "*" + goType, nil
+}
+
+func writeDoc(out *bytes.Buffer, typeName string, doc string, fullName string) {
+	if doc == "" {
+		fmt.Fprintf(out, "// %s is generated from the Avro type %s.\n", typeName, fullName)
+		return
+	}
+	lines := strings.Split(doc, "\n")
+	fmt.Fprintf(out, "// %s : %s\n", typeName, strings.TrimSpace(lines[0]))
+	for _, line := range lines[1:] {
+		fmt.Fprintf(out, "// %s\n", strings.TrimSpace(line))
+	}
+}
+
+// commonInitialisms are written in upper case in generated identifiers, following Go naming conventions.
+var commonInitialisms = map[string]bool{
+	"API": true, "HTTP": true, "ID": true, "JSON": true, "SQL": true, "URI": true, "URL": true, "UUID": true,
+}
+
+// exportedName converts an Avro name such as `user_id`, `userId` or `USER_ID` to an exported Go identifier.
+func exportedName(name string) string {
+	var parts []string
+	for _, word := range strings.FieldsFunc(name, func(r rune) bool { return r == '_' || r == '-' || r == '.' }) {
+		parts = append(parts, splitCamelCase(word)...)
+	}
+	var out strings.Builder
+	for _, part := range parts {
+		upper := strings.ToUpper(part)
+		if commonInitialisms[upper] {
+			out.WriteString(upper)
+			continue
+		}
+		if upper == part && !strings.ContainsAny(part, "0123456789") {
+			// Title-case words in upper case, such as enum symbols, but keep names such as MD5 or SHA256.
+			part = strings.ToLower(part)
+		}
+		runes := []rune(part)
+		runes[0] = unicode.ToUpper(runes[0])
+		out.WriteString(string(runes))
+	}
+	result := out.String()
+	if result == "" || unicode.IsDigit([]rune(result)[0]) {
+		result = "X" + result
+	}
+	return result
+}
+
+// splitCamelCase splits a word at each transition from a lower case letter or digit to an upper case letter.
+func splitCamelCase(word string) []string {
+	var parts []string
+	runes := []rune(word)
+	start := 0
+	for i := 1; i < len(runes); i++ {
+		if unicode.IsUpper(runes[i]) && !unicode.IsUpper(runes[i-1]) {
+			parts = append(parts, string(runes[start:i]))
+			start = i
+		}
+	}
+	return append(parts, string(runes[start:]))
+}
+
+// goString returns a Go string literal, preferring a raw string literal for readability.
+func goString(value string) string {
+	if !strings.Contains(value, "`") {
+		return "`" + value + "`"
+	}
+	return strconv.Quote(value)
+}
+
+func compactJSON(document string) string {
+	var out bytes.Buffer
+	if err := json.Compact(&out, []byte(document)); err != nil {
+		return strings.TrimSpace(document)
+	}
+	return out.String()
+}
@@ -0,0 +1,178 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package avro
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/IBM/eventstreams-go-sdk/pkg/schemaregistryv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenerate(t *testing.T) {
+	schema, err := Parse(userSchema)
+	assert.Nil(t, err)
+	generator := NewGenerator("events")
+	generator.AddSource(&GeneratorSource{Schema: schema, JSON: compactJSON(userSchema), SchemaID: "users", Version: 3})
+
+	code, err := generator.Generate()
+	assert.Nil(t, err)
+	source := normalizeSpace(string(code))
+	assert.Contains(t, source, "// Code generated by avrogen. DO NOT EDIT.")
+	assert.Contains(t, source, "package events")
+	assert.Contains(t, source, "\"math/big\"\n \"time\"")
+	assert.Contains(t, source, "// User : A registered user.\ntype User struct {")
+	assert.Contains(t, source, "UserID string `avro:\"user_id\"`")
+	assert.Contains(t, source, "// The contact address.\n Email *string `avro:\"email\"`")
+	assert.Contains(t, source, "Status Status `avro:\"status\"`")
+	assert.Contains(t, source, "CreatedAt time.Time `avro:\"createdAt\"`")
+	assert.Contains(t, source, "Balance *big.Rat `avro:\"balance\"`")
+	assert.Contains(t, source, "Tags map[string]string `avro:\"tags\"`")
+	assert.Contains(t, source, "Fingerprint MD5 `avro:\"fingerprint\"`")
+	assert.Contains(t, source, "Referrer *User `avro:\"referrer\"`")
+	assert.Contains(t, source, "Payload interface{} `avro:\"payload\"`")
+	assert.Contains(t, source, "type Status string")
+	assert.Contains(t, source, "StatusActive Status = \"ACTIVE\"")
+	assert.Contains(t, source, "type MD5 [16]byte")
+	assert.Contains(t, source, "const UserSchemaID = \"users\"")
+	assert.Contains(t, source, "const UserSchemaVersion int64 = 3")
+	assert.Contains(t, source, "const UserSchemaJSON = `{\"type\":\"record\",\"name\":\"User\"")
+}
+
+func TestGenerateFromFiles(t *testing.T) {
+	dir := t.TempDir()
+	address := filepath.Join(dir, "address.avsc")
+	customer := filepath.Join(dir, "customer.avsc")
+	assert.Nil(t, os.WriteFile(address, []byte(`{"type": "record", "name": "Address", "fields": [{"name": "city", "type": "string"}]}`), 0644))
+	assert.Nil(t, os.WriteFile(customer, []byte(`{"type": "record", "name": "Customer", "fields": [
+		{"name": "home", "type": "Address"},
+		{"name": "birth_date", "type": {"type": "int", "logicalType": "date"}},
+		{"name": "nicknames", "type": ["null", {"type": "array", "items": "string"}]}
+	]}`), 0644))
+
+	generator := NewGenerator("customers")
+	assert.Nil(t, generator.AddFiles(address, customer))
+	code, err := generator.Generate()
+	assert.Nil(t, err)
+	source := normalizeSpace(string(code))
+	assert.Contains(t, source, "Home Address `avro:\"home\"`")
+	assert.Contains(t, source, "BirthDate time.Time `avro:\"birth_date\"`")
+	assert.Contains(t, source, "Nicknames []string `avro:\"nicknames\"`")
+	assert.Contains(t, source, "// CustomerSchemaJSON is the Avro schema that Customer was generated from, read from customer.avsc.")
+	assert.NotContains(t, source, "CustomerSchemaID")
+
+	// Address is declared once even though it is both a top-level schema and a field type
+	assert.Equal(t, 1, countOccurrences(source, "type Address struct"))
+
+	assert.NotNil(t, NewGenerator("x").AddFiles(filepath.Join(dir, "missing.avsc")))
+	_, err = NewGenerator("").Generate()
+	assert.NotNil(t, err)
+}
+
+func TestGenerateErrors(t *testing.T) {
+	schema, err := Parse(`"string"`)
+	assert.Nil(t, err)
+	generator := NewGenerator("events")
+	generator.AddSource(&GeneratorSource{Schema: schema, JSON: `"string"`})
+	_, err = generator.Generate()
+	assert.NotNil(t, err)
+
+	schemas, err := ParseMultiple(
+		`{"type": "record", "name": "Event", "namespace": "a", "fields": []}`,
+		`{"type": "record", "name": "Event", "namespace": "b", "fields": []}`,
+	)
+	assert.Nil(t, err)
+	generator = NewGenerator("events")
+	generator.AddSource(&GeneratorSource{Schema: schemas[0], JSON: "{}"})
+	generator.AddSource(&GeneratorSource{Schema: schemas[1], JSON: "{}"})
+	_, err = generator.Generate()
+	assert.NotNil(t, err)
+}
+
+func TestExportedName(t *testing.T) {
+	for input, expected := range map[string]string{
+		"user_id":     "UserID",
+		"userId":      "UserID",
+		"USER_STATUS": "UserStatus",
+		"createdAt":   "CreatedAt",
+		"url":         "URL",
+		"2fa":         "X2fa",
+		"book-record": "BookRecord",
+	} {
+		assert.Equal(t, expected, exportedName(input), input)
+	}
+}
+
+func TestFetchAndVerifyRegistrySchema(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		res.Header().Set("Content-type", "application/json")
+		switch req.URL.EscapedPath() {
+		case "/artifacts/books":
+			res.Header().Set("X-Registry-Version", "4")
+			res.WriteHeader(200)
+			fmt.Fprintf(res, "%s", `{"schema": {"type": "record", "name": "Book", "fields": [{"name": "title", "type": "string"}]}}`)
+		case "/artifacts/books/versions/4":
+			res.WriteHeader(200)
+			fmt.Fprintf(res, "%s", `{"schema": {"name": "Book", "type": "record", "fields": [{"type": "string", "name": "title"}]}}`)
+		default:
+			res.WriteHeader(404)
+		}
+	}))
+	defer testServer.Close()
+
+	registry, err := schemaregistryv1.NewSchemaregistryV1(&schemaregistryv1.SchemaregistryV1Options{
+		URL:           testServer.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	assert.Nil(t, err)
+
+	source, err := FetchRegistrySchema(context.Background(), registry, "books", nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "books", source.SchemaID)
+	assert.Equal(t, int64(4), source.Version)
+	assert.Equal(t, "Book", source.Schema.Name)
+
+	assert.Nil(t, VerifyRegistrySchema(context.Background(), registry, "books", 4, source.JSON))
+	err = VerifyRegistrySchema(context.Background(), registry, "books", 4, `{"type": "record", "name": "Book", "fields": []}`)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "does not match")
+
+	_, err = FetchRegistrySchema(context.Background(), registry, "books", core.Int64Ptr(9))
+	assert.NotNil(t, err)
+}
+
+// normalizeSpace collapses the alignment that gofmt adds to struct fields and constants.
+func normalizeSpace(source string) string {
+	return regexp.MustCompile(`[ \t]+`).ReplaceAllString(source, " ")
+}
+
+func countOccurrences(source string, substring string) (count int) {
+	for index := 0; index+len(substring) <= len(source); index++ {
+		if source[index:index+len(substring)] == substring {
+			count++
+		}
+	}
+	return
+}
@@ -0,0 +1,527 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package avro
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/IBM/eventstreams-go-sdk/pkg/schemaregistryv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// ChangeKind : The kind of a change between two versions of a schema.
+type ChangeKind string
+
+// Constants associated with the Change.Kind property.
+const (
+	ChangeFieldAdded         ChangeKind = "field_added"
+	ChangeFieldRemoved       ChangeKind = "field_removed"
+	ChangeFieldRenamed       ChangeKind = "field_renamed"
+	ChangeTypeChanged        ChangeKind = "type_changed"
+	ChangeNameChanged        ChangeKind = "name_changed"
+	ChangeLogicalTypeChanged ChangeKind = "logical_type_changed"
+	ChangeDefaultChanged     ChangeKind = "default_changed"
+	ChangeSymbolAdded        ChangeKind = "symbol_added"
+	ChangeSymbolRemoved      ChangeKind = "symbol_removed"
+	ChangeDocChanged         ChangeKind = "doc_changed"
+)
+
+// The values of the config of the COMPATIBILITY rule, in the order in which they are reported.
+var compatibilityConfigs = []string{
+	schemaregistryv1.UpdateGlobalRuleOptionsConfigBackwardConst,
+	schemaregistryv1.UpdateGlobalRuleOptionsConfigBackwardTransitiveConst,
+	schemaregistryv1.UpdateGlobalRuleOptionsConfigForwardConst,
+	schemaregistryv1.UpdateGlobalRuleOptionsConfigForwardTransitiveConst,
+	schemaregistryv1.UpdateGlobalRuleOptionsConfigFullConst,
+	schemaregistryv1.UpdateGlobalRuleOptionsConfigFullTransitiveConst,
+}
+
+// Change : A change between two versions of a schema.
+type Change struct {
+	// The kind of change.
+	Kind ChangeKind `json:"kind"`
+
+	// The path of the changed schema or field, such as Order.items[].price.
+	Path string `json:"path"`
+
+	// The value before the change. Types are described by name, such as long, com.example.Order or
+	// union[null,string]; defaults are JSON values.
+	Old interface{} `json:"old,omitempty"`
+
+	// The value after the change.
+	New interface{} `json:"new,omitempty"`
+
+	// Whether the new schema cannot read data written with the old schema because of the change.
+	BreaksBackward bool `json:"breaks_backward"`
+
+	// Whether the old schema cannot read data written with the new schema because of the change.
+	BreaksForward bool `json:"breaks_forward"`
+}
+
+// SchemaDiff : The structural differences between two versions of a schema. It is rendered as JSON by json.Marshal
+// and as readable text by String.
+type SchemaDiff struct {
+	// The changes, in schema order.
+	Changes []Change `json:"changes"`
+
+	// The configs of the COMPATIBILITY rule that would reject the new version.
+	IncompatibleConfigs []string `json:"incompatible_configs"`
+}
+
+// Diff compares two versions of a schema. Fields are matched by name, and a new field whose aliases include the name
+// of an old field is reported as a rename of that field. Named types are matched by full name or alias.
+//
+// Compatibility is judged using Avro schema resolution for the two versions only: a change breaks BACKWARD if the new
+// schema cannot read data written with the old one, and FORWARD if the old schema cannot read data written with the
+// new one. The transitive configs are reported as broken whenever their non-transitive counterpart is, although they
+// also check versions that are not part of the diff.
+func Diff(oldSchema *Schema, newSchema *Schema) *SchemaDiff {
+	d := &differ{compared: map[[2]*Schema]bool{}}
+	d.schema(oldSchema, newSchema, rootPath(oldSchema, newSchema))
+
+	diff := &SchemaDiff{Changes: d.changes, IncompatibleConfigs: []string{}}
+	if diff.Changes == nil {
+		diff.Changes = []Change{}
+	}
+	for _, config := range compatibilityConfigs {
+		if diff.Breaks(config) {
+			diff.IncompatibleConfigs = append(diff.IncompatibleConfigs, config)
+		}
+	}
+	return diff
+}
+
+// DiffAvroSchemas compares two schemas returned by the GetVersion or GetLatestSchema methods of the SchemaregistryV1
+// service, or built locally.
+func DiffAvroSchemas(oldSchema *schemaregistryv1.AvroSchema, newSchema *schemaregistryv1.AvroSchema) (*SchemaDiff, error) {
+	oldParsed, err := FromAvroSchema(oldSchema)
+	if err != nil {
+		return nil, err
+	}
+	newParsed, err := FromAvroSchema(newSchema)
+	if err != nil {
+		return nil, err
+	}
+	return Diff(oldParsed, newParsed), nil
+}
+
+// DiffVersions fetches two versions of a schema from the registry and compares them.
+func DiffVersions(ctx context.Context, registry *schemaregistryv1.SchemaregistryV1, schemaID string, oldVersion int64, newVersion int64) (*SchemaDiff, error) {
+	oldSchema, _, err := registry.GetVersionWithContext(ctx, registry.NewGetVersionOptions(schemaID, oldVersion))
+	if err != nil {
+		return nil, core.RepurposeSDKProblem(err, "get-version-error")
+	}
+	newSchema, _, err := registry.GetVersionWithContext(ctx, registry.NewGetVersionOptions(schemaID, newVersion))
+	if err != nil {
+		return nil, core.RepurposeSDKProblem(err, "get-version-error")
+	}
+	return DiffAvroSchemas(oldSchema, newSchema)
+}
+
+// Breaks returns whether a COMPATIBILITY rule with the given config would reject the new version of the schema.
+func (diff *SchemaDiff) Breaks(config string) bool {
+	var backward, forward bool
+	switch strings.ToUpper(config) {
+	case schemaregistryv1.UpdateGlobalRuleOptionsConfigBackwardConst, schemaregistryv1.UpdateGlobalRuleOptionsConfigBackwardTransitiveConst:
+		backward = true
+	case schemaregistryv1.UpdateGlobalRuleOptionsConfigForwardConst, schemaregistryv1.UpdateGlobalRuleOptionsConfigForwardTransitiveConst:
+		forward = true
+	case schemaregistryv1.UpdateGlobalRuleOptionsConfigFullConst, schemaregistryv1.UpdateGlobalRuleOptionsConfigFullTransitiveConst:
+		backward, forward = true, true
+	}
+	for _, change := range diff.Changes {
+		if (backward && change.BreaksBackward) || (forward && change.BreaksForward) {
+			return true
+		}
+	}
+	return false
+}
+
+// String returns the changes one per line, marked with + for additions, - for removals and ~ for other changes,
+// followed by the configs of the COMPATIBILITY rule that would reject the new version.
+func (diff *SchemaDiff) String() string {
+	if len(diff.Changes) == 0 {
+		return "No changes.\n"
+	}
+	var out strings.Builder
+	for _, change := range diff.Changes {
+		out.WriteString(change.String())
+		out.WriteByte('\n')
+	}
+	if len(diff.IncompatibleConfigs) == 0 {
+		out.WriteString("Compatible with all COMPATIBILITY configs.\n")
+	} else {
+		out.WriteString("Incompatible with COMPATIBILITY configs: " + strings.Join(diff.IncompatibleConfigs, ", ") + ".\n")
+	}
+	return out.String()
+}
+
+// String returns a one line description of the change.
+func (change Change) String() string {
+	var text string
+	switch change.Kind {
+	case ChangeFieldAdded:
+		text = fmt.Sprintf("+ %s: field added with type %v", change.Path, change.New)
+	case ChangeFieldRemoved:
+		text = fmt.Sprintf("- %s: field removed", change.Path)
+	case ChangeFieldRenamed:
+		text = fmt.Sprintf("~ %s: field renamed from %v to %v", change.Path, change.Old, change.New)
+	case ChangeSymbolAdded:
+		text = fmt.Sprintf("+ %s: symbol %v added", change.Path, change.New)
+	case ChangeSymbolRemoved:
+		text = fmt.Sprintf("- %s: symbol %v removed", change.Path, change.Old)
+	case ChangeDefaultChanged:
+		text = fmt.Sprintf("~ %s: default changed from %s to %s", change.Path, jsonText(change.Old), jsonText(change.New))
+	case ChangeDocChanged:
+		text = fmt.Sprintf("~ %s: doc changed from %q to %q", change.Path, change.Old, change.New)
+	default:
+		text = fmt.Sprintf("~ %s: %s from %v to %v", change.Path, strings.ReplaceAll(string(change.Kind), "_", " "), change.Old, change.New)
+	}
+	switch {
+	case change.BreaksBackward && change.BreaksForward:
+		text += " (breaks backward and forward compatibility)"
+	case change.BreaksBackward:
+		text += " (breaks backward compatibility)"
+	case change.BreaksForward:
+		text += " (breaks forward compatibility)"
+	}
+	return text
+}
+
+// jsonText renders a default value, where nil means that there is no default.
+func jsonText(value interface{}) string {
+	if value == nil {
+		return "none"
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(data)
+}
+
+type differ struct {
+	compared map[[2]*Schema]bool
+	changes  []Change
+}
+
+func (d *differ) report(change Change) {
+	d.changes = append(d.changes, change)
+}
+
+func rootPath(oldSchema *Schema, newSchema *Schema) string {
+	if newSchema.IsNamed() {
+		return newSchema.Name
+	}
+	if oldSchema.IsNamed() {
+		return oldSchema.Name
+	}
+	return string(newSchema.Type)
+}
+
+func (d *differ) schema(oldSchema *Schema, newSchema *Schema, path string) {
+	pair := [2]*Schema{oldSchema, newSchema}
+	if d.compared[pair] {
+		return
+	}
+	d.compared[pair] = true
+
+	if oldSchema.Type == TypeUnion || newSchema.Type == TypeUnion {
+		d.union(oldSchema, newSchema, path)
+		return
+	}
+	if !sameKind(oldSchema, newSchema) || (oldSchema.Type == TypeFixed && oldSchema.Size != newSchema.Size) {
+		d.report(Change{
+			Kind:           ChangeTypeChanged,
+			Path:           path,
+			Old:            typeName(oldSchema),
+			New:            typeName(newSchema),
+			BreaksBackward: !resolvable(oldSchema, newSchema),
+			BreaksForward:  !resolvable(newSchema, oldSchema),
+		})
+		return
+	}
+
+	if oldSchema.IsNamed() {
+		if oldSchema.FullName() != newSchema.FullName() {
+			d.report(Change{
+				Kind:           ChangeNameChanged,
+				Path:           path,
+				Old:            oldSchema.FullName(),
+				New:            newSchema.FullName(),
+				BreaksBackward: !namesMatch(oldSchema, newSchema),
+				BreaksForward:  !namesMatch(newSchema, oldSchema),
+			})
+		}
+		if oldSchema.Doc != newSchema.Doc {
+			d.report(Change{Kind: ChangeDocChanged, Path: path, Old: oldSchema.Doc, New: newSchema.Doc})
+		}
+	}
+	if oldSchema.LogicalType != newSchema.LogicalType || oldSchema.Precision != newSchema.Precision || oldSchema.Scale != newSchema.Scale {
+		// Schema resolution ignores logical types, so the change is reported without breaking compatibility.
+		d.report(Change{Kind: ChangeLogicalTypeChanged, Path: path, Old: typeName(oldSchema), New: typeName(newSchema)})
+	}
+
+	switch newSchema.Type {
+	case TypeRecord, TypeError:
+		d.fields(oldSchema, newSchema, path)
+	case TypeEnum:
+		d.symbols(oldSchema, newSchema, path)
+	case TypeArray:
+		d.schema(oldSchema.Items, newSchema.Items, path+"[]")
+	case TypeMap:
+		d.schema(oldSchema.Values, newSchema.Values, path+"{}")
+	}
+}
+
+// union compares two schemas of which at least one is a union, and compares the branches that appear in both.
+func (d *differ) union(oldSchema *Schema, newSchema *Schema, path string) {
+	oldBranches, newBranches := branches(oldSchema), branches(newSchema)
+	changed := len(oldBranches) != len(newBranches) || oldSchema.Type != newSchema.Type
+	var matched [][2]*Schema
+	for i, newBranch := range newBranches {
+		var match *Schema
+		for _, oldBranch := range oldBranches {
+			if sameKind(oldBranch, newBranch) {
+				match = oldBranch
+				break
+			}
+		}
+		if match == nil || i >= len(oldBranches) || !sameKind(oldBranches[i], newBranch) {
+			changed = true
+		}
+		if match != nil {
+			matched = append(matched, [2]*Schema{match, newBranch})
+		}
+	}
+	if changed {
+		d.report(Change{
+			Kind:           ChangeTypeChanged,
+			Path:           path,
+			Old:            typeName(oldSchema),
+			New:            typeName(newSchema),
+			BreaksBackward: !resolvable(oldSchema, newSchema),
+			BreaksForward:  !resolvable(newSchema, oldSchema),
+		})
+	}
+	for _, pair := range matched {
+		d.schema(pair[0], pair[1], path)
+	}
+}
+
+func (d *differ) fields(oldSchema *Schema, newSchema *Schema, path string) {
+	oldFields := map[string]*Field{}
+	for _, field := range oldSchema.Fields {
+		oldFields[field.Name] = field
+	}
+	matched := map[*Field]bool{}
+	for _, newField := range newSchema.Fields {
+		fieldPath := path + "." + newField.Name
+		oldField := oldFields[newField.Name]
+		if oldField == nil || matched[oldField] {
+			oldField = nil
+			for _, alias := range newField.Aliases {
+				if candidate := oldFields[alias]; candidate != nil && !matched[candidate] {
+					oldField = candidate
+					break
+				}
+			}
+			if oldField != nil {
+				// The new schema reads the old field through its alias, but the old schema does not know the new name.
+				d.report(Change{
+					Kind:          ChangeFieldRenamed,
+					Path:          fieldPath,
+					Old:           oldField.Name,
+					New:           newField.Name,
+					BreaksForward: !oldField.HasDefault,
+				})
+			}
+		}
+		if oldField == nil {
+			d.report(Change{
+				Kind:           ChangeFieldAdded,
+				Path:           fieldPath,
+				New:            typeName(newField.Type),
+				BreaksBackward: !newField.HasDefault,
+			})
+			continue
+		}
+		matched[oldField] = true
+
+		if oldField.Doc != newField.Doc {
+			d.report(Change{Kind: ChangeDocChanged, Path: fieldPath, Old: oldField.Doc, New: newField.Doc})
+		}
+		if oldField.HasDefault != newField.HasDefault || !reflect.DeepEqual(oldField.Default, newField.Default) {
+			// Defaults are only used for fields that the writer does not have, which is not the case for this field.
+			d.report(Change{Kind: ChangeDefaultChanged, Path: fieldPath, Old: fieldDefault(oldField), New: fieldDefault(newField)})
+		}
+		d.schema(oldField.Type, newField.Type, fieldPath)
+	}
+	for _, oldField := range oldSchema.Fields {
+		if !matched[oldField] {
+			d.report(Change{
+				Kind:          ChangeFieldRemoved,
+				Path:          path + "." + oldField.Name,
+				Old:           typeName(oldField.Type),
+				BreaksForward: !oldField.HasDefault,
+			})
+		}
+	}
+}
+
+func (d *differ) symbols(oldSchema *Schema, newSchema *Schema, path string) {
+	for _, symbol := range newSchema.Symbols {
+		if !containsSymbol(oldSchema.Symbols, symbol) {
+			d.report(Change{Kind: ChangeSymbolAdded, Path: path, New: symbol, BreaksForward: oldSchema.EnumDefault == ""})
+		}
+	}
+	for _, symbol := range oldSchema.Symbols {
+		if !containsSymbol(newSchema.Symbols, symbol) {
+			d.report(Change{Kind: ChangeSymbolRemoved, Path: path, Old: symbol, BreaksBackward: newSchema.EnumDefault == ""})
+		}
+	}
+	if oldSchema.EnumDefault != newSchema.EnumDefault {
+		d.report(Change{Kind: ChangeDefaultChanged, Path: path, Old: enumDefault(oldSchema), New: enumDefault(newSchema)})
+	}
+}
+
+func fieldDefault(field *Field) interface{} {
+	if !field.HasDefault {
+		return nil
+	}
+	return field.Default
+}
+
+func enumDefault(schema *Schema) interface{} {
+	if schema.EnumDefault == "" {
+		return nil
+	}
+	return schema.EnumDefault
+}
+
+func branches(schema *Schema) []*Schema {
+	if schema.Type == TypeUnion {
+		return schema.Types
+	}
+	return []*Schema{schema}
+}
+
+// sameKind returns whether two schemas that are not unions describe the same type, apart from changes that are
+// reported separately: named types match by name or alias.
+func sameKind(oldSchema *Schema, newSchema *Schema) bool {
+	if oldSchema.Type != newSchema.Type {
+		return false
+	}
+	if oldSchema.IsNamed() {
+		return namesMatch(oldSchema, newSchema) || namesMatch(newSchema, oldSchema)
+	}
+	return true
+}
+
+// namesMatch returns whether a reader schema accepts the name of a writer schema.
+func namesMatch(writer *Schema, reader *Schema) bool {
+	if writer.FullName() == reader.FullName() {
+		return true
+	}
+	for _, alias := range reader.Aliases {
+		if qualify(alias, reader.Namespace) == writer.FullName() {
+			return true
+		}
+	}
+	return false
+}
+
+// resolvable returns whether data written with the writer schema can be read with the reader schema, looking no
+// deeper than the names of named types, whose contents are compared separately.
+func resolvable(writer *Schema, reader *Schema) bool {
+	if writer.Type == TypeUnion {
+		for _, branch := range writer.Types {
+			if !resolvable(branch, reader) {
+				return false
+			}
+		}
+		return true
+	}
+	if reader.Type == TypeUnion {
+		for _, branch := range reader.Types {
+			if resolvable(writer, branch) {
+				return true
+			}
+		}
+		return false
+	}
+	if writer.Type == reader.Type {
+		switch writer.Type {
+		case TypeRecord, TypeError, TypeEnum:
+			return namesMatch(writer, reader)
+		case TypeFixed:
+			return namesMatch(writer, reader) && writer.Size == reader.Size
+		case TypeArray:
+			return resolvable(writer.Items, reader.Items)
+		case TypeMap:
+			return resolvable(writer.Values, reader.Values)
+		}
+		return true
+	}
+	switch writer.Type {
+	case TypeInt:
+		return reader.Type == TypeLong || reader.Type == TypeFloat || reader.Type == TypeDouble
+	case TypeLong:
+		return reader.Type == TypeFloat || reader.Type == TypeDouble
+	case TypeFloat:
+		return reader.Type == TypeDouble
+	case TypeString:
+		return reader.Type == TypeBytes
+	case TypeBytes:
+		return reader.Type == TypeString
+	}
+	return false
+}
+
+// typeName describes a type briefly, for example long, timestamp-millis(long), array<string> or union[null,string].
+func typeName(schema *Schema) string {
+	var name string
+	switch schema.Type {
+	case TypeUnion:
+		names := make([]string, 0, len(schema.Types))
+		for _, branch := range schema.Types {
+			names = append(names, typeName(branch))
+		}
+		return "union[" + strings.Join(names, ",") + "]"
+	case TypeArray:
+		name = "array<" + typeName(schema.Items) + ">"
+	case TypeMap:
+		name = "map<" + typeName(schema.Values) + ">"
+	default:
+		if schema.IsNamed() {
+			name = schema.FullName()
+		} else {
+			name = string(schema.Type)
+		}
+	}
+	switch schema.LogicalType {
+	case "":
+		return name
+	case LogicalTypeDecimal:
+		return fmt.Sprintf("decimal(%d,%d)(%s)", schema.Precision, schema.Scale, name)
+	}
+	return schema.LogicalType + "(" + name + ")"
+}
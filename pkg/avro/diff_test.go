@@ -0,0 +1,156 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package avro
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/IBM/eventstreams-go-sdk/pkg/schemaregistryv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func mustParse(t *testing.T, data string) *Schema {
+	schema, err := Parse(data)
+	assert.Nil(t, err)
+	return schema
+}
+
+func TestDiff(t *testing.T) {
+	oldSchema := mustParse(t, `{"type": "record", "name": "Order", "namespace": "com.example", "fields": [
+		{"name": "id", "type": "int"},
+		{"name": "customer", "type": "string"},
+		{"name": "note", "type": "string", "default": ""},
+		{"name": "status", "type": {"type": "enum", "name": "Status", "symbols": ["NEW", "PAID", "LOST"]}},
+		{"name": "total", "type": "double", "doc": "Total"},
+		{"name": "tags", "type": {"type": "array", "items": "string"}, "default": []}
+	]}`)
+	newSchema := mustParse(t, `{"type": "record", "name": "Order", "namespace": "com.example", "fields": [
+		{"name": "id", "type": "long"},
+		{"name": "buyer", "type": "string", "aliases": ["customer"]},
+		{"name": "status", "type": {"type": "enum", "name": "Status", "symbols": ["NEW", "PAID", "SHIPPED"]}},
+		{"name": "total", "type": "double", "doc": "Total price"},
+		{"name": "tags", "type": {"type": "array", "items": "bytes"}},
+		{"name": "currency", "type": "string"}
+	]}`)
+
+	diff := Diff(oldSchema, newSchema)
+	assert.Equal(t, []Change{
+		{Kind: ChangeTypeChanged, Path: "Order.id", Old: "int", New: "long", BreaksForward: true},
+		{Kind: ChangeFieldRenamed, Path: "Order.buyer", Old: "customer", New: "buyer", BreaksForward: true},
+		{Kind: ChangeSymbolAdded, Path: "Order.status", New: "SHIPPED", BreaksForward: true},
+		{Kind: ChangeSymbolRemoved, Path: "Order.status", Old: "LOST", BreaksBackward: true},
+		{Kind: ChangeDocChanged, Path: "Order.total", Old: "Total", New: "Total price"},
+		{Kind: ChangeDefaultChanged, Path: "Order.tags", Old: []interface{}{}, New: nil},
+		{Kind: ChangeTypeChanged, Path: "Order.tags[]", Old: "string", New: "bytes"},
+		{Kind: ChangeFieldAdded, Path: "Order.currency", New: "string", BreaksBackward: true},
+		{Kind: ChangeFieldRemoved, Path: "Order.note", Old: "string"},
+	}, diff.Changes)
+	assert.Equal(t, []string{"BACKWARD", "BACKWARD_TRANSITIVE", "FORWARD", "FORWARD_TRANSITIVE", "FULL", "FULL_TRANSITIVE"}, diff.IncompatibleConfigs)
+	assert.True(t, diff.Breaks("backward"))
+	assert.False(t, diff.Breaks("NONE"))
+
+	text := diff.String()
+	assert.Contains(t, text, "~ Order.id: type changed from int to long (breaks forward compatibility)\n")
+	assert.Contains(t, text, "~ Order.buyer: field renamed from customer to buyer (breaks forward compatibility)\n")
+	assert.Contains(t, text, "- Order.status: symbol LOST removed (breaks backward compatibility)\n")
+	assert.Contains(t, text, "~ Order.tags: default changed from [] to none\n")
+	assert.Contains(t, text, "+ Order.currency: field added with type string (breaks backward compatibility)\n")
+	assert.Contains(t, text, "- Order.note: field removed\n")
+	assert.Contains(t, text, "Incompatible with COMPATIBILITY configs: BACKWARD, BACKWARD_TRANSITIVE, FORWARD")
+
+	data, err := json.Marshal(diff)
+	assert.Nil(t, err)
+	var decoded map[string]interface{}
+	assert.Nil(t, json.Unmarshal(data, &decoded))
+	first := decoded["changes"].([]interface{})[0].(map[string]interface{})
+	assert.Equal(t, map[string]interface{}{"kind": "type_changed", "path": "Order.id", "old": "int", "new": "long", "breaks_backward": false, "breaks_forward": true}, first)
+}
+
+func TestDiffCompatibleChanges(t *testing.T) {
+	oldSchema := mustParse(t, `{"type": "record", "name": "Order", "fields": [
+		{"name": "id", "type": "string"},
+		{"name": "note", "type": ["null", "string"], "default": null},
+		{"name": "status", "type": {"type": "enum", "name": "Status", "symbols": ["NEW"], "default": "NEW"}}
+	]}`)
+	newSchema := mustParse(t, `{"type": "record", "name": "Order", "fields": [
+		{"name": "id", "type": "string"},
+		{"name": "status", "type": {"type": "enum", "name": "Status", "symbols": ["NEW", "PAID"], "default": "NEW"}},
+		{"name": "total", "type": "double", "default": 0}
+	]}`)
+
+	diff := Diff(oldSchema, newSchema)
+	assert.Len(t, diff.Changes, 3)
+	assert.Empty(t, diff.IncompatibleConfigs)
+	assert.Contains(t, diff.String(), "Compatible with all COMPATIBILITY configs.")
+
+	diff = Diff(oldSchema, oldSchema)
+	assert.Empty(t, diff.Changes)
+	assert.Equal(t, "No changes.\n", diff.String())
+}
+
+func TestDiffUnionsAndNames(t *testing.T) {
+	oldSchema := mustParse(t, `{"type": "record", "name": "Order", "fields": [
+		{"name": "address", "type": {"type": "record", "name": "Address", "fields": [{"name": "city", "type": "string"}]}},
+		{"name": "amount", "type": "string"}
+	]}`)
+	newSchema := mustParse(t, `{"type": "record", "name": "Order", "fields": [
+		{"name": "address", "type": ["null", {"type": "record", "name": "Location", "aliases": ["Address"], "fields": [
+			{"name": "city", "type": "string"}, {"name": "zip", "type": "string"}]}]},
+		{"name": "amount", "type": {"type": "bytes", "logicalType": "decimal", "precision": 10, "scale": 2}}
+	]}`)
+
+	diff := Diff(oldSchema, newSchema)
+	assert.Equal(t, []Change{
+		{Kind: ChangeTypeChanged, Path: "Order.address", Old: "Address", New: "union[null,Location]", BreaksForward: true},
+		{Kind: ChangeNameChanged, Path: "Order.address", Old: "Address", New: "Location", BreaksForward: true},
+		{Kind: ChangeFieldAdded, Path: "Order.address.zip", New: "string", BreaksBackward: true},
+		{Kind: ChangeTypeChanged, Path: "Order.amount", Old: "string", New: "decimal(10,2)(bytes)"},
+	}, diff.Changes)
+}
+
+func TestDiffVersions(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		res.Header().Set("Content-type", "application/json")
+		res.WriteHeader(200)
+		switch req.URL.EscapedPath() {
+		case "/artifacts/orders/versions/1":
+			fmt.Fprintf(res, "%s", `{"schema": {"type": "record", "name": "Order", "fields": [{"name": "id", "type": "int"}]}}`)
+		default:
+			fmt.Fprintf(res, "%s", `{"schema": {"type": "record", "name": "Order", "fields": [{"name": "id", "type": "long"}]}}`)
+		}
+	}))
+	defer testServer.Close()
+
+	registry, err := schemaregistryv1.NewSchemaregistryV1(&schemaregistryv1.SchemaregistryV1Options{
+		URL:           testServer.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	assert.Nil(t, err)
+
+	diff, err := DiffVersions(context.Background(), registry, "orders", 1, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"FORWARD", "FORWARD_TRANSITIVE", "FULL", "FULL_TRANSITIVE"}, diff.IncompatibleConfigs)
+
+	_, err = DiffAvroSchemas(&schemaregistryv1.AvroSchema{}, &schemaregistryv1.AvroSchema{})
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,297 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package avro
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+
+	common "github.com/IBM/eventstreams-go-sdk/pkg/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Constants associated with the LintFinding.Rule property.
+// The rules checked by a LintConfig.
+const (
+	LintRuleRecordDoc       = "record-doc"
+	LintRuleFieldDoc        = "field-doc"
+	LintRuleNamespace       = "namespace"
+	LintRuleFieldNameCase   = "field-name-case"
+	LintRuleOptionalDefault = "optional-default"
+	LintRuleBannedType      = "banned-type"
+	LintRuleMaxDepth        = "max-depth"
+)
+
+// Constants associated with the LintFinding.Severity property.
+const (
+	LintSeverityError   = "error"
+	LintSeverityWarning = "warning"
+)
+
+// Constants associated with the LintConfig.FieldNameCase property.
+const (
+	FieldNameCaseCamel  = "camelCase"
+	FieldNameCasePascal = "PascalCase"
+	FieldNameCaseSnake  = "snake_case"
+)
+
+var fieldNameCasePatterns = map[string]*regexp.Regexp{
+	FieldNameCaseCamel:  regexp.MustCompile(`^[a-z][a-zA-Z0-9]*$`),
+	FieldNameCasePascal: regexp.MustCompile(`^[A-Z][a-zA-Z0-9]*$`),
+	FieldNameCaseSnake:  regexp.MustCompile(`^[a-z][a-z0-9]*(_[a-z0-9]+)*$`),
+}
+
+// LintConfig : The conventions that a linter enforces on Avro schemas. The zero value enforces nothing. A config is
+// usually loaded from a JSON file with LoadLintConfig, for example:
+//
+//	{
+//	  "require_record_doc": true,
+//	  "require_field_doc": true,
+//	  "namespace_pattern": "^com\\.example\\.",
+//	  "field_name_case": "camelCase",
+//	  "require_optional_defaults": true,
+//	  "banned_types": ["map", "timestamp-micros"],
+//	  "max_depth": 4,
+//	  "warnings": ["field-doc"]
+//	}
+type LintConfig struct {
+	// Whether records, enums and fixed types must have a doc.
+	RequireRecordDoc bool `json:"require_record_doc,omitempty"`
+
+	// Whether record fields must have a doc.
+	RequireFieldDoc bool `json:"require_field_doc,omitempty"`
+
+	// A regular expression that the namespace of every named type must match.
+	NamespacePattern string `json:"namespace_pattern,omitempty"`
+
+	// The case of field names, one of the FieldNameCase constants.
+	FieldNameCase string `json:"field_name_case,omitempty"`
+
+	// Whether optional fields, whose type is a union with null, must have a default.
+	RequireOptionalDefaults bool `json:"require_optional_defaults,omitempty"`
+
+	// Types that may not be used, given as Avro types such as bytes or map, logical types such as timestamp-micros, or
+	// full names of named types.
+	BannedTypes []string `json:"banned_types,omitempty"`
+
+	// The maximum nesting depth of records, arrays and maps, counting the top-level schema as 1. Zero means no limit.
+	MaxDepth int `json:"max_depth,omitempty"`
+
+	// Rules whose findings are warnings rather than errors.
+	Warnings []string `json:"warnings,omitempty"`
+}
+
+// LintFinding : A violation of a lint rule.
+type LintFinding struct {
+	// The rule, one of the LintRule constants.
+	Rule string `json:"rule"`
+
+	// The severity, one of the LintSeverity constants.
+	Severity string `json:"severity"`
+
+	// The location of the violation in the schema document as a JSONPath expression, such as $.fields[2].type.items.
+	Path string `json:"path"`
+
+	// A description of the violation.
+	Message string `json:"message"`
+}
+
+// String returns the finding as a single line.
+func (finding LintFinding) String() string {
+	return fmt.Sprintf("%s: %s: %s [%s]", finding.Path, finding.Severity, finding.Message, finding.Rule)
+}
+
+// LoadLintConfig reads a LintConfig from a JSON file. Unknown properties are rejected so that misspelt rules are not
+// silently ignored.
+func LoadLintConfig(path string) (*LintConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, core.SDKErrorf(err, "", "avro-lint-config-error", common.GetComponentInfo())
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	config := &LintConfig{}
+	if err := decoder.Decode(config); err != nil {
+		return nil, core.SDKErrorf(err, fmt.Sprintf("invalid lint config %s: %s", path, err.Error()), "avro-lint-config-error", common.GetComponentInfo())
+	}
+	if err := config.check(); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+func (config *LintConfig) check() error {
+	if config.NamespacePattern != "" {
+		if _, err := regexp.Compile(config.NamespacePattern); err != nil {
+			return core.SDKErrorf(err, "invalid namespace_pattern: "+err.Error(), "avro-lint-config-error", common.GetComponentInfo())
+		}
+	}
+	if config.FieldNameCase != "" && fieldNameCasePatterns[config.FieldNameCase] == nil {
+		return core.SDKErrorf(nil, fmt.Sprintf("invalid field_name_case %q", config.FieldNameCase), "avro-lint-config-error", common.GetComponentInfo())
+	}
+	return nil
+}
+
+// Lint checks the schema against the config and returns the findings in document order. An error is returned only if
+// the config itself is invalid.
+func (config *LintConfig) Lint(schema *Schema) ([]LintFinding, error) {
+	if err := config.check(); err != nil {
+		return nil, err
+	}
+	l := &linter{config: config, visited: map[*Schema]bool{}, findings: []LintFinding{}}
+	if config.NamespacePattern != "" {
+		l.namespace = regexp.MustCompile(config.NamespacePattern)
+	}
+	l.fieldName = fieldNameCasePatterns[config.FieldNameCase]
+	l.schema(schema, "$", 1)
+	return l.findings, nil
+}
+
+// Check lints the schema and returns an error listing the error findings, if there are any. It is intended to be
+// called before the schema is registered with CreateSchema or CreateVersion.
+func (config *LintConfig) Check(schema *Schema) error {
+	findings, err := config.Lint(schema)
+	if err != nil {
+		return err
+	}
+	var problems []string
+	for _, finding := range findings {
+		if finding.Severity == LintSeverityError {
+			problems = append(problems, finding.Path+": "+finding.Message)
+		}
+	}
+	if len(problems) == 0 {
+		return nil
+	}
+	return core.SDKErrorf(nil, "schema violates lint rules: "+strings.Join(problems, "; "), "avro-lint-error", common.GetComponentInfo())
+}
+
+// HasLintErrors returns whether any of the findings is an error.
+func HasLintErrors(findings []LintFinding) bool {
+	for _, finding := range findings {
+		if finding.Severity == LintSeverityError {
+			return true
+		}
+	}
+	return false
+}
+
+type linter struct {
+	config    *LintConfig
+	namespace *regexp.Regexp
+	fieldName *regexp.Regexp
+	visited   map[*Schema]bool
+	findings  []LintFinding
+	deep      bool
+}
+
+func (l *linter) report(rule string, path string, format string, args ...interface{}) {
+	severity := LintSeverityError
+	for _, warning := range l.config.Warnings {
+		if warning == rule {
+			severity = LintSeverityWarning
+		}
+	}
+	l.findings = append(l.findings, LintFinding{Rule: rule, Severity: severity, Path: path, Message: fmt.Sprintf(format, args...)})
+}
+
+// schema walks the schema in the order in which it is written by MarshalJSON, so that each named type is checked
+// where it is defined.
+func (l *linter) schema(schema *Schema, path string, depth int) {
+	if schema.IsNamed() {
+		if l.visited[schema] {
+			return
+		}
+		l.visited[schema] = true
+	}
+	l.bannedType(schema, path)
+
+	nested := schema.Type == TypeRecord || schema.Type == TypeError || schema.Type == TypeArray || schema.Type == TypeMap
+	if nested && l.config.MaxDepth > 0 && depth > l.config.MaxDepth && !l.deep {
+		// Only the outermost violation is reported, rather than one for every type nested within it.
+		l.report(LintRuleMaxDepth, path, "nesting depth %d exceeds the maximum of %d", depth, l.config.MaxDepth)
+		l.deep = true
+		defer func() { l.deep = false }()
+	}
+
+	if schema.IsNamed() {
+		if l.config.RequireRecordDoc && schema.Doc == "" {
+			l.report(LintRuleRecordDoc, path, "%s %s has no doc", schema.Type, schema.FullName())
+		}
+		if l.namespace != nil && !l.namespace.MatchString(schema.Namespace) {
+			l.report(LintRuleNamespace, path, "namespace %q of %s does not match %s", schema.Namespace, schema.FullName(), l.config.NamespacePattern)
+		}
+	}
+
+	switch schema.Type {
+	case TypeRecord, TypeError:
+		for i, field := range schema.Fields {
+			l.field(schema, field, fmt.Sprintf("%s.fields[%d]", path, i), depth)
+		}
+	case TypeArray:
+		l.schema(schema.Items, path+".items", depth+1)
+	case TypeMap:
+		l.schema(schema.Values, path+".values", depth+1)
+	case TypeUnion:
+		for i, branch := range schema.Types {
+			l.schema(branch, fmt.Sprintf("%s[%d]", path, i), depth)
+		}
+	}
+}
+
+func (l *linter) field(record *Schema, field *Field, path string, depth int) {
+	if l.config.RequireFieldDoc && field.Doc == "" {
+		l.report(LintRuleFieldDoc, path, "field %s of %s has no doc", field.Name, record.FullName())
+	}
+	if l.fieldName != nil && !l.fieldName.MatchString(field.Name) {
+		l.report(LintRuleFieldNameCase, path, "field name %s is not %s", field.Name, l.config.FieldNameCase)
+	}
+	if l.config.RequireOptionalDefaults && isOptional(field.Type) && !field.HasDefault {
+		l.report(LintRuleOptionalDefault, path, "optional field %s of %s has no default", field.Name, record.FullName())
+	}
+	l.schema(field.Type, path+".type", depth+1)
+}
+
+func (l *linter) bannedType(schema *Schema, path string) {
+	for _, banned := range l.config.BannedTypes {
+		switch {
+		case schema.LogicalType != "" && schema.LogicalType == banned:
+			l.report(LintRuleBannedType, path, "logical type %s is not allowed", banned)
+		case string(schema.Type) == banned:
+			l.report(LintRuleBannedType, path, "type %s is not allowed", banned)
+		case schema.IsNamed() && schema.FullName() == banned:
+			l.report(LintRuleBannedType, path, "type %s is not allowed", banned)
+		}
+	}
+}
+
+// isOptional returns whether a schema is a union with a null branch.
+func isOptional(schema *Schema) bool {
+	if schema.Type != TypeUnion {
+		return false
+	}
+	for _, branch := range schema.Types {
+		if branch.Type == TypeNull {
+			return true
+		}
+	}
+	return false
+}
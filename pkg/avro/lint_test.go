@@ -0,0 +1,100 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package avro
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const lintedSchema = `{"type": "record", "name": "Order", "namespace": "com.example.orders", "doc": "An order", "fields": [
+	{"name": "id", "type": "string", "doc": "The ID"},
+	{"name": "customer_name", "type": "string"},
+	{"name": "note", "type": ["null", "string"], "doc": "A note"},
+	{"name": "lines", "doc": "The lines", "type": {"type": "array", "items": {"type": "record", "name": "Line", "namespace": "legacy", "fields": [
+		{"name": "attributes", "doc": "Attributes", "type": {"type": "map", "values": {"type": "array", "items": "string"}}},
+		{"name": "price", "doc": "Price", "type": {"type": "bytes", "logicalType": "decimal", "precision": 10, "scale": 2}}
+	]}}},
+	{"name": "previous", "doc": "The previous line", "type": ["null", "legacy.Line"], "default": null}
+]}`
+
+func TestLint(t *testing.T) {
+	schema, err := Parse(lintedSchema)
+	assert.Nil(t, err)
+
+	config := &LintConfig{
+		RequireRecordDoc:        true,
+		RequireFieldDoc:         true,
+		NamespacePattern:        `^com\.example(\.|$)`,
+		FieldNameCase:           FieldNameCaseCamel,
+		RequireOptionalDefaults: true,
+		BannedTypes:             []string{"map", "decimal"},
+		MaxDepth:                3,
+		Warnings:                []string{LintRuleFieldDoc},
+	}
+	findings, err := config.Lint(schema)
+	assert.Nil(t, err)
+	assert.Equal(t, []LintFinding{
+		{Rule: LintRuleFieldDoc, Severity: LintSeverityWarning, Path: "$.fields[1]", Message: "field customer_name of com.example.orders.Order has no doc"},
+		{Rule: LintRuleFieldNameCase, Severity: LintSeverityError, Path: "$.fields[1]", Message: "field name customer_name is not camelCase"},
+		{Rule: LintRuleOptionalDefault, Severity: LintSeverityError, Path: "$.fields[2]", Message: "optional field note of com.example.orders.Order has no default"},
+		{Rule: LintRuleRecordDoc, Severity: LintSeverityError, Path: "$.fields[3].type.items", Message: "record legacy.Line has no doc"},
+		{Rule: LintRuleNamespace, Severity: LintSeverityError, Path: "$.fields[3].type.items", Message: `namespace "legacy" of legacy.Line does not match ^com\.example(\.|$)`},
+		{Rule: LintRuleBannedType, Severity: LintSeverityError, Path: "$.fields[3].type.items.fields[0].type", Message: "type map is not allowed"},
+		{Rule: LintRuleMaxDepth, Severity: LintSeverityError, Path: "$.fields[3].type.items.fields[0].type", Message: "nesting depth 4 exceeds the maximum of 3"},
+		{Rule: LintRuleBannedType, Severity: LintSeverityError, Path: "$.fields[3].type.items.fields[1].type", Message: "logical type decimal is not allowed"},
+	}, findings)
+	assert.True(t, HasLintErrors(findings))
+	assert.Equal(t, "$.fields[1]: error: field name customer_name is not camelCase [field-name-case]", findings[1].String())
+
+	err = config.Check(schema)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "schema violates lint rules: $.fields[1]: field name customer_name is not camelCase; ")
+	assert.NotContains(t, err.Error(), "has no doc; $.fields[1]: field name")
+
+	findings, err = (&LintConfig{}).Lint(schema)
+	assert.Nil(t, err)
+	assert.Empty(t, findings)
+	assert.Nil(t, (&LintConfig{FieldNameCase: FieldNameCaseSnake}).Check(schema))
+
+	_, err = (&LintConfig{FieldNameCase: "kebab"}).Lint(schema)
+	assert.NotNil(t, err)
+}
+
+func TestLoadLintConfig(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "lint.json")
+	assert.Nil(t, os.WriteFile(path, []byte(`{"require_record_doc": true, "field_name_case": "snake_case", "max_depth": 2, "warnings": ["record-doc"]}`), 0644))
+	config, err := LoadLintConfig(path)
+	assert.Nil(t, err)
+	assert.Equal(t, &LintConfig{RequireRecordDoc: true, FieldNameCase: FieldNameCaseSnake, MaxDepth: 2, Warnings: []string{LintRuleRecordDoc}}, config)
+
+	assert.Nil(t, os.WriteFile(path, []byte(`{"require_recrd_doc": true}`), 0644))
+	_, err = LoadLintConfig(path)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "require_recrd_doc")
+
+	assert.Nil(t, os.WriteFile(path, []byte(`{"namespace_pattern": "("}`), 0644))
+	_, err = LoadLintConfig(path)
+	assert.NotNil(t, err)
+
+	_, err = LoadLintConfig(filepath.Join(dir, "missing.json"))
+	assert.NotNil(t, err)
+}
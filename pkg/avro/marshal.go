@@ -0,0 +1,143 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package avro
+
+import (
+	"encoding/json"
+
+	common "github.com/IBM/eventstreams-go-sdk/pkg/common"
+	"github.com/IBM/eventstreams-go-sdk/pkg/schemaregistryv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// FromAvroSchema parses the schema of an AvroSchema returned by the GetLatestSchema or GetVersion methods of the
+// SchemaregistryV1 service.
+func FromAvroSchema(avroSchema *schemaregistryv1.AvroSchema) (*Schema, error) {
+	if avroSchema == nil || avroSchema.Schema == nil {
+		return nil, core.SDKErrorf(nil, "the AvroSchema has no schema", "avro-parse-error", common.GetComponentInfo())
+	}
+	return ParseMap(avroSchema.Schema)
+}
+
+// ToMap returns the schema in the map form used by the SchemaregistryV1 service, for example by
+// CreateSchemaOptions.SetSchema. Primitive schemas without a logical type are returned as {"type": "<name>"}.
+func (schema *Schema) ToMap() map[string]interface{} {
+	value := schema.toJSONValue("", map[*Schema]bool{})
+	if object, ok := value.(map[string]interface{}); ok {
+		return object
+	}
+	if name, ok := value.(string); ok {
+		return map[string]interface{}{"type": name}
+	}
+	return map[string]interface{}{"type": value}
+}
+
+// MarshalJSON returns the schema as an Avro schema document.
+func (schema *Schema) MarshalJSON() ([]byte, error) {
+	return json.Marshal(schema.toJSONValue("", map[*Schema]bool{}))
+}
+
+// String returns the schema as an Avro schema document.
+func (schema *Schema) String() string {
+	data, _ := schema.MarshalJSON()
+	return string(data)
+}
+
+// toJSONValue converts the schema to the value that represents it in JSON. Named types are defined the first time
+// they are encountered and referred to by name afterwards.
+func (schema *Schema) toJSONValue(namespace string, defined map[*Schema]bool) interface{} {
+	if schema.Type == TypeUnion {
+		branches := make([]interface{}, 0, len(schema.Types))
+		for _, branch := range schema.Types {
+			branches = append(branches, branch.toJSONValue(namespace, defined))
+		}
+		return branches
+	}
+	if primitiveTypes[schema.Type] && schema.LogicalType == "" {
+		return string(schema.Type)
+	}
+	if schema.IsNamed() {
+		if defined[schema] {
+			if schema.Namespace == namespace {
+				return schema.Name
+			}
+			return schema.FullName()
+		}
+		defined[schema] = true
+	}
+
+	object := map[string]interface{}{"type": string(schema.Type)}
+	if schema.IsNamed() {
+		object["name"] = schema.Name
+		if schema.Namespace != namespace {
+			object["namespace"] = schema.Namespace
+		}
+		if schema.Doc != "" {
+			object["doc"] = schema.Doc
+		}
+		if len(schema.Aliases) > 0 {
+			object["aliases"] = schema.Aliases
+		}
+	}
+
+	switch schema.Type {
+	case TypeRecord, TypeError:
+		fields := make([]interface{}, 0, len(schema.Fields))
+		for _, field := range schema.Fields {
+			fieldObject := map[string]interface{}{
+				"name": field.Name,
+				"type": field.Type.toJSONValue(schema.Namespace, defined),
+			}
+			if field.Doc != "" {
+				fieldObject["doc"] = field.Doc
+			}
+			if field.HasDefault {
+				fieldObject["default"] = field.Default
+			}
+			if len(field.Aliases) > 0 {
+				fieldObject["aliases"] = field.Aliases
+			}
+			if field.Order != "" {
+				fieldObject["order"] = field.Order
+			}
+			fields = append(fields, fieldObject)
+		}
+		object["fields"] = fields
+	case TypeEnum:
+		object["symbols"] = schema.Symbols
+		if schema.EnumDefault != "" {
+			object["default"] = schema.EnumDefault
+		}
+	case TypeFixed:
+		object["size"] = schema.Size
+	case TypeArray:
+		object["items"] = schema.Items.toJSONValue(namespace, defined)
+	case TypeMap:
+		object["values"] = schema.Values.toJSONValue(namespace, defined)
+	}
+
+	if schema.LogicalType != "" {
+		object["logicalType"] = schema.LogicalType
+		if schema.LogicalType == LogicalTypeDecimal {
+			object["precision"] = schema.Precision
+			if schema.Scale != 0 {
+				object["scale"] = schema.Scale
+			}
+		}
+	}
+	return object
+}
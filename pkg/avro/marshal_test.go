@@ -0,0 +1,60 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package avro
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToMap(t *testing.T) {
+	schema, err := Parse(userSchema)
+	assert.Nil(t, err)
+
+	object := schema.ToMap()
+	assert.Equal(t, "record", object["type"])
+	assert.Equal(t, "com.example", object["namespace"])
+	fields := object["fields"].([]interface{})
+	assert.Len(t, fields, 9)
+
+	// Named types nested in the record inherit its namespace, and later uses refer to them by name
+	status := fields[2].(map[string]interface{})["type"].(map[string]interface{})
+	assert.Equal(t, "Status", status["name"])
+	assert.NotContains(t, status, "namespace")
+	assert.Equal(t, []interface{}{"null", "User"}, fields[7].(map[string]interface{})["type"])
+
+	decimal := fields[4].(map[string]interface{})["type"].(map[string]interface{})
+	assert.Equal(t, map[string]interface{}{"type": "bytes", "logicalType": "decimal", "precision": 10, "scale": 2}, decimal)
+
+	assert.Equal(t, map[string]interface{}{"type": "string"}, (&Schema{Type: TypeString}).ToMap())
+}
+
+func TestMarshalJSON(t *testing.T) {
+	schema, err := Parse(userSchema)
+	assert.Nil(t, err)
+
+	data, err := json.Marshal(schema)
+	assert.Nil(t, err)
+	reparsed, err := Parse(string(data))
+	assert.Nil(t, err)
+	assert.Equal(t, schema.String(), reparsed.String())
+
+	assert.Equal(t, `["null","string"]`, schema.Fields[1].Type.String())
+	assert.Equal(t, `"long"`, (&Schema{Type: TypeLong}).String())
+}
@@ -0,0 +1,389 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package avro
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	common "github.com/IBM/eventstreams-go-sdk/pkg/common"
+	"github.com/IBM/eventstreams-go-sdk/pkg/schemaregistryv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// The struct tags read by Reflect.
+const (
+	// TagName sets the Avro name of a field, or excludes the field with "-". It is the same tag that the generated
+	// code uses, so generated types can be reflected back into their schema.
+	TagName = "avro"
+
+	// TagDoc sets the documentation of a field.
+	TagDoc = "avrodoc"
+
+	// TagDefault sets the default value of a field, as JSON.
+	TagDefault = "avrodefault"
+
+	// TagLogicalType sets the logical type of a field, for example "uuid", "timestamp-micros", "date" or
+	// "decimal(10,2)".
+	TagLogicalType = "avrological"
+)
+
+// EnumSymbols is implemented by Go types, usually string types, that are mapped to Avro enums.
+type EnumSymbols interface {
+	AvroEnumSymbols() []string
+}
+
+// Documented is implemented by Go struct types that provide the documentation of their Avro record.
+type Documented interface {
+	AvroDoc() string
+}
+
+var (
+	timeType        = reflect.TypeOf(time.Time{})
+	durationType    = reflect.TypeOf(time.Duration(0))
+	ratType         = reflect.TypeOf(big.Rat{})
+	enumSymbolsType = reflect.TypeOf((*EnumSymbols)(nil)).Elem()
+	documentedType  = reflect.TypeOf((*Documented)(nil)).Elem()
+	decimalRegexp   = regexp.MustCompile(`^decimal\((\d+)(?:,\s*(\d+))?\)$`)
+)
+
+// Reflect derives an Avro record schema from a Go struct, or a pointer to one. Named types are placed in the given
+// namespace, which may be empty.
+//
+// Exported fields are mapped to Avro fields named after the `avro` tag, or after the Go field name when there is no
+// tag. Fields of embedded structs without a tag are promoted into the record. Go types are mapped as follows:
+//
+//   - bool maps to boolean, int8, int16, int32, uint8 and uint16 map to int, and int, int64 and uint32 map to long
+//   - float32 and float64 map to float and double, and string and []byte map to string and bytes
+//   - slices and arrays map to arrays, maps with string keys map to maps, and [N]byte maps to a fixed type
+//   - structs map to records named after the Go type, and types implementing EnumSymbols map to enums
+//   - time.Time maps to timestamp-millis, time.Duration to time-millis and big.Rat or *big.Rat to a decimal, which
+//     must be given a precision with the `avrological` tag
+//   - other pointers map to an optional ["null", T] union with a null default
+func Reflect(value interface{}, namespace string) (*Schema, error) {
+	t := reflect.TypeOf(value)
+	if t == nil {
+		return nil, core.SDKErrorf(nil, "cannot derive an Avro schema from nil", "avro-reflect-error", common.GetComponentInfo())
+	}
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return nil, core.SDKErrorf(nil, fmt.Sprintf("cannot derive an Avro record from %s, a struct is required", t), "avro-reflect-error", common.GetComponentInfo())
+	}
+	r := &reflector{namespace: namespace, named: map[reflect.Type]*Schema{}, names: map[string]reflect.Type{}}
+	return r.schema(t, "")
+}
+
+type reflector struct {
+	namespace string
+	named     map[reflect.Type]*Schema
+	names     map[string]reflect.Type
+}
+
+func (r *reflector) errorf(format string, args ...interface{}) error {
+	return core.SDKErrorf(nil, fmt.Sprintf(format, args...), "avro-reflect-error", common.GetComponentInfo())
+}
+
+// schema maps a Go type to a schema. The logical type comes from the `avrological` tag of the field, if any.
+func (r *reflector) schema(t reflect.Type, logicalType string) (*Schema, error) {
+	switch t {
+	case timeType:
+		if logicalType == "" {
+			logicalType = LogicalTypeTimestampMillis
+		}
+		switch logicalType {
+		case LogicalTypeDate:
+			return &Schema{Type: TypeInt, LogicalType: logicalType}, nil
+		case LogicalTypeTimestampMillis, LogicalTypeTimestampMicros, LogicalTypeLocalTimestampMillis, LogicalTypeLocalTimestampMicros:
+			return &Schema{Type: TypeLong, LogicalType: logicalType}, nil
+		}
+		return nil, r.errorf("logical type %q cannot be used for time.Time", logicalType)
+	case durationType:
+		switch logicalType {
+		case "", LogicalTypeTimeMillis:
+			return &Schema{Type: TypeInt, LogicalType: LogicalTypeTimeMillis}, nil
+		case LogicalTypeTimeMicros:
+			return &Schema{Type: TypeLong, LogicalType: logicalType}, nil
+		}
+		return nil, r.errorf("logical type %q cannot be used for time.Duration", logicalType)
+	case ratType:
+		matches := decimalRegexp.FindStringSubmatch(logicalType)
+		if matches == nil {
+			return nil, r.errorf("big.Rat requires a logical type of the form decimal(precision,scale)")
+		}
+		schema := &Schema{Type: TypeBytes, LogicalType: LogicalTypeDecimal}
+		schema.Precision, _ = strconv.Atoi(matches[1])
+		if matches[2] != "" {
+			schema.Scale, _ = strconv.Atoi(matches[2])
+		}
+		return schema, nil
+	}
+
+	if t.Implements(enumSymbolsType) && t.Kind() != reflect.Ptr {
+		return r.enum(t)
+	}
+
+	var schema *Schema
+	switch t.Kind() {
+	case reflect.Bool:
+		schema = &Schema{Type: TypeBoolean}
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint8, reflect.Uint16:
+		schema = &Schema{Type: TypeInt}
+	case reflect.Int, reflect.Int64, reflect.Uint32:
+		schema = &Schema{Type: TypeLong}
+	case reflect.Float32:
+		schema = &Schema{Type: TypeFloat}
+	case reflect.Float64:
+		schema = &Schema{Type: TypeDouble}
+	case reflect.String:
+		schema = &Schema{Type: TypeString}
+	case reflect.Slice:
+		if t.Elem().Kind() == reflect.Uint8 {
+			schema = &Schema{Type: TypeBytes}
+			break
+		}
+		items, err := r.schema(t.Elem(), "")
+		if err != nil {
+			return nil, err
+		}
+		return &Schema{Type: TypeArray, Items: items}, nil
+	case reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return r.fixed(t)
+		}
+		items, err := r.schema(t.Elem(), "")
+		if err != nil {
+			return nil, err
+		}
+		return &Schema{Type: TypeArray, Items: items}, nil
+	case reflect.Map:
+		if t.Key().Kind() != reflect.String {
+			return nil, r.errorf("map %s must have string keys", t)
+		}
+		values, err := r.schema(t.Elem(), "")
+		if err != nil {
+			return nil, err
+		}
+		return &Schema{Type: TypeMap, Values: values}, nil
+	case reflect.Ptr:
+		if t.Elem() == ratType {
+			// The generated code and the Avro libraries use *big.Rat for required decimals.
+			return r.schema(ratType, logicalType)
+		}
+		elem, err := r.schema(t.Elem(), logicalType)
+		if err != nil {
+			return nil, err
+		}
+		return &Schema{Type: TypeUnion, Types: []*Schema{{Type: TypeNull}, elem}}, nil
+	case reflect.Struct:
+		return r.record(t)
+	default:
+		return nil, r.errorf("Go type %s has no Avro equivalent", t)
+	}
+
+	if logicalType != "" {
+		if logicalType == LogicalTypeDecimal || strings.HasPrefix(logicalType, LogicalTypeDecimal+"(") {
+			matches := decimalRegexp.FindStringSubmatch(logicalType)
+			if matches == nil || schema.Type != TypeBytes {
+				return nil, r.errorf("invalid decimal logical type %q for Go type %s", logicalType, t)
+			}
+			schema.Precision, _ = strconv.Atoi(matches[1])
+			if matches[2] != "" {
+				schema.Scale, _ = strconv.Atoi(matches[2])
+			}
+			logicalType = LogicalTypeDecimal
+		}
+		schema.LogicalType = logicalType
+	}
+	return schema, nil
+}
+
+// register records the Avro name used for a Go type, so that two Go types with the same name in different packages
+// are reported rather than silently merged.
+func (r *reflector) register(t reflect.Type, schema *Schema) error {
+	if t.Name() == "" {
+		return r.errorf("anonymous Go type %s cannot be mapped to a named Avro type", t)
+	}
+	if other, exists := r.names[schema.FullName()]; exists && other != t {
+		return r.errorf("Go types %s and %s both map to the Avro name %s", other, t, schema.FullName())
+	}
+	r.names[schema.FullName()] = t
+	r.named[t] = schema
+	return nil
+}
+
+func (r *reflector) record(t reflect.Type) (*Schema, error) {
+	if schema, ok := r.named[t]; ok {
+		return schema, nil
+	}
+	schema := &Schema{Type: TypeRecord, Name: t.Name(), Namespace: r.namespace}
+	if err := r.register(t, schema); err != nil {
+		return nil, err
+	}
+	if t.Implements(documentedType) {
+		schema.Doc = reflect.Zero(t).Interface().(Documented).AvroDoc()
+	}
+	fields, err := r.fields(t)
+	if err != nil {
+		return nil, err
+	}
+	seen := map[string]bool{}
+	for _, field := range fields {
+		if seen[field.Name] {
+			return nil, r.errorf("record %s has more than one field named %q", schema.Name, field.Name)
+		}
+		seen[field.Name] = true
+	}
+	schema.Fields = fields
+	return schema, nil
+}
+
+func (r *reflector) fields(t reflect.Type) ([]*Field, error) {
+	var fields []*Field
+	for i := 0; i < t.NumField(); i++ {
+		structField := t.Field(i)
+		tag, tagged := structField.Tag.Lookup(TagName)
+		name := strings.Split(tag, ",")[0]
+		if name == "-" {
+			continue
+		}
+		if structField.Anonymous && !tagged && structField.Type.Kind() == reflect.Struct {
+			promoted, err := r.fields(structField.Type)
+			if err != nil {
+				return nil, err
+			}
+			fields = append(fields, promoted...)
+			continue
+		}
+		if structField.PkgPath != "" {
+			continue
+		}
+		if name == "" {
+			name = structField.Name
+		}
+
+		field := &Field{Name: name, Doc: structField.Tag.Get(TagDoc)}
+		var err error
+		field.Type, err = r.schema(structField.Type, structField.Tag.Get(TagLogicalType))
+		if err != nil {
+			return nil, r.errorf("field %s.%s: %s", t.Name(), structField.Name, err.Error())
+		}
+		if defaultValue, ok := structField.Tag.Lookup(TagDefault); ok {
+			decoder := json.NewDecoder(strings.NewReader(defaultValue))
+			decoder.UseNumber()
+			if err := decoder.Decode(&field.Default); err != nil {
+				return nil, r.errorf("field %s.%s has an invalid default %q", t.Name(), structField.Name, defaultValue)
+			}
+			field.HasDefault = true
+		} else if field.Type.Type == TypeUnion {
+			field.HasDefault = true
+		}
+		fields = append(fields, field)
+	}
+	return fields, nil
+}
+
+func (r *reflector) enum(t reflect.Type) (*Schema, error) {
+	if schema, ok := r.named[t]; ok {
+		return schema, nil
+	}
+	schema := &Schema{
+		Type:      TypeEnum,
+		Name:      t.Name(),
+		Namespace: r.namespace,
+		Symbols:   reflect.Zero(t).Interface().(EnumSymbols).AvroEnumSymbols(),
+	}
+	if len(schema.Symbols) == 0 {
+		return nil, r.errorf("enum %s has no symbols", t)
+	}
+	if err := r.register(t, schema); err != nil {
+		return nil, err
+	}
+	return schema, nil
+}
+
+func (r *reflector) fixed(t reflect.Type) (*Schema, error) {
+	if schema, ok := r.named[t]; ok {
+		return schema, nil
+	}
+	schema := &Schema{Type: TypeFixed, Name: t.Name(), Namespace: r.namespace, Size: t.Len()}
+	if err := r.register(t, schema); err != nil {
+		return nil, err
+	}
+	return schema, nil
+}
+
+// CreateSchemaFromStruct : Create a schema from a Go struct
+// Derives an Avro schema from the Go struct with Reflect, validates it and creates it in the registry with
+// CreateSchema, using the schema ID as the artifact ID.
+func CreateSchemaFromStruct(registry *schemaregistryv1.SchemaregistryV1, schemaID string, value interface{}, namespace string) (result *schemaregistryv1.SchemaMetadata, response *core.DetailedResponse, err error) {
+	result, response, err = CreateSchemaFromStructWithContext(context.Background(), registry, schemaID, value, namespace)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// CreateSchemaFromStructWithContext is an alternate form of the CreateSchemaFromStruct method which supports a Context parameter
+func CreateSchemaFromStructWithContext(ctx context.Context, registry *schemaregistryv1.SchemaregistryV1, schemaID string, value interface{}, namespace string) (result *schemaregistryv1.SchemaMetadata, response *core.DetailedResponse, err error) {
+	schema, err := Reflect(value, namespace)
+	if err != nil {
+		return
+	}
+	if err = schema.Validate(); err != nil {
+		return
+	}
+	createSchemaOptions := registry.NewCreateSchemaOptions()
+	createSchemaOptions.SetSchema(schema.ToMap())
+	if schemaID != "" {
+		createSchemaOptions.SetXRegistryArtifactID(schemaID)
+	}
+	result, response, err = registry.CreateSchemaWithContext(ctx, createSchemaOptions)
+	err = core.RepurposeSDKProblem(err, "create-schema-error")
+	return
+}
+
+// CreateVersionFromStruct : Create a schema version from a Go struct
+// Derives an Avro schema from the Go struct with Reflect, validates it and adds it as a new version of an existing
+// schema with CreateVersion.
+func CreateVersionFromStruct(registry *schemaregistryv1.SchemaregistryV1, schemaID string, value interface{}, namespace string) (result *schemaregistryv1.SchemaMetadata, response *core.DetailedResponse, err error) {
+	result, response, err = CreateVersionFromStructWithContext(context.Background(), registry, schemaID, value, namespace)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// CreateVersionFromStructWithContext is an alternate form of the CreateVersionFromStruct method which supports a Context parameter
+func CreateVersionFromStructWithContext(ctx context.Context, registry *schemaregistryv1.SchemaregistryV1, schemaID string, value interface{}, namespace string) (result *schemaregistryv1.SchemaMetadata, response *core.DetailedResponse, err error) {
+	schema, err := Reflect(value, namespace)
+	if err != nil {
+		return
+	}
+	if err = schema.Validate(); err != nil {
+		return
+	}
+	createVersionOptions := registry.NewCreateVersionOptions(schemaID)
+	createVersionOptions.SetSchema(schema.ToMap())
+	result, response, err = registry.CreateVersionWithContext(ctx, createVersionOptions)
+	err = core.RepurposeSDKProblem(err, "create-version-error")
+	return
+}
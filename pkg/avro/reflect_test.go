@@ -0,0 +1,167 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package avro
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/IBM/eventstreams-go-sdk/pkg/schemaregistryv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/stretchr/testify/assert"
+)
+
+type testColor string
+
+func (testColor) AvroEnumSymbols() []string {
+	return []string{"RED", "GREEN"}
+}
+
+type testChecksum [4]byte
+
+type testAudit struct {
+	CreatedBy string `avro:"created_by"`
+}
+
+type testOrder struct {
+	testAudit
+	ID        string           `avro:"id" avrological:"uuid" avrodoc:"The order ID."`
+	Quantity  int32            `avro:"quantity" avrodefault:"1"`
+	Total     *big.Rat         `avro:"total" avrological:"decimal(10,2)"`
+	PlacedAt  time.Time        `avro:"placed_at"`
+	ShipDate  *time.Time       `avro:"ship_date" avrological:"date"`
+	Color     testColor        `avro:"color"`
+	Checksum  testChecksum     `avro:"checksum"`
+	Tags      []string         `avro:"tags"`
+	Labels    map[string]int64 `avro:"labels"`
+	Parent    *testOrder       `avro:"parent"`
+	Notes     *string          `avro:"notes"`
+	Ignored   string           `avro:"-"`
+	internal  string
+	Untagged  bool
+	Snapshots map[string][]byte `avro:"snapshots"`
+}
+
+func (testOrder) AvroDoc() string {
+	return "An order placed by a customer."
+}
+
+func TestReflect(t *testing.T) {
+	schema, err := Reflect(&testOrder{}, "com.example")
+	assert.Nil(t, err)
+	assert.Equal(t, "com.example.testOrder", schema.FullName())
+	assert.Equal(t, "An order placed by a customer.", schema.Doc)
+
+	fields := map[string]*Field{}
+	var names []string
+	for _, field := range schema.Fields {
+		fields[field.Name] = field
+		names = append(names, field.Name)
+	}
+	assert.Equal(t, []string{"created_by", "id", "quantity", "total", "placed_at", "ship_date", "color", "checksum", "tags", "labels", "parent", "notes", "Untagged", "snapshots"}, names)
+
+	assert.Equal(t, LogicalTypeUUID, fields["id"].Type.LogicalType)
+	assert.Equal(t, "The order ID.", fields["id"].Doc)
+	assert.Equal(t, json.Number("1"), fields["quantity"].Default)
+	assert.Equal(t, TypeBytes, fields["total"].Type.Type)
+	assert.Equal(t, 10, fields["total"].Type.Precision)
+	assert.Equal(t, 2, fields["total"].Type.Scale)
+	assert.Equal(t, LogicalTypeTimestampMillis, fields["placed_at"].Type.LogicalType)
+	assert.Equal(t, TypeUnion, fields["ship_date"].Type.Type)
+	assert.Equal(t, LogicalTypeDate, fields["ship_date"].Type.Types[1].LogicalType)
+	assert.True(t, fields["ship_date"].HasDefault)
+	assert.Nil(t, fields["ship_date"].Default)
+	assert.Equal(t, []string{"RED", "GREEN"}, fields["color"].Type.Symbols)
+	assert.Equal(t, 4, fields["checksum"].Type.Size)
+	assert.Equal(t, TypeLong, fields["labels"].Type.Values.Type)
+	assert.Same(t, schema, fields["parent"].Type.Types[1])
+	assert.Equal(t, TypeBytes, fields["snapshots"].Type.Values.Type)
+
+	// The derived schema is a valid Avro schema
+	parsed, err := Parse(schema.String())
+	assert.Nil(t, err)
+	assert.Equal(t, len(schema.Fields), len(parsed.Fields))
+}
+
+func TestReflectErrors(t *testing.T) {
+	type noPrecision struct {
+		Amount big.Rat
+	}
+	type badKey struct {
+		Counts map[int]string
+	}
+	type badDefault struct {
+		Count int32 `avrodefault:"{"`
+	}
+	type badLogicalType struct {
+		When time.Time `avrological:"uuid"`
+	}
+	type duplicate struct {
+		A string `avro:"x"`
+		B string `avro:"x"`
+	}
+	for _, value := range []interface{}{nil, "text", noPrecision{}, badKey{}, badDefault{}, badLogicalType{}, duplicate{}, struct{ C chan int }{}} {
+		_, err := Reflect(value, "")
+		assert.NotNil(t, err, fmt.Sprintf("%T", value))
+	}
+}
+
+func TestCreateSchemaFromStruct(t *testing.T) {
+	var requests []string
+	var bodies []map[string]interface{}
+	testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		var body map[string]interface{}
+		assert.Nil(t, json.NewDecoder(req.Body).Decode(&body))
+		requests = append(requests, req.Method+" "+req.URL.EscapedPath()+" "+req.Header.Get("X-Registry-ArtifactId"))
+		bodies = append(bodies, body)
+		res.Header().Set("Content-type", "application/json")
+		res.WriteHeader(200)
+		fmt.Fprintf(res, "%s", `{"id": "orders", "version": 1, "type": "AVRO", "globalId": 7, "createdOn": 1, "modifiedOn": 1}`)
+	}))
+	defer testServer.Close()
+
+	registry, err := schemaregistryv1.NewSchemaregistryV1(&schemaregistryv1.SchemaregistryV1Options{
+		URL:           testServer.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	assert.Nil(t, err)
+
+	result, response, err := CreateSchemaFromStruct(registry, "orders", testAudit{}, "com.example")
+	assert.Nil(t, err)
+	assert.Equal(t, 200, response.StatusCode)
+	assert.Equal(t, "orders", *result.ID)
+
+	result, _, err = CreateVersionFromStruct(registry, "orders", testAudit{}, "com.example")
+	assert.Nil(t, err)
+	assert.Equal(t, int64(7), *result.GlobalID)
+
+	assert.Equal(t, []string{"POST /artifacts orders", "POST /artifacts/orders/versions "}, requests)
+	for _, body := range bodies {
+		schema := body["schema"].(map[string]interface{})
+		assert.Equal(t, "testAudit", schema["name"])
+		assert.Equal(t, "com.example", schema["namespace"])
+	}
+
+	_, _, err = CreateSchemaFromStruct(registry, "orders", 42, "")
+	assert.NotNil(t, err)
+	assert.Len(t, requests, 2)
+}
@@ -0,0 +1,253 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package avro
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"sort"
+	"strconv"
+	"sync"
+
+	common "github.com/IBM/eventstreams-go-sdk/pkg/common"
+	"github.com/IBM/eventstreams-go-sdk/pkg/schemaregistryv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// The response header in which the schema registry returns the global ID of a schema version.
+const registryGlobalIDHeader = "X-Registry-GlobalId"
+
+// SchemaRegistrar : Registers schemas without creating duplicate versions.
+//
+// Schemas are compared by content fingerprint: the SHA-256 digest of the schema document as normalized by the typed
+// model. Formatting, property order and equivalent spellings of names and primitive types are ignored, but any change
+// to fields, types, documentation, defaults or logical types is a new version. The registrar remembers the
+// fingerprints of the versions it has seen, so repeated registrations of the same schema make no requests. A
+// registrar is safe for concurrent use, but does not serialize requests: concurrent registrations of the same new
+// schema may each create a version.
+//
+// Disabled versions are never returned. The registry does not return the content of disabled versions, and when a
+// lifecycle is set with SetLifecycle, the versions that it records as DISABLED are skipped too, including cached ones.
+type SchemaRegistrar struct {
+	registry *schemaregistryv1.SchemaregistryV1
+
+	// lifecycle records the states of versions, if set.
+	lifecycle *schemaregistryv1.SchemaLifecycle
+
+	mutex sync.Mutex
+
+	// index maps schema IDs to the metadata of their versions, by content fingerprint.
+	index map[string]map[string]*schemaregistryv1.SchemaMetadata
+}
+
+// NewSchemaRegistrar : Instantiate SchemaRegistrar
+func NewSchemaRegistrar(registry *schemaregistryv1.SchemaregistryV1) *SchemaRegistrar {
+	return &SchemaRegistrar{
+		registry: registry,
+		index:    map[string]map[string]*schemaregistryv1.SchemaMetadata{},
+	}
+}
+
+// SetLifecycle sets the lifecycle whose recorded version states are checked before a version is returned, so that
+// versions disabled with it are skipped.
+func (registrar *SchemaRegistrar) SetLifecycle(lifecycle *schemaregistryv1.SchemaLifecycle) *SchemaRegistrar {
+	registrar.mutex.Lock()
+	defer registrar.mutex.Unlock()
+	registrar.lifecycle = lifecycle
+	return registrar
+}
+
+// RegisterSchema registers a schema with a registrar that is used only once, so nothing is cached between calls.
+func RegisterSchema(ctx context.Context, registry *schemaregistryv1.SchemaregistryV1, id string, schema *Schema) (*schemaregistryv1.SchemaMetadata, error) {
+	return NewSchemaRegistrar(registry).RegisterSchema(ctx, id, schema)
+}
+
+// ContentFingerprint returns the hexadecimal SHA-256 digest of the normalized schema document, which SchemaRegistrar
+// uses to decide whether two schemas are the same.
+func ContentFingerprint(schema *Schema) string {
+	digest := sha256.Sum256([]byte(schema.String()))
+	return hex.EncodeToString(digest[:])
+}
+
+// RegisterSchema makes sure that the schema is a version of the schema with the given ID and returns the metadata of
+// that version. The schema is created with CreateSchema if there is no schema with the ID, and a version is created
+// with CreateVersion only if no existing version has the same content.
+//
+// When an existing version matches, the metadata contains the ID, version and type of the schema, and the global ID
+// if the registry returns it; the creation and modification times are not available for existing versions and are
+// nil.
+func (registrar *SchemaRegistrar) RegisterSchema(ctx context.Context, id string, schema *Schema) (*schemaregistryv1.SchemaMetadata, error) {
+	if err := schema.Validate(); err != nil {
+		return nil, err
+	}
+	fingerprint := ContentFingerprint(schema)
+
+	// The mutex is not held during requests, so concurrent registrations of the same new schema may each create a
+	// version.
+	if metadata := registrar.cached(id, fingerprint); metadata != nil {
+		disabled, err := registrar.disabled(ctx, id, *metadata.Version)
+		if err != nil {
+			return nil, err
+		}
+		if !disabled {
+			return metadata, nil
+		}
+		registrar.forget(id, fingerprint)
+	}
+
+	versions, response, err := registrar.registry.ListVersionsWithContext(ctx, registrar.registry.NewListVersionsOptions(id))
+	if err != nil {
+		if response == nil || response.StatusCode != http.StatusNotFound {
+			return nil, core.SDKErrorf(err, "", "list-versions-error", common.GetComponentInfo())
+		}
+		metadata, response, err := registrar.registry.CreateSchemaWithContext(ctx, registrar.registry.NewCreateSchemaOptions().
+			SetXRegistryArtifactID(id).
+			SetSchema(schema.ToMap()))
+		if err == nil {
+			registrar.remember(id, fingerprint, metadata)
+			return metadata, nil
+		}
+		if response == nil || response.StatusCode != http.StatusConflict {
+			return nil, core.SDKErrorf(err, "", "create-schema-error", common.GetComponentInfo())
+		}
+		// Another client created the schema first, so compare with its versions instead.
+		versions, _, err = registrar.registry.ListVersionsWithContext(ctx, registrar.registry.NewListVersionsOptions(id))
+		if err != nil {
+			return nil, core.SDKErrorf(err, "", "list-versions-error", common.GetComponentInfo())
+		}
+	}
+
+	// Newer versions are the most likely to match, so compare with them first.
+	sort.Slice(versions, func(i, j int) bool { return versions[i] > versions[j] })
+	for _, version := range versions {
+		if registrar.indexed(id, version) {
+			continue
+		}
+		disabled, err := registrar.disabled(ctx, id, version)
+		if err != nil {
+			return nil, err
+		}
+		if disabled {
+			continue
+		}
+		avroSchema, response, err := registrar.registry.GetVersionWithContext(ctx, registrar.registry.NewGetVersionOptions(id, version))
+		if err != nil {
+			if response != nil && response.StatusCode == http.StatusNotFound {
+				// The version was deleted or disabled after it was listed.
+				continue
+			}
+			return nil, core.SDKErrorf(err, "", "get-version-error", common.GetComponentInfo())
+		}
+		existing, err := FromAvroSchema(avroSchema)
+		if err != nil {
+			// A version that cannot be parsed cannot match either.
+			continue
+		}
+		metadata := existingVersionMetadata(id, version, response)
+		existingFingerprint := ContentFingerprint(existing)
+		registrar.remember(id, existingFingerprint, metadata)
+		if existingFingerprint == fingerprint {
+			return metadata, nil
+		}
+	}
+
+	createVersionOptions := registrar.registry.NewCreateVersionOptions(id)
+	createVersionOptions.SetSchema(schema.ToMap())
+	metadata, _, err := registrar.registry.CreateVersionWithContext(ctx, createVersionOptions)
+	if err != nil {
+		return nil, core.SDKErrorf(err, "", "create-version-error", common.GetComponentInfo())
+	}
+	registrar.remember(id, fingerprint, metadata)
+	return metadata, nil
+}
+
+// Forget removes the cached fingerprints of a schema, for example after its versions have been deleted.
+func (registrar *SchemaRegistrar) Forget(id string) {
+	registrar.mutex.Lock()
+	defer registrar.mutex.Unlock()
+	delete(registrar.index, id)
+}
+
+// cached returns the metadata of the version with a fingerprint, or nil if it is not cached.
+func (registrar *SchemaRegistrar) cached(id string, fingerprint string) *schemaregistryv1.SchemaMetadata {
+	registrar.mutex.Lock()
+	defer registrar.mutex.Unlock()
+	return registrar.index[id][fingerprint]
+}
+
+// remember caches the metadata of a version. Metadata without a version number is not cached, because a cached
+// version is checked against the lifecycle by its number.
+func (registrar *SchemaRegistrar) remember(id string, fingerprint string, metadata *schemaregistryv1.SchemaMetadata) {
+	if metadata == nil || metadata.Version == nil {
+		return
+	}
+	registrar.mutex.Lock()
+	defer registrar.mutex.Unlock()
+	if registrar.index[id] == nil {
+		registrar.index[id] = map[string]*schemaregistryv1.SchemaMetadata{}
+	}
+	registrar.index[id][fingerprint] = metadata
+}
+
+func (registrar *SchemaRegistrar) forget(id string, fingerprint string) {
+	registrar.mutex.Lock()
+	defer registrar.mutex.Unlock()
+	delete(registrar.index[id], fingerprint)
+}
+
+// disabled returns whether the lifecycle, if any, records a version as DISABLED or DELETED.
+func (registrar *SchemaRegistrar) disabled(ctx context.Context, id string, version int64) (bool, error) {
+	registrar.mutex.Lock()
+	lifecycle := registrar.lifecycle
+	registrar.mutex.Unlock()
+	if lifecycle == nil {
+		return false, nil
+	}
+	record, err := lifecycle.GetVersionLifecycleStateWithContext(ctx, registrar.registry.NewGetVersionLifecycleStateOptions(id, version))
+	if err != nil {
+		return false, core.SDKErrorf(err, "", "get-version-state-error", common.GetComponentInfo())
+	}
+	return record.State == schemaregistryv1.SchemaStateDisabled || record.State == schemaregistryv1.SchemaStateDeleted, nil
+}
+
+func (registrar *SchemaRegistrar) indexed(id string, version int64) bool {
+	registrar.mutex.Lock()
+	defer registrar.mutex.Unlock()
+	for _, metadata := range registrar.index[id] {
+		if metadata.Version != nil && *metadata.Version == version {
+			return true
+		}
+	}
+	return false
+}
+
+// existingVersionMetadata builds the metadata of a version from what the registry returns with GetVersion.
+func existingVersionMetadata(id string, version int64, response *core.DetailedResponse) *schemaregistryv1.SchemaMetadata {
+	metadata := &schemaregistryv1.SchemaMetadata{
+		ID:      core.StringPtr(id),
+		Version: core.Int64Ptr(version),
+		Type:    core.StringPtr("AVRO"),
+	}
+	if response != nil {
+		if globalID, err := strconv.ParseInt(response.Headers.Get(registryGlobalIDHeader), 10, 64); err == nil {
+			metadata.GlobalID = core.Int64Ptr(globalID)
+		}
+	}
+	return metadata
+}
@@ -0,0 +1,249 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package avro
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/IBM/eventstreams-go-sdk/pkg/schemaregistryv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/stretchr/testify/assert"
+)
+
+// newVersionedRegistry starts a registry that keeps the versions of each schema in memory and records the requests
+// it receives.
+func newVersionedRegistry(t *testing.T, requests *[]string) (*schemaregistryv1.SchemaregistryV1, *httptest.Server) {
+	versions := map[string][]interface{}{}
+	testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		*requests = append(*requests, req.Method+" "+req.URL.EscapedPath())
+		res.Header().Set("Content-type", "application/json")
+		parts := strings.Split(strings.TrimPrefix(req.URL.EscapedPath(), "/artifacts"), "/")
+		id := req.Header.Get("X-Registry-ArtifactId")
+		if len(parts) > 1 {
+			id = parts[1]
+		}
+		switch {
+		case req.Method == http.MethodPost:
+			var body map[string]interface{}
+			assert.Nil(t, json.NewDecoder(req.Body).Decode(&body))
+			if len(parts) == 1 && versions[id] != nil {
+				res.WriteHeader(409)
+				fmt.Fprintf(res, `{"error_code": 409, "message": "artifact exists"}`)
+				return
+			}
+			versions[id] = append(versions[id], body["schema"])
+			res.WriteHeader(200)
+			fmt.Fprintf(res, `{"id": %q, "version": %d, "type": "AVRO", "globalId": %d, "createdOn": 1, "modifiedOn": 1}`, id, len(versions[id]), 100+len(versions[id]))
+		case versions[id] == nil:
+			res.WriteHeader(404)
+			fmt.Fprintf(res, `{"error_code": 404, "message": "artifact not found"}`)
+		case len(parts) == 3:
+			list := []int{}
+			for i := range versions[id] {
+				list = append(list, i+1)
+			}
+			res.WriteHeader(200)
+			assert.Nil(t, json.NewEncoder(res).Encode(list))
+		default:
+			version, _ := strconv.Atoi(parts[3])
+			res.Header().Set("X-Registry-GlobalId", strconv.Itoa(100+version))
+			res.WriteHeader(200)
+			assert.Nil(t, json.NewEncoder(res).Encode(map[string]interface{}{"schema": versions[id][version-1]}))
+		}
+	}))
+	registry, err := schemaregistryv1.NewSchemaregistryV1(&schemaregistryv1.SchemaregistryV1Options{
+		URL:           testServer.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	assert.Nil(t, err)
+	return registry, testServer
+}
+
+func TestRegisterSchema(t *testing.T) {
+	var requests []string
+	registry, testServer := newVersionedRegistry(t, &requests)
+	defer testServer.Close()
+
+	first, err := Parse(`{"type": "record", "name": "Order", "fields": [{"name": "id", "type": "string"}]}`)
+	assert.Nil(t, err)
+	// The same schema with different formatting, property order and spelling of the field type.
+	reformatted, err := Parse(`{"fields": [{"type": {"type": "string"}, "name": "id"}], "name": "Order", "type": "record"}`)
+	assert.Nil(t, err)
+	second, err := Parse(`{"type": "record", "name": "Order", "fields": [{"name": "id", "type": "string", "doc": "The order ID"}]}`)
+	assert.Nil(t, err)
+
+	metadata, err := RegisterSchema(context.Background(), registry, "orders", first)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), *metadata.Version)
+	assert.Equal(t, []string{"GET /artifacts/orders/versions", "POST /artifacts"}, requests)
+
+	requests = nil
+	metadata, err = RegisterSchema(context.Background(), registry, "orders", reformatted)
+	assert.Nil(t, err)
+	assert.Equal(t, "orders", *metadata.ID)
+	assert.Equal(t, int64(1), *metadata.Version)
+	assert.Equal(t, int64(101), *metadata.GlobalID)
+	assert.Equal(t, "AVRO", *metadata.Type)
+	assert.Nil(t, metadata.CreatedOn)
+	assert.Equal(t, []string{"GET /artifacts/orders/versions", "GET /artifacts/orders/versions/1"}, requests)
+
+	requests = nil
+	metadata, err = RegisterSchema(context.Background(), registry, "orders", second)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), *metadata.Version)
+	assert.Equal(t, []string{"GET /artifacts/orders/versions", "GET /artifacts/orders/versions/1", "POST /artifacts/orders/versions"}, requests)
+
+	// The newest version is compared first.
+	requests = nil
+	metadata, err = RegisterSchema(context.Background(), registry, "orders", second)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), *metadata.Version)
+	assert.Equal(t, []string{"GET /artifacts/orders/versions", "GET /artifacts/orders/versions/2"}, requests)
+
+	requests = nil
+	_, err = RegisterSchema(context.Background(), registry, "orders", &Schema{Type: TypeRecord, Name: "1nvalid"})
+	assert.NotNil(t, err)
+	assert.Empty(t, requests)
+}
+
+func TestSchemaRegistrarCache(t *testing.T) {
+	var requests []string
+	registry, testServer := newVersionedRegistry(t, &requests)
+	defer testServer.Close()
+
+	first, err := Parse(`{"type": "record", "name": "Order", "fields": [{"name": "id", "type": "string"}]}`)
+	assert.Nil(t, err)
+	second, err := Parse(`{"type": "record", "name": "Order", "fields": [{"name": "id", "type": "string"}, {"name": "total", "type": "double", "default": 0}]}`)
+	assert.Nil(t, err)
+
+	registrar := NewSchemaRegistrar(registry)
+	_, err = registrar.RegisterSchema(context.Background(), "orders", first)
+	assert.Nil(t, err)
+	_, err = registrar.RegisterSchema(context.Background(), "orders", second)
+	assert.Nil(t, err)
+	assert.Len(t, requests, 4)
+
+	// Both versions are cached, so registering them again makes no requests.
+	requests = nil
+	metadata, err := registrar.RegisterSchema(context.Background(), "orders", first)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), *metadata.Version)
+	metadata, err = registrar.RegisterSchema(context.Background(), "orders", second)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), *metadata.Version)
+	assert.Empty(t, requests)
+
+	// A new registrar does not refetch versions that it has already compared.
+	registrar = NewSchemaRegistrar(registry)
+	third, err := Parse(`{"type": "record", "name": "Order", "fields": [{"name": "id", "type": "string"}, {"name": "total", "type": "double", "default": 1}]}`)
+	assert.Nil(t, err)
+	_, err = registrar.RegisterSchema(context.Background(), "orders", third)
+	assert.Nil(t, err)
+	requests = nil
+	metadata, err = registrar.RegisterSchema(context.Background(), "orders", first)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), *metadata.Version)
+	assert.Empty(t, requests)
+
+	registrar.Forget("orders")
+	_, err = registrar.RegisterSchema(context.Background(), "orders", first)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"GET /artifacts/orders/versions", "GET /artifacts/orders/versions/3", "GET /artifacts/orders/versions/2", "GET /artifacts/orders/versions/1"}, requests)
+}
+
+func TestSchemaRegistrarDisabledVersions(t *testing.T) {
+	var requests []string
+	registry, testServer := newVersionedRegistry(t, &requests)
+	defer testServer.Close()
+
+	schema, err := Parse(`{"type": "record", "name": "Order", "fields": [{"name": "id", "type": "string"}]}`)
+	assert.Nil(t, err)
+	store := schemaregistryv1.NewMemoryLifecycleStore()
+	registrar := NewSchemaRegistrar(registry).SetLifecycle(registry.NewSchemaLifecycle(&schemaregistryv1.SchemaLifecycleOptions{Store: store}))
+	metadata, err := registrar.RegisterSchema(context.Background(), "orders", schema)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), *metadata.Version)
+
+	// The cached version is disabled, so a new version is created.
+	assert.Nil(t, store.Put(schemaregistryv1.LifecycleKey{SchemaID: "orders", Version: 1}, schemaregistryv1.LifecycleRecord{State: schemaregistryv1.SchemaStateDisabled}))
+	requests = nil
+	metadata, err = registrar.RegisterSchema(context.Background(), "orders", schema)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), *metadata.Version)
+	assert.Equal(t, []string{"GET /artifacts/orders/versions", "POST /artifacts/orders/versions"}, requests)
+
+	requests = nil
+	metadata, err = registrar.RegisterSchema(context.Background(), "orders", schema)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), *metadata.Version)
+	assert.Empty(t, requests)
+}
+
+func TestContentFingerprint(t *testing.T) {
+	first, err := Parse(`{"type": "record", "name": "Order", "namespace": "com.example", "fields": [{"name": "id", "type": "string"}]}`)
+	assert.Nil(t, err)
+	second, err := Parse(`{"name": "com.example.Order", "type": "record", "fields": [{"name": "id", "type": {"type": "string"}}]}`)
+	assert.Nil(t, err)
+	documented, err := Parse(`{"type": "record", "name": "Order", "namespace": "com.example", "doc": "An order", "fields": [{"name": "id", "type": "string"}]}`)
+	assert.Nil(t, err)
+
+	assert.Equal(t, ContentFingerprint(first), ContentFingerprint(second))
+	assert.NotEqual(t, ContentFingerprint(first), ContentFingerprint(documented))
+	assert.Len(t, ContentFingerprint(first), 64)
+}
+
+func TestSchemaRegistrarMetadataWithoutVersion(t *testing.T) {
+	var requests []string
+	testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		requests = append(requests, req.Method+" "+req.URL.EscapedPath())
+		res.Header().Set("Content-type", "application/json")
+		if req.Method == http.MethodPost {
+			res.WriteHeader(200)
+			fmt.Fprintf(res, `{"id": "orders", "type": "AVRO"}`)
+			return
+		}
+		res.WriteHeader(404)
+		fmt.Fprintf(res, `{"error_code": 404, "message": "artifact not found"}`)
+	}))
+	defer testServer.Close()
+	registry, err := schemaregistryv1.NewSchemaregistryV1(&schemaregistryv1.SchemaregistryV1Options{
+		URL:           testServer.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	assert.Nil(t, err)
+
+	schema, err := Parse(`{"type": "record", "name": "Order", "fields": [{"name": "id", "type": "string"}]}`)
+	assert.Nil(t, err)
+	registrar := NewSchemaRegistrar(registry).SetLifecycle(registry.NewSchemaLifecycle(&schemaregistryv1.SchemaLifecycleOptions{Store: schemaregistryv1.NewMemoryLifecycleStore()}))
+	metadata, err := registrar.RegisterSchema(context.Background(), "orders", schema)
+	assert.Nil(t, err)
+	assert.Nil(t, metadata.Version)
+
+	// Metadata without a version is not cached, so it is registered again.
+	requests = nil
+	metadata, err = registrar.RegisterSchema(context.Background(), "orders", schema)
+	assert.Nil(t, err)
+	assert.Nil(t, metadata.Version)
+	assert.Equal(t, []string{"GET /artifacts/orders/versions", "POST /artifacts"}, requests)
+}
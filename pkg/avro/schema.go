@@ -0,0 +1,501 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package avro : Avro schema support for the IBM Event Streams schema registry
+//
+// The package parses Avro schemas into a typed model that can be validated, fingerprinted and converted to and from
+// the map form used by the SchemaregistryV1 service. It also generates Go types from schemas and derives schemas from
+// Go types, so that applications do not have to hand-maintain one to match the other.
+package avro
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	common "github.com/IBM/eventstreams-go-sdk/pkg/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Type : The type of an Avro schema.
+type Type string
+
+// Constants associated with the Type.
+// The primitive and complex Avro types. Unions have no type name in Avro, so TypeUnion is used for them.
+const (
+	TypeNull    Type = "null"
+	TypeBoolean Type = "boolean"
+	TypeInt     Type = "int"
+	TypeLong    Type = "long"
+	TypeFloat   Type = "float"
+	TypeDouble  Type = "double"
+	TypeBytes   Type = "bytes"
+	TypeString  Type = "string"
+	TypeRecord  Type = "record"
+	TypeError   Type = "error"
+	TypeEnum    Type = "enum"
+	TypeArray   Type = "array"
+	TypeMap     Type = "map"
+	TypeFixed   Type = "fixed"
+	TypeUnion   Type = "union"
+)
+
+// Constants associated with the Schema.LogicalType property.
+// The logical types defined by the Avro specification.
+const (
+	LogicalTypeDecimal              = "decimal"
+	LogicalTypeUUID                 = "uuid"
+	LogicalTypeDate                 = "date"
+	LogicalTypeTimeMillis           = "time-millis"
+	LogicalTypeTimeMicros           = "time-micros"
+	LogicalTypeTimestampMillis      = "timestamp-millis"
+	LogicalTypeTimestampMicros      = "timestamp-micros"
+	LogicalTypeLocalTimestampMillis = "local-timestamp-millis"
+	LogicalTypeLocalTimestampMicros = "local-timestamp-micros"
+	LogicalTypeDuration             = "duration"
+)
+
+var primitiveTypes = map[Type]bool{
+	TypeNull:    true,
+	TypeBoolean: true,
+	TypeInt:     true,
+	TypeLong:    true,
+	TypeFloat:   true,
+	TypeDouble:  true,
+	TypeBytes:   true,
+	TypeString:  true,
+}
+
+var nameRegexp = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// Schema : An Avro schema.
+// Named types (records, enums and fixed) that are referenced more than once share the same *Schema, so a recursive
+// record refers back to itself.
+type Schema struct {
+	// The type of the schema.
+	Type Type
+
+	// The name of a record, enum or fixed schema, without its namespace.
+	Name string
+
+	// The namespace of a record, enum or fixed schema.
+	Namespace string
+
+	// The documentation of a record or enum schema.
+	Doc string
+
+	// The alternate names of a record, enum or fixed schema.
+	Aliases []string
+
+	// The fields of a record schema.
+	Fields []*Field
+
+	// The symbols of an enum schema.
+	Symbols []string
+
+	// The default symbol of an enum schema.
+	EnumDefault string
+
+	// The schema of the items of an array schema.
+	Items *Schema
+
+	// The schema of the values of a map schema.
+	Values *Schema
+
+	// The branches of a union schema.
+	Types []*Schema
+
+	// The size in bytes of a fixed schema.
+	Size int
+
+	// The logical type annotating the schema.
+	LogicalType string
+
+	// The precision of a decimal logical type.
+	Precision int
+
+	// The scale of a decimal logical type.
+	Scale int
+}
+
+// Field : A field of an Avro record schema.
+type Field struct {
+	// The name of the field.
+	Name string
+
+	// The documentation of the field.
+	Doc string
+
+	// The schema of the field.
+	Type *Schema
+
+	// The default value of the field. Only meaningful when HasDefault is true, since null is a valid default.
+	Default interface{}
+
+	// Whether the field has a default value.
+	HasDefault bool
+
+	// The alternate names of the field.
+	Aliases []string
+
+	// The sort order of the field.
+	Order string
+}
+
+// FullName returns the namespace-qualified name of a named schema.
+func (schema *Schema) FullName() string {
+	if schema.Namespace == "" {
+		return schema.Name
+	}
+	return schema.Namespace + "." + schema.Name
+}
+
+// IsNamed returns true for record, error, enum and fixed schemas.
+func (schema *Schema) IsNamed() bool {
+	switch schema.Type {
+	case TypeRecord, TypeError, TypeEnum, TypeFixed:
+		return true
+	}
+	return false
+}
+
+// Parse parses an Avro schema document.
+func Parse(data string) (*Schema, error) {
+	schemas, err := ParseMultiple(data)
+	if err != nil {
+		return nil, err
+	}
+	return schemas[0], nil
+}
+
+// ParseMultiple parses several Avro schema documents in order. Named types defined by a document can be referenced by
+// the documents that follow it, which allows schemas split across several `.avsc` files to be parsed together.
+func ParseMultiple(data ...string) ([]*Schema, error) {
+	p := &parser{names: map[string]*Schema{}}
+	schemas := make([]*Schema, 0, len(data))
+	for _, document := range data {
+		var raw interface{}
+		decoder := json.NewDecoder(strings.NewReader(document))
+		decoder.UseNumber()
+		if err := decoder.Decode(&raw); err != nil {
+			return nil, core.SDKErrorf(err, fmt.Sprintf("invalid Avro schema JSON: %s", err.Error()), "avro-parse-error", common.GetComponentInfo())
+		}
+		schema, err := p.parse(raw, "")
+		if err != nil {
+			return nil, err
+		}
+		schemas = append(schemas, schema)
+	}
+	return schemas, nil
+}
+
+// ParseMap parses an Avro schema held in the map form used by the SchemaregistryV1 service, for example the Schema
+// property of an AvroSchema.
+func ParseMap(schema map[string]interface{}) (*Schema, error) {
+	data, err := json.Marshal(schema)
+	if err != nil {
+		return nil, core.SDKErrorf(err, "", "avro-marshal-error", common.GetComponentInfo())
+	}
+	return Parse(string(data))
+}
+
+type parser struct {
+	names map[string]*Schema
+}
+
+func (p *parser) errorf(format string, args ...interface{}) error {
+	return core.SDKErrorf(nil, fmt.Sprintf("invalid Avro schema: "+format, args...), "avro-parse-error", common.GetComponentInfo())
+}
+
+func (p *parser) parse(raw interface{}, namespace string) (*Schema, error) {
+	switch value := raw.(type) {
+	case string:
+		return p.parseReference(value, namespace)
+	case []interface{}:
+		return p.parseUnion(value, namespace)
+	case map[string]interface{}:
+		return p.parseObject(value, namespace)
+	}
+	return nil, p.errorf("unexpected JSON value %v", raw)
+}
+
+func (p *parser) parseReference(name string, namespace string) (*Schema, error) {
+	if primitiveTypes[Type(name)] {
+		return &Schema{Type: Type(name)}, nil
+	}
+	if schema, ok := p.names[qualify(name, namespace)]; ok {
+		return schema, nil
+	}
+	if schema, ok := p.names[name]; ok {
+		return schema, nil
+	}
+	return nil, p.errorf("unknown type %q", name)
+}
+
+func (p *parser) parseUnion(branches []interface{}, namespace string) (*Schema, error) {
+	if len(branches) == 0 {
+		return nil, p.errorf("union has no branches")
+	}
+	union := &Schema{Type: TypeUnion}
+	seen := map[string]bool{}
+	for _, branch := range branches {
+		schema, err := p.parse(branch, namespace)
+		if err != nil {
+			return nil, err
+		}
+		if schema.Type == TypeUnion {
+			return nil, p.errorf("union contains a nested union")
+		}
+		key := string(schema.Type)
+		if schema.IsNamed() {
+			key = schema.FullName()
+		}
+		if seen[key] {
+			return nil, p.errorf("union contains %q more than once", key)
+		}
+		seen[key] = true
+		union.Types = append(union.Types, schema)
+	}
+	return union, nil
+}
+
+func (p *parser) parseObject(object map[string]interface{}, namespace string) (*Schema, error) {
+	typeValue, ok := object["type"]
+	if !ok {
+		return nil, p.errorf("schema object has no type")
+	}
+	typeName, ok := typeValue.(string)
+	if !ok {
+		// A schema such as {"type": {"type": "array", ...}} wraps another schema.
+		return p.parse(typeValue, namespace)
+	}
+
+	var schema *Schema
+	var err error
+	switch Type(typeName) {
+	case TypeRecord, TypeError:
+		schema, err = p.parseRecord(object, Type(typeName), namespace)
+	case TypeEnum:
+		schema, err = p.parseEnum(object, namespace)
+	case TypeFixed:
+		schema, err = p.parseFixed(object, namespace)
+	case TypeArray:
+		schema = &Schema{Type: TypeArray}
+		schema.Items, err = p.parseChild(object, "items", namespace)
+	case TypeMap:
+		schema = &Schema{Type: TypeMap}
+		schema.Values, err = p.parseChild(object, "values", namespace)
+	default:
+		if !primitiveTypes[Type(typeName)] {
+			// {"type": "com.example.Name"} refers to a named type.
+			return p.parseReference(typeName, namespace)
+		}
+		schema = &Schema{Type: Type(typeName)}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if logicalType, ok := object["logicalType"].(string); ok {
+		schema.LogicalType = logicalType
+		if logicalType == LogicalTypeDecimal {
+			if schema.Precision, err = intProperty(object, "precision"); err != nil {
+				return nil, p.errorf("decimal %s", err.Error())
+			}
+			if _, ok := object["scale"]; ok {
+				if schema.Scale, err = intProperty(object, "scale"); err != nil {
+					return nil, p.errorf("decimal %s", err.Error())
+				}
+			}
+		}
+	}
+	return schema, nil
+}
+
+func (p *parser) parseChild(object map[string]interface{}, property string, namespace string) (*Schema, error) {
+	child, ok := object[property]
+	if !ok {
+		return nil, p.errorf("%s schema has no %s", object["type"], property)
+	}
+	return p.parse(child, namespace)
+}
+
+// parseName reads the name and namespace of a named type and registers it, so that it can be referenced from within
+// its own definition.
+func (p *parser) parseName(object map[string]interface{}, schema *Schema, namespace string) (err error) {
+	name, _ := object["name"].(string)
+	if name == "" {
+		return p.errorf("%s schema has no name", schema.Type)
+	}
+	if explicit, ok := object["namespace"].(string); ok {
+		namespace = explicit
+	}
+	if index := strings.LastIndex(name, "."); index >= 0 {
+		namespace = name[:index]
+		name = name[index+1:]
+	}
+	if !nameRegexp.MatchString(name) {
+		return p.errorf("invalid name %q", name)
+	}
+	if namespace != "" {
+		for _, part := range strings.Split(namespace, ".") {
+			if !nameRegexp.MatchString(part) {
+				return p.errorf("invalid namespace %q", namespace)
+			}
+		}
+	}
+	schema.Name = name
+	schema.Namespace = namespace
+	if primitiveTypes[Type(schema.FullName())] {
+		return p.errorf("name %q redefines a primitive type", name)
+	}
+	if _, exists := p.names[schema.FullName()]; exists {
+		return p.errorf("type %q is defined more than once", schema.FullName())
+	}
+	p.names[schema.FullName()] = schema
+
+	schema.Doc, _ = object["doc"].(string)
+	schema.Aliases, err = stringsProperty(object, "aliases")
+	if err != nil {
+		return p.errorf("%s %s", schema.FullName(), err.Error())
+	}
+	return nil
+}
+
+func (p *parser) parseRecord(object map[string]interface{}, recordType Type, namespace string) (*Schema, error) {
+	schema := &Schema{Type: recordType}
+	if err := p.parseName(object, schema, namespace); err != nil {
+		return nil, err
+	}
+	fields, ok := object["fields"].([]interface{})
+	if !ok {
+		return nil, p.errorf("record %s has no fields", schema.FullName())
+	}
+	seen := map[string]bool{}
+	for _, rawField := range fields {
+		fieldObject, ok := rawField.(map[string]interface{})
+		if !ok {
+			return nil, p.errorf("record %s has an invalid field", schema.FullName())
+		}
+		field := &Field{}
+		field.Name, _ = fieldObject["name"].(string)
+		if !nameRegexp.MatchString(field.Name) {
+			return nil, p.errorf("record %s has a field with invalid name %q", schema.FullName(), field.Name)
+		}
+		if seen[field.Name] {
+			return nil, p.errorf("record %s has more than one field named %q", schema.FullName(), field.Name)
+		}
+		seen[field.Name] = true
+		fieldType, ok := fieldObject["type"]
+		if !ok {
+			return nil, p.errorf("field %s.%s has no type", schema.FullName(), field.Name)
+		}
+		var err error
+		field.Type, err = p.parse(fieldType, schema.Namespace)
+		if err != nil {
+			return nil, err
+		}
+		field.Doc, _ = fieldObject["doc"].(string)
+		field.Order, _ = fieldObject["order"].(string)
+		field.Default, field.HasDefault = fieldObject["default"]
+		field.Aliases, err = stringsProperty(fieldObject, "aliases")
+		if err != nil {
+			return nil, p.errorf("field %s.%s %s", schema.FullName(), field.Name, err.Error())
+		}
+		schema.Fields = append(schema.Fields, field)
+	}
+	return schema, nil
+}
+
+func (p *parser) parseEnum(object map[string]interface{}, namespace string) (*Schema, error) {
+	schema := &Schema{Type: TypeEnum}
+	if err := p.parseName(object, schema, namespace); err != nil {
+		return nil, err
+	}
+	symbols, err := stringsProperty(object, "symbols")
+	if err != nil || len(symbols) == 0 {
+		return nil, p.errorf("enum %s has no symbols", schema.FullName())
+	}
+	seen := map[string]bool{}
+	for _, symbol := range symbols {
+		if !nameRegexp.MatchString(symbol) || seen[symbol] {
+			return nil, p.errorf("enum %s has an invalid or duplicate symbol %q", schema.FullName(), symbol)
+		}
+		seen[symbol] = true
+	}
+	schema.Symbols = symbols
+	if enumDefault, ok := object["default"].(string); ok {
+		if !seen[enumDefault] {
+			return nil, p.errorf("enum %s default %q is not a symbol", schema.FullName(), enumDefault)
+		}
+		schema.EnumDefault = enumDefault
+	}
+	return schema, nil
+}
+
+func (p *parser) parseFixed(object map[string]interface{}, namespace string) (*Schema, error) {
+	schema := &Schema{Type: TypeFixed}
+	if err := p.parseName(object, schema, namespace); err != nil {
+		return nil, err
+	}
+	size, err := intProperty(object, "size")
+	if err != nil || size < 0 {
+		return nil, p.errorf("fixed %s has an invalid size", schema.FullName())
+	}
+	schema.Size = size
+	return schema, nil
+}
+
+// qualify returns the full name of a type referenced from within a namespace.
+func qualify(name string, namespace string) string {
+	if namespace == "" || strings.Contains(name, ".") {
+		return name
+	}
+	return namespace + "." + name
+}
+
+func intProperty(object map[string]interface{}, property string) (int, error) {
+	number, ok := object[property].(json.Number)
+	if !ok {
+		return 0, fmt.Errorf("%s is not a number", property)
+	}
+	value, err := number.Int64()
+	if err != nil {
+		return 0, fmt.Errorf("%s is not an integer", property)
+	}
+	return int(value), nil
+}
+
+func stringsProperty(object map[string]interface{}, property string) ([]string, error) {
+	raw, ok := object[property]
+	if !ok {
+		return nil, nil
+	}
+	values, ok := raw.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%s is not an array", property)
+	}
+	result := make([]string, 0, len(values))
+	for _, value := range values {
+		text, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("%s contains a value that is not a string", property)
+		}
+		result = append(result, text)
+	}
+	return result, nil
+}
@@ -0,0 +1,109 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package avro
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const userSchema = `{
+	"type": "record",
+	"name": "User",
+	"namespace": "com.example",
+	"doc": "A registered user.",
+	"fields": [
+		{"name": "user_id", "type": {"type": "string", "logicalType": "uuid"}},
+		{"name": "email", "type": ["null", "string"], "default": null, "doc": "The contact address."},
+		{"name": "status", "type": {"type": "enum", "name": "Status", "symbols": ["ACTIVE", "SUSPENDED"], "default": "ACTIVE"}},
+		{"name": "createdAt", "type": {"type": "long", "logicalType": "timestamp-millis"}},
+		{"name": "balance", "type": {"type": "bytes", "logicalType": "decimal", "precision": 10, "scale": 2}},
+		{"name": "tags", "type": {"type": "map", "values": "string"}},
+		{"name": "fingerprint", "type": {"type": "fixed", "name": "MD5", "size": 16}},
+		{"name": "referrer", "type": ["null", "User"], "default": null},
+		{"name": "payload", "type": ["null", "string", "long"]}
+	]
+}`
+
+func TestParse(t *testing.T) {
+	schema, err := Parse(userSchema)
+	assert.Nil(t, err)
+	assert.Equal(t, TypeRecord, schema.Type)
+	assert.Equal(t, "com.example.User", schema.FullName())
+	assert.Len(t, schema.Fields, 9)
+
+	assert.Equal(t, LogicalTypeUUID, schema.Fields[0].Type.LogicalType)
+	assert.Equal(t, TypeUnion, schema.Fields[1].Type.Type)
+	assert.True(t, schema.Fields[1].HasDefault)
+	assert.Nil(t, schema.Fields[1].Default)
+	assert.Equal(t, "com.example.Status", schema.Fields[2].Type.FullName())
+	assert.Equal(t, "ACTIVE", schema.Fields[2].Type.EnumDefault)
+	assert.Equal(t, 10, schema.Fields[4].Type.Precision)
+	assert.Equal(t, 2, schema.Fields[4].Type.Scale)
+	assert.Equal(t, 16, schema.Fields[6].Type.Size)
+
+	// The recursive reference resolves to the record itself
+	assert.Same(t, schema, schema.Fields[7].Type.Types[1])
+}
+
+func TestParseMultiple(t *testing.T) {
+	schemas, err := ParseMultiple(
+		`{"type": "record", "name": "Address", "namespace": "com.example", "fields": [{"name": "city", "type": "string"}]}`,
+		`{"type": "record", "name": "Customer", "namespace": "com.example", "fields": [{"name": "address", "type": "Address"}]}`,
+	)
+	assert.Nil(t, err)
+	assert.Same(t, schemas[0], schemas[1].Fields[0].Type)
+
+	_, err = Parse(`{"type": "record", "name": "Customer", "fields": [{"name": "address", "type": "Address"}]}`)
+	assert.NotNil(t, err)
+}
+
+func TestParseMap(t *testing.T) {
+	schema, err := ParseMap(map[string]interface{}{
+		"type":   "record",
+		"name":   "book_record",
+		"fields": []interface{}{map[string]interface{}{"name": "title", "type": "string"}},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "book_record", schema.Name)
+	assert.Equal(t, TypeString, schema.Fields[0].Type.Type)
+}
+
+func TestParseErrors(t *testing.T) {
+	for _, document := range []string{
+		`{`,
+		`"unknown"`,
+		`[]`,
+		`["string", "string"]`,
+		`["null", ["string"]]`,
+		`{"type": "record", "fields": []}`,
+		`{"type": "record", "name": "1st", "fields": []}`,
+		`{"type": "record", "name": "R", "fields": [{"name": "a", "type": "int"}, {"name": "a", "type": "int"}]}`,
+		`{"type": "record", "name": "R", "fields": [{"name": "a"}]}`,
+		`{"type": "enum", "name": "E", "symbols": []}`,
+		`{"type": "enum", "name": "E", "symbols": ["A", "A"]}`,
+		`{"type": "enum", "name": "E", "symbols": ["A"], "default": "B"}`,
+		`{"type": "fixed", "name": "F"}`,
+		`{"type": "array"}`,
+		`{"type": "bytes", "logicalType": "decimal"}`,
+		`{"type": "record", "name": "R", "fields": [{"name": "a", "type": {"type": "enum", "name": "R", "symbols": ["A"]}}]}`,
+	} {
+		_, err := Parse(document)
+		assert.NotNil(t, err, document)
+	}
+}
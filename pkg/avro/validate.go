@@ -0,0 +1,353 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package avro
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
+
+	common "github.com/IBM/eventstreams-go-sdk/pkg/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Constants associated with the Field.Order property.
+// The sort orders of a record field.
+const (
+	FieldOrderAscending  = "ascending"
+	FieldOrderDescending = "descending"
+	FieldOrderIgnore     = "ignore"
+)
+
+// Validate checks the schema strictly before it is uploaded to the registry. In addition to the checks made by Parse,
+// it reports:
+//
+//   - names, namespaces, aliases and enum symbols that are not valid Avro names, and named types defined twice
+//   - field defaults that do not match the field type, or the first branch of a union
+//   - logical types that are not valid for their underlying type, such as a uuid on a long or a decimal whose scale
+//     exceeds its precision or whose precision does not fit in its fixed size
+//   - invalid field sort orders and fixed sizes
+//
+// All of the problems found are reported in a single error.
+func (schema *Schema) Validate() error {
+	v := &validator{names: map[string]*Schema{}, visited: map[*Schema]bool{}}
+	v.schema(schema, schema.Name)
+	if len(v.problems) == 0 {
+		return nil
+	}
+	return core.SDKErrorf(nil, "invalid Avro schema: "+strings.Join(v.problems, "; "), "avro-validation-error", common.GetComponentInfo())
+}
+
+// ParseStrict parses an Avro schema document and validates it with Validate.
+func ParseStrict(data string) (*Schema, error) {
+	schema, err := Parse(data)
+	if err != nil {
+		return nil, err
+	}
+	if err := schema.Validate(); err != nil {
+		return nil, err
+	}
+	return schema, nil
+}
+
+type validator struct {
+	names    map[string]*Schema
+	visited  map[*Schema]bool
+	problems []string
+}
+
+func (v *validator) report(path string, format string, args ...interface{}) {
+	v.problems = append(v.problems, path+": "+fmt.Sprintf(format, args...))
+}
+
+func (v *validator) schema(schema *Schema, path string) {
+	if schema == nil {
+		v.report(path, "schema is missing")
+		return
+	}
+	if v.visited[schema] {
+		return
+	}
+	if schema.IsNamed() {
+		v.visited[schema] = true
+		v.name(schema, path)
+	}
+
+	switch schema.Type {
+	case TypeRecord, TypeError:
+		seen := map[string]bool{}
+		for _, field := range schema.Fields {
+			fieldPath := path + "." + field.Name
+			if !nameRegexp.MatchString(field.Name) {
+				v.report(fieldPath, "invalid field name %q", field.Name)
+			}
+			if seen[field.Name] {
+				v.report(fieldPath, "duplicate field name")
+			}
+			seen[field.Name] = true
+			for _, alias := range field.Aliases {
+				if !nameRegexp.MatchString(alias) {
+					v.report(fieldPath, "invalid alias %q", alias)
+				}
+			}
+			switch field.Order {
+			case "", FieldOrderAscending, FieldOrderDescending, FieldOrderIgnore:
+			default:
+				v.report(fieldPath, "invalid order %q", field.Order)
+			}
+			v.schema(field.Type, fieldPath)
+			if field.HasDefault && field.Type != nil {
+				if problem := defaultProblem(field.Type, field.Default); problem != "" {
+					v.report(fieldPath, "invalid default: %s", problem)
+				}
+			}
+		}
+	case TypeEnum:
+		if len(schema.Symbols) == 0 {
+			v.report(path, "enum has no symbols")
+		}
+		seen := map[string]bool{}
+		for _, symbol := range schema.Symbols {
+			if !nameRegexp.MatchString(symbol) || seen[symbol] {
+				v.report(path, "invalid or duplicate symbol %q", symbol)
+			}
+			seen[symbol] = true
+		}
+		if schema.EnumDefault != "" && !seen[schema.EnumDefault] {
+			v.report(path, "default %q is not a symbol", schema.EnumDefault)
+		}
+	case TypeFixed:
+		if schema.Size < 0 {
+			v.report(path, "invalid size %d", schema.Size)
+		}
+	case TypeArray:
+		v.schema(schema.Items, path+"[]")
+	case TypeMap:
+		v.schema(schema.Values, path+"{}")
+	case TypeUnion:
+		if len(schema.Types) == 0 {
+			v.report(path, "union has no branches")
+		}
+		seen := map[string]bool{}
+		for _, branch := range schema.Types {
+			if branch == nil {
+				v.report(path, "union branch is missing")
+				continue
+			}
+			key := string(branch.Type)
+			if branch.IsNamed() {
+				key = branch.FullName()
+			}
+			if branch.Type == TypeUnion {
+				v.report(path, "union contains a nested union")
+			} else if seen[key] {
+				v.report(path, "union contains %q more than once", key)
+			}
+			seen[key] = true
+			v.schema(branch, path)
+		}
+	default:
+		if !primitiveTypes[schema.Type] {
+			v.report(path, "unknown type %q", schema.Type)
+		}
+	}
+	v.logicalType(schema, path)
+}
+
+func (v *validator) name(schema *Schema, path string) {
+	if !nameRegexp.MatchString(schema.Name) {
+		v.report(path, "invalid name %q", schema.Name)
+	}
+	if schema.Namespace != "" {
+		for _, part := range strings.Split(schema.Namespace, ".") {
+			if !nameRegexp.MatchString(part) {
+				v.report(path, "invalid namespace %q", schema.Namespace)
+				break
+			}
+		}
+	}
+	if primitiveTypes[Type(schema.Name)] && schema.Namespace == "" {
+		v.report(path, "name %q redefines a primitive type", schema.Name)
+	}
+	if other, exists := v.names[schema.FullName()]; exists && other != schema {
+		v.report(path, "type %q is defined more than once", schema.FullName())
+	}
+	v.names[schema.FullName()] = schema
+	for _, alias := range schema.Aliases {
+		for _, part := range strings.Split(alias, ".") {
+			if !nameRegexp.MatchString(part) {
+				v.report(path, "invalid alias %q", alias)
+				break
+			}
+		}
+	}
+}
+
+func (v *validator) logicalType(schema *Schema, path string) {
+	var valid bool
+	switch schema.LogicalType {
+	case "":
+		return
+	case LogicalTypeDecimal:
+		valid = schema.Type == TypeBytes || schema.Type == TypeFixed
+		if valid {
+			if schema.Precision <= 0 {
+				v.report(path, "decimal precision must be positive")
+			}
+			if schema.Scale < 0 || schema.Scale > schema.Precision {
+				v.report(path, "decimal scale must be between 0 and the precision")
+			}
+			if schema.Type == TypeFixed && schema.Precision > maxDecimalPrecision(schema.Size) {
+				v.report(path, "decimal precision %d does not fit in %d bytes", schema.Precision, schema.Size)
+			}
+		}
+	case LogicalTypeUUID:
+		valid = schema.Type == TypeString || (schema.Type == TypeFixed && schema.Size == 16)
+	case LogicalTypeDate, LogicalTypeTimeMillis:
+		valid = schema.Type == TypeInt
+	case LogicalTypeTimeMicros, LogicalTypeTimestampMillis, LogicalTypeTimestampMicros, LogicalTypeLocalTimestampMillis, LogicalTypeLocalTimestampMicros:
+		valid = schema.Type == TypeLong
+	case LogicalTypeDuration:
+		valid = schema.Type == TypeFixed && schema.Size == 12
+	default:
+		v.report(path, "unknown logical type %q", schema.LogicalType)
+		return
+	}
+	if !valid {
+		v.report(path, "logical type %q cannot annotate %s", schema.LogicalType, schema.Type)
+	}
+}
+
+// maxDecimalPrecision returns the number of base 10 digits that a two's complement number of size bytes can hold.
+func maxDecimalPrecision(size int) int {
+	if size <= 0 {
+		return 0
+	}
+	return int(math.Floor(math.Log10(2) * float64(8*size-1)))
+}
+
+// defaultProblem describes why a default value does not match a schema, or returns an empty string. As in the Avro
+// specification, the default of a union must match the first branch of the union.
+func defaultProblem(schema *Schema, value interface{}) string {
+	switch schema.Type {
+	case TypeNull:
+		if value != nil {
+			return "expected null"
+		}
+	case TypeBoolean:
+		if _, ok := value.(bool); !ok {
+			return "expected a boolean"
+		}
+	case TypeInt, TypeLong:
+		number, ok := numberValue(value)
+		if !ok || number != math.Trunc(number) {
+			return fmt.Sprintf("expected an integer for %s", schema.Type)
+		}
+		if schema.Type == TypeInt && (number < math.MinInt32 || number > math.MaxInt32) {
+			return "integer out of range for int"
+		}
+	case TypeFloat, TypeDouble:
+		if _, ok := numberValue(value); !ok {
+			return fmt.Sprintf("expected a number for %s", schema.Type)
+		}
+	case TypeBytes, TypeString, TypeFixed:
+		text, ok := value.(string)
+		if !ok {
+			return fmt.Sprintf("expected a string for %s", schema.Type)
+		}
+		if schema.Type == TypeFixed && len([]rune(text)) != schema.Size {
+			return fmt.Sprintf("expected %d characters for fixed %s", schema.Size, schema.FullName())
+		}
+	case TypeEnum:
+		symbol, ok := value.(string)
+		if !ok || !containsSymbol(schema.Symbols, symbol) {
+			return fmt.Sprintf("expected a symbol of enum %s", schema.FullName())
+		}
+	case TypeArray:
+		items, ok := value.([]interface{})
+		if !ok {
+			return "expected an array"
+		}
+		for _, item := range items {
+			if problem := defaultProblem(schema.Items, item); problem != "" {
+				return problem
+			}
+		}
+	case TypeMap:
+		values, ok := value.(map[string]interface{})
+		if !ok {
+			return "expected an object"
+		}
+		for _, item := range values {
+			if problem := defaultProblem(schema.Values, item); problem != "" {
+				return problem
+			}
+		}
+	case TypeRecord, TypeError:
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Sprintf("expected an object for record %s", schema.FullName())
+		}
+		for _, field := range schema.Fields {
+			fieldValue, present := object[field.Name]
+			if !present {
+				if field.HasDefault {
+					continue
+				}
+				return fmt.Sprintf("missing field %s of record %s", field.Name, schema.FullName())
+			}
+			if problem := defaultProblem(field.Type, fieldValue); problem != "" {
+				return problem
+			}
+		}
+	case TypeUnion:
+		if len(schema.Types) == 0 {
+			return "union has no branches"
+		}
+		return defaultProblem(schema.Types[0], value)
+	}
+	return ""
+}
+
+func numberValue(value interface{}) (float64, bool) {
+	switch number := value.(type) {
+	case json.Number:
+		result, err := number.Float64()
+		return result, err == nil
+	case float64:
+		return number, true
+	case float32:
+		return float64(number), true
+	case int:
+		return float64(number), true
+	case int32:
+		return float64(number), true
+	case int64:
+		return float64(number), true
+	}
+	return 0, false
+}
+
+func containsSymbol(symbols []string, symbol string) bool {
+	for _, candidate := range symbols {
+		if candidate == symbol {
+			return true
+		}
+	}
+	return false
+}
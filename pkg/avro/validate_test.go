@@ -0,0 +1,87 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package avro
+
+import (
+	"testing"
+
+	"github.com/IBM/eventstreams-go-sdk/pkg/schemaregistryv1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidate(t *testing.T) {
+	schema, err := ParseStrict(userSchema)
+	assert.Nil(t, err)
+	assert.Nil(t, schema.Validate())
+
+	_, err = ParseStrict(`{"type": "record", "name": "R", "fields": [
+		{"name": "a", "type": "int", "default": "one"},
+		{"name": "b", "type": ["null", "string"], "default": "x"},
+		{"name": "c", "type": {"type": "long", "logicalType": "uuid"}},
+		{"name": "d", "type": {"type": "bytes", "logicalType": "decimal", "precision": 2, "scale": 3}},
+		{"name": "e", "type": {"type": "fixed", "name": "F", "size": 2, "logicalType": "decimal", "precision": 9}},
+		{"name": "f", "type": "int", "order": "sideways"},
+		{"name": "g", "type": {"type": "array", "items": "int"}, "default": [1, "2"]},
+		{"name": "h", "type": "int", "default": 3000000000},
+		{"name": "i", "type": {"type": "string", "logicalType": "rot13"}}
+	]}`)
+	assert.NotNil(t, err)
+	for _, problem := range []string{
+		"R.a: invalid default: expected an integer for int",
+		"R.b: invalid default: expected null",
+		`R.c: logical type "uuid" cannot annotate long`,
+		"R.d: decimal scale must be between 0 and the precision",
+		"R.e: decimal precision 9 does not fit in 2 bytes",
+		`R.f: invalid order "sideways"`,
+		"R.g: invalid default: expected an integer for int",
+		"R.h: invalid default: integer out of range for int",
+		`R.i: unknown logical type "rot13"`,
+	} {
+		assert.Contains(t, err.Error(), problem)
+	}
+
+	_, err = ParseStrict(`{"type": "record", "name": "R", "fields": [
+		{"name": "p", "type": {"type": "record", "name": "P", "fields": [{"name": "x", "type": "int"}, {"name": "y", "type": "int", "default": 0}]}, "default": {"x": 1}},
+		{"name": "m", "type": {"type": "map", "values": "double"}, "default": {"k": 1.5}},
+		{"name": "u", "type": {"type": "fixed", "name": "U", "size": 16, "logicalType": "uuid"}}
+	]}`)
+	assert.Nil(t, err)
+
+	// Schemas built in code are validated in the same way
+	invalid := &Schema{Type: TypeRecord, Name: "9lives", Fields: []*Field{
+		{Name: "a", Type: &Schema{Type: TypeEnum, Name: "E", Symbols: []string{"A", "A"}}},
+		{Name: "a", Type: &Schema{Type: TypeUnion}},
+	}}
+	err = invalid.Validate()
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), `invalid name "9lives"`)
+	assert.Contains(t, err.Error(), `invalid or duplicate symbol "A"`)
+	assert.Contains(t, err.Error(), "duplicate field name")
+	assert.Contains(t, err.Error(), "union has no branches")
+}
+
+func TestFromAvroSchema(t *testing.T) {
+	schema, err := FromAvroSchema(&schemaregistryv1.AvroSchema{Schema: map[string]interface{}{
+		"type": "enum", "name": "Suit", "symbols": []interface{}{"SPADES", "HEARTS"},
+	}})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"SPADES", "HEARTS"}, schema.Symbols)
+	assert.Equal(t, map[string]interface{}{"type": "enum", "name": "Suit", "symbols": []string{"SPADES", "HEARTS"}}, schema.ToMap())
+
+	_, err = FromAvroSchema(&schemaregistryv1.AvroSchema{})
+	assert.NotNil(t, err)
+}
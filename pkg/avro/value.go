@@ -0,0 +1,300 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package avro
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	common "github.com/IBM/eventstreams-go-sdk/pkg/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+var numberType = reflect.TypeOf(json.Number(""))
+
+// ValidateValue checks that a value can be written with the schema, and reports every mismatch in a single error.
+//
+// The value can be a decoded JSON document, or a Go value such as a struct generated by avrogen or described by
+// Reflect, whose fields are named by their avro tags. Union values are plain values that match one of the branches,
+// or objects with a single property naming the branch, as in the Avro JSON encoding. Bytes and fixed values are
+// strings or byte slices, and logical types also accept time.Time for timestamps and dates, time.Duration for times of
+// day and *big.Rat for decimals.
+// Nil Go slices and maps are treated as empty.
+func (schema *Schema) ValidateValue(value interface{}) error {
+	var problems []string
+	valueProblems(schema, genericValue(reflect.ValueOf(value)), "$", &problems)
+	if len(problems) == 0 {
+		return nil
+	}
+	return core.SDKErrorf(nil, "value does not match schema "+schemaLabel(schema)+": "+strings.Join(problems, "; "), "avro-value-error", common.GetComponentInfo())
+}
+
+// ValidateJSON checks that a JSON document can be written with the schema, as described for ValidateValue.
+func (schema *Schema) ValidateJSON(data []byte) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return core.SDKErrorf(err, "invalid JSON value: "+err.Error(), "avro-value-error", common.GetComponentInfo())
+	}
+	return schema.ValidateValue(value)
+}
+
+func schemaLabel(schema *Schema) string {
+	if schema.IsNamed() {
+		return schema.FullName()
+	}
+	return string(schema.Type)
+}
+
+// genericValue converts a Go value to the values produced by decoding JSON, keeping byte slices, times, durations and
+// decimals.
+func genericValue(value reflect.Value) interface{} {
+	if !value.IsValid() {
+		return nil
+	}
+	switch value.Type() {
+	case timeType:
+		return value.Interface().(time.Time)
+	case durationType:
+		return time.Duration(value.Int())
+	case ratType:
+		rat := value.Interface().(big.Rat)
+		return &rat
+	case numberType:
+		return value.Interface().(json.Number)
+	}
+	switch value.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if value.IsNil() {
+			return nil
+		}
+		return genericValue(value.Elem())
+	case reflect.Bool:
+		return value.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return json.Number(strconv.FormatInt(value.Int(), 10))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return json.Number(strconv.FormatUint(value.Uint(), 10))
+	case reflect.Float32, reflect.Float64:
+		return value.Float()
+	case reflect.String:
+		return value.String()
+	case reflect.Slice, reflect.Array:
+		if value.Type().Elem().Kind() == reflect.Uint8 {
+			data := make([]byte, value.Len())
+			reflect.Copy(reflect.ValueOf(data), value)
+			return data
+		}
+		items := make([]interface{}, value.Len())
+		for i := range items {
+			items[i] = genericValue(value.Index(i))
+		}
+		return items
+	case reflect.Map:
+		values := map[string]interface{}{}
+		iterator := value.MapRange()
+		for iterator.Next() {
+			values[fmt.Sprint(iterator.Key().Interface())] = genericValue(iterator.Value())
+		}
+		return values
+	case reflect.Struct:
+		object := map[string]interface{}{}
+		structFields(value, object)
+		return object
+	}
+	return value.Interface()
+}
+
+// durationValue converts a duration to the integer that is written for it: milliseconds for time-millis, microseconds
+// for time-micros and nanoseconds otherwise.
+func durationValue(schema *Schema, duration time.Duration) json.Number {
+	switch schema.LogicalType {
+	case LogicalTypeTimeMillis:
+		return json.Number(strconv.FormatInt(duration.Milliseconds(), 10))
+	case LogicalTypeTimeMicros:
+		return json.Number(strconv.FormatInt(duration.Microseconds(), 10))
+	}
+	return json.Number(strconv.FormatInt(int64(duration), 10))
+}
+
+// structFields adds the fields of a struct to object, named as Reflect names them.
+func structFields(value reflect.Value, object map[string]interface{}) {
+	t := value.Type()
+	for i := 0; i < t.NumField(); i++ {
+		structField := t.Field(i)
+		tag, tagged := structField.Tag.Lookup(TagName)
+		name := strings.Split(tag, ",")[0]
+		if name == "-" {
+			continue
+		}
+		if structField.Anonymous && !tagged && structField.Type.Kind() == reflect.Struct {
+			structFields(value.Field(i), object)
+			continue
+		}
+		if structField.PkgPath != "" {
+			continue
+		}
+		if name == "" {
+			name = structField.Name
+		}
+		object[name] = genericValue(value.Field(i))
+	}
+}
+
+func valueProblems(schema *Schema, value interface{}, path string, problems *[]string) {
+	report := func(format string, args ...interface{}) {
+		*problems = append(*problems, path+": "+fmt.Sprintf(format, args...))
+	}
+
+	switch schema.Type {
+	case TypeNull:
+		if value != nil {
+			report("expected null")
+		}
+	case TypeBoolean:
+		if _, ok := value.(bool); !ok {
+			report("expected a boolean")
+		}
+	case TypeInt, TypeLong:
+		if _, ok := value.(time.Time); ok && schema.LogicalType != "" {
+			return
+		}
+		if duration, ok := value.(time.Duration); ok {
+			value = durationValue(schema, duration)
+		}
+		number, ok := numberValue(value)
+		if !ok || number != math.Trunc(number) {
+			report("expected an integer for %s", schema.Type)
+		} else if schema.Type == TypeInt && (number < math.MinInt32 || number > math.MaxInt32) {
+			report("integer %v out of range for int", value)
+		}
+	case TypeFloat, TypeDouble:
+		if _, ok := numberValue(value); !ok {
+			report("expected a number for %s", schema.Type)
+		}
+	case TypeString:
+		if _, ok := value.(string); !ok {
+			report("expected a string")
+		}
+	case TypeBytes, TypeFixed:
+		size := -1
+		switch data := value.(type) {
+		case string:
+			size = len([]rune(data))
+		case []byte:
+			size = len(data)
+		case *big.Rat:
+			if schema.LogicalType == LogicalTypeDecimal {
+				return
+			}
+		}
+		if size < 0 {
+			report("expected a string or bytes for %s", schema.Type)
+		} else if schema.Type == TypeFixed && size != schema.Size {
+			report("expected %d bytes for fixed %s, got %d", schema.Size, schema.FullName(), size)
+		}
+	case TypeEnum:
+		symbol, ok := value.(string)
+		if !ok || !containsSymbol(schema.Symbols, symbol) {
+			report("expected a symbol of enum %s, got %v", schema.FullName(), value)
+		}
+	case TypeArray:
+		items, ok := value.([]interface{})
+		if !ok {
+			report("expected an array")
+			return
+		}
+		for i, item := range items {
+			valueProblems(schema.Items, item, fmt.Sprintf("%s[%d]", path, i), problems)
+		}
+	case TypeMap:
+		values, ok := value.(map[string]interface{})
+		if !ok {
+			report("expected an object")
+			return
+		}
+		keys := make([]string, 0, len(values))
+		for key := range values {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			valueProblems(schema.Values, values[key], fmt.Sprintf("%s[%s]", path, strconv.Quote(key)), problems)
+		}
+	case TypeRecord, TypeError:
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			report("expected an object for record %s", schema.FullName())
+			return
+		}
+		known := map[string]bool{}
+		for _, field := range schema.Fields {
+			known[field.Name] = true
+			fieldValue, present := object[field.Name]
+			if !present {
+				if !field.HasDefault {
+					report("missing field %s", field.Name)
+				}
+				continue
+			}
+			valueProblems(field.Type, fieldValue, path+"."+field.Name, problems)
+		}
+		var unknown []string
+		for name := range object {
+			if !known[name] {
+				unknown = append(unknown, name)
+			}
+		}
+		sort.Strings(unknown)
+		for _, name := range unknown {
+			report("unknown field %s", name)
+		}
+	case TypeUnion:
+		for _, branch := range schema.Types {
+			var branchProblems []string
+			valueProblems(branch, value, path, &branchProblems)
+			if len(branchProblems) == 0 {
+				return
+			}
+		}
+		// The Avro JSON encoding wraps non-null union values in an object naming the branch.
+		if object, ok := value.(map[string]interface{}); ok && len(object) == 1 {
+			for name, wrapped := range object {
+				for _, branch := range schema.Types {
+					if name == string(branch.Type) || (branch.IsNamed() && (name == branch.FullName() || name == branch.Name)) {
+						valueProblems(branch, wrapped, path, problems)
+						return
+					}
+				}
+			}
+		}
+		names := make([]string, 0, len(schema.Types))
+		for _, branch := range schema.Types {
+			names = append(names, schemaLabel(branch))
+		}
+		report("value matches none of the union branches %s", strings.Join(names, ", "))
+	}
+}
@@ -0,0 +1,101 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package avro
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const paymentSchema = `{"type": "record", "name": "Payment", "namespace": "com.example", "fields": [
+	{"name": "id", "type": "string"},
+	{"name": "amount", "type": "int"},
+	{"name": "currency", "type": {"type": "enum", "name": "Currency", "symbols": ["EUR", "USD"]}},
+	{"name": "note", "type": ["null", "string"], "default": null},
+	{"name": "tags", "type": {"type": "array", "items": "string"}, "default": []},
+	{"name": "hash", "type": {"type": "fixed", "name": "Hash", "size": 4}}
+]}`
+
+func TestValidateJSON(t *testing.T) {
+	schema, err := Parse(paymentSchema)
+	assert.Nil(t, err)
+
+	assert.Nil(t, schema.ValidateJSON([]byte(`{"id": "p1", "amount": 10, "currency": "EUR", "hash": "abcd"}`)))
+	assert.Nil(t, schema.ValidateJSON([]byte(`{"id": "p1", "amount": 10, "currency": "EUR", "hash": "abcd", "note": {"string": "hi"}, "tags": ["a"]}`)))
+	assert.Nil(t, schema.ValidateJSON([]byte(`{"id": "p1", "amount": 10, "currency": "EUR", "hash": "abcd", "note": "hi"}`)))
+
+	err = schema.ValidateJSON([]byte(`{"amount": 1.5, "currency": "GBP", "note": 3, "tags": ["a", 1], "hash": "abc", "extra": true}`))
+	assert.NotNil(t, err)
+	assert.Equal(t, "value does not match schema com.example.Payment: "+
+		"$: missing field id; "+
+		"$.amount: expected an integer for int; "+
+		"$.currency: expected a symbol of enum com.example.Currency, got GBP; "+
+		"$.note: value matches none of the union branches null, string; "+
+		"$.tags[1]: expected a string; "+
+		"$.hash: expected 4 bytes for fixed com.example.Hash, got 3; "+
+		"$: unknown field extra", err.Error())
+
+	assert.NotNil(t, schema.ValidateJSON([]byte(`{"amount": 3000000000}`)))
+	assert.NotNil(t, schema.ValidateJSON([]byte(`{`)))
+}
+
+func TestValidateValue(t *testing.T) {
+	schema, err := Reflect(testOrder{}, "com.example")
+	assert.Nil(t, err)
+
+	note := "fragile"
+	order := testOrder{
+		testAudit: testAudit{CreatedBy: "me"},
+		ID:        "5f0c",
+		Quantity:  2,
+		Total:     big.NewRat(1999, 100),
+		PlacedAt:  time.Now(),
+		Color:     "RED",
+		Tags:      []string{"a"},
+		Labels:    map[string]int64{"x": 1},
+		Parent:    &testOrder{Color: "GREEN", PlacedAt: time.Now(), Total: big.NewRat(1, 1)},
+		Notes:     &note,
+	}
+	assert.Nil(t, schema.ValidateValue(order))
+	assert.Nil(t, schema.ValidateValue(&order))
+
+	order.Color = "BLUE"
+	err = schema.ValidateValue(order)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "$.color: expected a symbol of enum com.example.testColor, got BLUE")
+
+	assert.Nil(t, (&Schema{Type: TypeLong}).ValidateValue(uint8(3)))
+	assert.NotNil(t, (&Schema{Type: TypeString}).ValidateValue(nil))
+	assert.Nil(t, (&Schema{Type: TypeBytes}).ValidateValue([]byte{1, 2}))
+	assert.Nil(t, (&Schema{Type: TypeMap, Values: &Schema{Type: TypeInt}}).ValidateValue(map[string]interface{}{"a": 1}))
+
+	// Durations are written in the unit of their logical type.
+	type testTrip struct {
+		Length time.Duration `avro:"length"`
+		Delay  time.Duration `avro:"delay" avrological:"time-micros"`
+	}
+	tripSchema, err := Reflect(testTrip{}, "com.example")
+	assert.Nil(t, err)
+	assert.Equal(t, LogicalTypeTimeMicros, tripSchema.Fields[1].Type.LogicalType)
+	assert.Nil(t, tripSchema.ValidateValue(testTrip{Length: 23 * time.Hour, Delay: 90 * time.Minute}))
+	err = (&Schema{Type: TypeInt, LogicalType: LogicalTypeTimeMicros}).ValidateValue(time.Hour)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "integer 3600000000 out of range for int")
+}
@@ -0,0 +1,80 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"context"
+	"time"
+)
+
+// The classes of service operations, which have separate default timeouts.
+const (
+	// OperationClassRead is the class of operations that do not change anything, such as GET operations and the
+	// schema lookups and compatibility tests of the Confluent registry API.
+	OperationClassRead = "read"
+
+	// OperationClassWrite is the class of operations that create, change or delete resources.
+	OperationClassWrite = "write"
+
+	// OperationClassLongRunning is the class of operations that can take much longer than others, such as
+	// DeleteTopicRecords.
+	OperationClassLongRunning = "long_running"
+)
+
+// OperationTimeouts : The default timeouts of the operations of a service, by operation class. A timeout applies to
+// the whole operation, including its retries, and only when the context passed to the operation has no deadline.
+// Zero means no timeout.
+type OperationTimeouts struct {
+	// The timeout of OperationClassRead operations.
+	Read time.Duration
+
+	// The timeout of OperationClassWrite operations.
+	Write time.Duration
+
+	// The timeout of OperationClassLongRunning operations.
+	LongRunning time.Duration
+}
+
+// Timeout returns the timeout of an operation class.
+func (timeouts OperationTimeouts) Timeout(class string) time.Duration {
+	switch class {
+	case OperationClassRead:
+		return timeouts.Read
+	case OperationClassWrite:
+		return timeouts.Write
+	case OperationClassLongRunning:
+		return timeouts.LongRunning
+	}
+	return 0
+}
+
+// OperationContext returns the context of an operation of a class: ctx with the timeout of the class, or ctx itself
+// when it already has a deadline or the class has no timeout. The returned cancel function must be called once the
+// operation completes.
+//
+// This function is invoked by generated service methods, so that the methods without a context parameter, which use
+// context.Background(), are also bounded.
+func OperationContext(ctx context.Context, timeouts OperationTimeouts, class string) (context.Context, context.CancelFunc) {
+	timeout := timeouts.Timeout(class)
+	if ctx == nil || timeout <= 0 {
+		return ctx, func() {}
+	}
+	if _, ok := ctx.Deadline(); ok {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, timeout)
+}
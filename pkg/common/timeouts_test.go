@@ -0,0 +1,54 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOperationTimeouts(t *testing.T) {
+	timeouts := OperationTimeouts{Read: time.Second, Write: 2 * time.Second, LongRunning: time.Minute}
+	assert.Equal(t, time.Second, timeouts.Timeout(OperationClassRead))
+	assert.Equal(t, 2*time.Second, timeouts.Timeout(OperationClassWrite))
+	assert.Equal(t, time.Minute, timeouts.Timeout(OperationClassLongRunning))
+	assert.Equal(t, time.Duration(0), timeouts.Timeout("other"))
+}
+
+func TestOperationContext(t *testing.T) {
+	timeouts := OperationTimeouts{Read: time.Second}
+
+	ctx, cancel := OperationContext(context.Background(), timeouts, OperationClassRead)
+	defer cancel()
+	deadline, ok := ctx.Deadline()
+	assert.True(t, ok)
+	assert.WithinDuration(t, time.Now().Add(time.Second), deadline, 100*time.Millisecond)
+
+	ctx, cancel = OperationContext(context.Background(), timeouts, OperationClassWrite)
+	defer cancel()
+	_, ok = ctx.Deadline()
+	assert.False(t, ok)
+
+	parent, parentCancel := context.WithTimeout(context.Background(), time.Hour)
+	defer parentCancel()
+	ctx, cancel = OperationContext(parent, timeouts, OperationClassRead)
+	defer cancel()
+	assert.Equal(t, parent, ctx)
+}
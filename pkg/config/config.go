@@ -0,0 +1,322 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package config loads the connection settings of an Event Streams instance from a service key, as returned by
+// `ibmcloud resource service-key`, or from environment variables, and builds admin REST API and schema registry
+// clients that share the same authentication, retries and timeouts.
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/IBM/eventstreams-go-sdk/pkg/adminrestv1"
+	common "github.com/IBM/eventstreams-go-sdk/pkg/common"
+	"github.com/IBM/eventstreams-go-sdk/pkg/schemaregistryv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// The environment variables read by FromEnvironment.
+const (
+	// EnvServiceKeyFile names a file that contains a service key.
+	EnvServiceKeyFile = "EVENTSTREAMS_SERVICE_KEY_FILE"
+
+	// EnvServiceKey contains a service key, when EnvServiceKeyFile is not set.
+	EnvServiceKey = "EVENTSTREAMS_SERVICE_KEY"
+
+	// EnvAdminURL overrides the admin REST API URL.
+	EnvAdminURL = "KAFKA_ADMIN_URL"
+
+	// EnvSchemaRegistryURL overrides the schema registry URL.
+	EnvSchemaRegistryURL = "SCHEMA_REGISTRY_URL"
+
+	// EnvAPIKey overrides the API key.
+	EnvAPIKey = "API_KEY"
+
+	// EnvBearerToken sets a bearer token, used instead of an API key.
+	EnvBearerToken = "BEARER_TOKEN"
+
+	// EnvBrokers overrides the Kafka bootstrap servers, as a comma-separated list.
+	EnvBrokers = "KAFKA_BROKERS"
+)
+
+// Config : The connection settings of an Event Streams instance.
+type Config struct {
+	// The URL of the admin REST API, the `kafka_admin_url` of a service key.
+	AdminURL string
+
+	// The URL of the schema registry. The registry is served by the admin REST API host, so this defaults to
+	// AdminURL.
+	SchemaRegistryURL string
+
+	// The API key, the `api_key` of a service key.
+	APIKey string
+
+	// A bearer token, used instead of APIKey.
+	BearerToken string
+
+	// The Kafka bootstrap servers, the `kafka_brokers_sasl` of a service key.
+	Brokers []string
+
+	// How APIKey authenticates: core.AUTHTYPE_BASIC, which sends it with the user `token` as in the examples, or
+	// core.AUTHTYPE_IAM, which exchanges it for IAM tokens. Defaults to core.AUTHTYPE_BASIC.
+	AuthType string
+
+	// The maximum number of retries of failed requests. Zero disables retries.
+	MaxRetries int
+
+	// The maximum delay between retries. Zero means the core default.
+	MaxRetryInterval time.Duration
+
+	// The timeout of each request. Zero means the core default.
+	Timeout time.Duration
+
+	// The default timeouts of operations by class, which also bound their retries.
+	OperationTimeouts common.OperationTimeouts
+}
+
+// serviceKey holds the fields of a service key that Config uses.
+type serviceKey struct {
+	AdminURL          string   `json:"kafka_admin_url"`
+	SchemaRegistryURL string   `json:"schema_registry_url"`
+	APIKey            string   `json:"api_key"`
+	APIKeyAlias       string   `json:"apikey"`
+	Brokers           []string `json:"kafka_brokers_sasl"`
+
+	// Credentials is set when the key is wrapped in the service key resource returned by the CLI.
+	Credentials *serviceKey `json:"credentials"`
+}
+
+// ParseServiceKey reads the credentials of a service key. data can be the credentials object itself, the service key
+// resource that holds it under `credentials`, or the JSON array printed by `ibmcloud resource service-key --output
+// json`, whose first element is used.
+func ParseServiceKey(data []byte) (config *Config, err error) {
+	data = bytes.TrimSpace(data)
+	var key serviceKey
+	if len(data) > 0 && data[0] == '[' {
+		var keys []serviceKey
+		err = json.Unmarshal(data, &keys)
+		if err == nil && len(keys) == 0 {
+			err = fmt.Errorf("no service keys")
+		}
+		if err == nil {
+			key = keys[0]
+		}
+	} else {
+		err = json.Unmarshal(data, &key)
+	}
+	if err != nil {
+		err = core.SDKErrorf(err, fmt.Sprintf("invalid service key: %s", err.Error()), "invalid-service-key", common.GetComponentInfo())
+		return
+	}
+	if key.Credentials != nil {
+		key = *key.Credentials
+	}
+	if key.AdminURL == "" {
+		err = core.SDKErrorf(nil, "service key has no kafka_admin_url", "invalid-service-key", common.GetComponentInfo())
+		return
+	}
+
+	config = &Config{
+		AdminURL:          key.AdminURL,
+		SchemaRegistryURL: key.SchemaRegistryURL,
+		APIKey:            key.APIKey,
+		Brokers:           key.Brokers,
+	}
+	if config.APIKey == "" {
+		config.APIKey = key.APIKeyAlias
+	}
+	return
+}
+
+// LoadServiceKeyFile reads a service key from a file, as described by ParseServiceKey.
+func LoadServiceKeyFile(path string) (config *Config, err error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "read-service-key-error", common.GetComponentInfo())
+		return
+	}
+	return ParseServiceKey(data)
+}
+
+// FromEnvironment reads the service key named by EnvServiceKeyFile or contained in EnvServiceKey, if any, and then
+// overrides its settings with the other Env variables that are set. This accepts the KAFKA_ADMIN_URL, API_KEY and
+// BEARER_TOKEN variables used by the examples. The result is validated.
+func FromEnvironment() (config *Config, err error) {
+	config = &Config{}
+	if path := os.Getenv(EnvServiceKeyFile); path != "" {
+		config, err = LoadServiceKeyFile(path)
+	} else if key := os.Getenv(EnvServiceKey); key != "" {
+		config, err = ParseServiceKey([]byte(key))
+	}
+	if err != nil {
+		config = nil
+		return
+	}
+
+	if value := os.Getenv(EnvAdminURL); value != "" {
+		config.AdminURL = value
+	}
+	if value := os.Getenv(EnvSchemaRegistryURL); value != "" {
+		config.SchemaRegistryURL = value
+	}
+	if value := os.Getenv(EnvAPIKey); value != "" {
+		config.APIKey = value
+	}
+	if value := os.Getenv(EnvBearerToken); value != "" {
+		config.BearerToken = value
+		if os.Getenv(EnvAPIKey) == "" {
+			// A bearer token replaces the API key of the service key.
+			config.APIKey = ""
+		}
+	}
+	if value := os.Getenv(EnvBrokers); value != "" {
+		config.Brokers = nil
+		for _, broker := range strings.Split(value, ",") {
+			if broker = strings.TrimSpace(broker); broker != "" {
+				config.Brokers = append(config.Brokers, broker)
+			}
+		}
+	}
+
+	err = config.Validate()
+	if err != nil {
+		config = nil
+	}
+	return
+}
+
+// Validate checks that the configuration has an admin REST API URL and either an API key or a bearer token, but not
+// both.
+func (config *Config) Validate() (err error) {
+	switch {
+	case config.AdminURL == "":
+		err = core.SDKErrorf(nil, "no admin REST API URL is configured", "invalid-config", common.GetComponentInfo())
+	case config.APIKey == "" && config.BearerToken == "":
+		err = core.SDKErrorf(nil, "either an API key or a bearer token must be configured", "invalid-config", common.GetComponentInfo())
+	case config.APIKey != "" && config.BearerToken != "":
+		err = core.SDKErrorf(nil, "either an API key or a bearer token must be configured, not both", "invalid-config", common.GetComponentInfo())
+	case config.AuthType != "" && config.AuthType != core.AUTHTYPE_BASIC && config.AuthType != core.AUTHTYPE_IAM:
+		err = core.SDKErrorf(nil, fmt.Sprintf("unsupported auth type '%s'", config.AuthType), "invalid-config", common.GetComponentInfo())
+	}
+	return
+}
+
+// Authenticator returns a new authenticator for the configured credentials.
+func (config *Config) Authenticator() (authenticator core.Authenticator, err error) {
+	err = config.Validate()
+	if err != nil {
+		return
+	}
+	switch {
+	case config.BearerToken != "":
+		authenticator, err = core.NewBearerTokenAuthenticator(config.BearerToken)
+	case config.AuthType == core.AUTHTYPE_IAM:
+		authenticator, err = core.NewIamAuthenticatorBuilder().SetApiKey(config.APIKey).Build()
+	default:
+		authenticator, err = core.NewBasicAuthenticator("token", config.APIKey)
+	}
+	if err != nil {
+		err = core.SDKErrorf(err, "", "authenticator-error", common.GetComponentInfo())
+	}
+	return
+}
+
+// NewAdminrestV1 builds an admin REST API client with a new authenticator.
+func (config *Config) NewAdminrestV1() (adminrest *adminrestv1.AdminrestV1, err error) {
+	authenticator, err := config.Authenticator()
+	if err != nil {
+		return
+	}
+	return config.newAdminrestV1(authenticator)
+}
+
+// NewSchemaregistryV1 builds a schema registry client with a new authenticator.
+func (config *Config) NewSchemaregistryV1() (registry *schemaregistryv1.SchemaregistryV1, err error) {
+	authenticator, err := config.Authenticator()
+	if err != nil {
+		return
+	}
+	return config.newSchemaregistryV1(authenticator)
+}
+
+// NewClients builds an admin REST API client and a schema registry client that share one authenticator, so that
+// IAM tokens are requested once for both.
+func (config *Config) NewClients() (adminrest *adminrestv1.AdminrestV1, registry *schemaregistryv1.SchemaregistryV1, err error) {
+	authenticator, err := config.Authenticator()
+	if err != nil {
+		return
+	}
+	adminrest, err = config.newAdminrestV1(authenticator)
+	if err != nil {
+		return
+	}
+	registry, err = config.newSchemaregistryV1(authenticator)
+	if err != nil {
+		adminrest = nil
+	}
+	return
+}
+
+func (config *Config) newAdminrestV1(authenticator core.Authenticator) (adminrest *adminrestv1.AdminrestV1, err error) {
+	adminrest, err = adminrestv1.NewAdminrestV1(&adminrestv1.AdminrestV1Options{
+		URL:           config.AdminURL,
+		Authenticator: authenticator,
+	})
+	if err != nil {
+		return
+	}
+	config.configureService(adminrest.Service)
+	adminrest.SetOperationTimeouts(config.OperationTimeouts)
+	return
+}
+
+func (config *Config) newSchemaregistryV1(authenticator core.Authenticator) (registry *schemaregistryv1.SchemaregistryV1, err error) {
+	url := config.SchemaRegistryURL
+	if url == "" {
+		url = config.AdminURL
+	}
+	registry, err = schemaregistryv1.NewSchemaregistryV1(&schemaregistryv1.SchemaregistryV1Options{
+		URL:           url,
+		Authenticator: authenticator,
+	})
+	if err != nil {
+		return
+	}
+	config.configureService(registry.Service)
+	registry.SetOperationTimeouts(config.OperationTimeouts)
+	return
+}
+
+// configureService applies the timeout and retries of the configuration to a client.
+func (config *Config) configureService(service *core.BaseService) {
+	if config.Timeout > 0 {
+		client := http.Client{}
+		if current := service.GetHTTPClient(); current != nil {
+			client = *current
+		}
+		client.Timeout = config.Timeout
+		service.SetHTTPClient(&client)
+	}
+	if config.MaxRetries > 0 {
+		service.EnableRetries(config.MaxRetries, config.MaxRetryInterval)
+	}
+}
@@ -0,0 +1,172 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package config
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	common "github.com/IBM/eventstreams-go-sdk/pkg/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/stretchr/testify/assert"
+)
+
+const credentials = `{
+	"api_key": "key-1",
+	"apikey": "key-1",
+	"instance_id": "1234",
+	"kafka_admin_url": "https://admin.example.com",
+	"kafka_brokers_sasl": ["broker-0.example.com:9093", "broker-1.example.com:9093"],
+	"user": "token"
+}`
+
+func TestParseServiceKey(t *testing.T) {
+	expected := &Config{
+		AdminURL: "https://admin.example.com",
+		APIKey:   "key-1",
+		Brokers:  []string{"broker-0.example.com:9093", "broker-1.example.com:9093"},
+	}
+	for _, data := range []string{
+		credentials,
+		`{"name": "key", "credentials": ` + credentials + `}`,
+		` [{"name": "key", "credentials": ` + credentials + `}]`,
+	} {
+		config, err := ParseServiceKey([]byte(data))
+		assert.Nil(t, err)
+		assert.Equal(t, expected, config)
+	}
+
+	config, err := ParseServiceKey([]byte(`{"kafka_admin_url": "https://admin", "apikey": "key-2", "schema_registry_url": "https://registry"}`))
+	assert.Nil(t, err)
+	assert.Equal(t, "key-2", config.APIKey)
+	assert.Equal(t, "https://registry", config.SchemaRegistryURL)
+
+	for _, data := range []string{`[]`, `{"api_key": "key"}`, `not json`} {
+		_, err = ParseServiceKey([]byte(data))
+		assert.NotNil(t, err, data)
+	}
+}
+
+func TestFromEnvironment(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "key.json")
+	assert.Nil(t, os.WriteFile(path, []byte(credentials), 0600))
+
+	t.Setenv(EnvServiceKeyFile, path)
+	t.Setenv(EnvBrokers, " broker-2.example.com:9093, ")
+	config, err := FromEnvironment()
+	assert.Nil(t, err)
+	assert.Equal(t, "https://admin.example.com", config.AdminURL)
+	assert.Equal(t, "key-1", config.APIKey)
+	assert.Equal(t, []string{"broker-2.example.com:9093"}, config.Brokers)
+
+	t.Setenv(EnvBearerToken, "token-1")
+	config, err = FromEnvironment()
+	assert.Nil(t, err)
+	assert.Equal(t, "", config.APIKey)
+	assert.Equal(t, "token-1", config.BearerToken)
+
+	t.Setenv(EnvAPIKey, "key-2")
+	_, err = FromEnvironment()
+	assert.NotNil(t, err)
+
+	t.Setenv(EnvServiceKeyFile, "")
+	t.Setenv(EnvServiceKey, `{"credentials": `+credentials+`}`)
+	t.Setenv(EnvBearerToken, "")
+	t.Setenv(EnvAdminURL, "https://other.example.com")
+	config, err = FromEnvironment()
+	assert.Nil(t, err)
+	assert.Equal(t, "https://other.example.com", config.AdminURL)
+	assert.Equal(t, "key-2", config.APIKey)
+
+	t.Setenv(EnvServiceKey, "")
+	t.Setenv(EnvAdminURL, "")
+	_, err = FromEnvironment()
+	assert.NotNil(t, err)
+}
+
+func TestValidate(t *testing.T) {
+	assert.NotNil(t, (&Config{APIKey: "key"}).Validate())
+	assert.NotNil(t, (&Config{AdminURL: "https://admin"}).Validate())
+	assert.NotNil(t, (&Config{AdminURL: "https://admin", APIKey: "key", AuthType: core.AUTHTYPE_CP4D}).Validate())
+	assert.Nil(t, (&Config{AdminURL: "https://admin", APIKey: "key", AuthType: core.AUTHTYPE_IAM}).Validate())
+
+	authenticator, err := (&Config{AdminURL: "https://admin", APIKey: "key", AuthType: core.AUTHTYPE_IAM}).Authenticator()
+	assert.Nil(t, err)
+	assert.Equal(t, core.AUTHTYPE_IAM, authenticator.AuthenticationType())
+	authenticator, err = (&Config{AdminURL: "https://admin", BearerToken: "token"}).Authenticator()
+	assert.Nil(t, err)
+	assert.Equal(t, core.AUTHTYPE_BEARER_TOKEN, authenticator.AuthenticationType())
+}
+
+func TestNewClients(t *testing.T) {
+	var requests []string
+	failures := 1
+	server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		username, password, _ := req.BasicAuth()
+		requests = append(requests, fmt.Sprintf("%s %s:%s", req.URL.EscapedPath(), username, password))
+		res.Header().Set("Content-type", "application/json")
+		if failures > 0 {
+			failures--
+			res.WriteHeader(503)
+			return
+		}
+		res.WriteHeader(200)
+		fmt.Fprint(res, `{"status": "available", "type": "COMPATIBILITY", "config": "BACKWARD"}`)
+	}))
+	defer server.Close()
+
+	config := &Config{
+		AdminURL:         server.URL,
+		APIKey:           "key-1",
+		MaxRetries:       2,
+		MaxRetryInterval: time.Millisecond,
+		Timeout:          5 * time.Second,
+		OperationTimeouts: common.OperationTimeouts{
+			Read:  10 * time.Second,
+			Write: 20 * time.Second,
+		},
+	}
+	adminrest, registry, err := config.NewClients()
+	assert.Nil(t, err)
+	assert.Same(t, adminrest.Service.Options.Authenticator, registry.Service.Options.Authenticator)
+	assert.Equal(t, 5*time.Second, adminrest.Service.GetHTTPClient().Timeout)
+	assert.Equal(t, 5*time.Second, registry.Service.GetHTTPClient().Timeout)
+	assert.Equal(t, server.URL, registry.GetServiceURL())
+	assert.Equal(t, config.OperationTimeouts, adminrest.GetOperationTimeouts())
+	assert.Equal(t, config.OperationTimeouts, registry.GetOperationTimeouts())
+
+	_, _, err = adminrest.GetStatus(adminrest.NewGetStatusOptions())
+	assert.Nil(t, err)
+	_, _, err = registry.GetGlobalRule(registry.NewGetGlobalRuleOptions("COMPATIBILITY"))
+	assert.Nil(t, err)
+	assert.Equal(t, []string{
+		"/admin/status token:key-1",
+		"/admin/status token:key-1",
+		"/rules/COMPATIBILITY token:key-1",
+	}, requests)
+
+	_, err = (&Config{AdminURL: server.URL}).NewAdminrestV1()
+	assert.NotNil(t, err)
+	registry, err = (&Config{AdminURL: server.URL, SchemaRegistryURL: "https://registry.example.com", BearerToken: "token"}).NewSchemaregistryV1()
+	assert.Nil(t, err)
+	assert.Equal(t, "https://registry.example.com", registry.GetServiceURL())
+}
@@ -0,0 +1,2176 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package confluentregistryv1 : Operations and models for the ConfluentregistryV1 service
+//
+// The Confluent-compatible schema registry API is served by Event Streams instances on the Enterprise plan, at the
+// `/confluent` path of the schema registry endpoint. It uses the same credentials as the SchemaregistryV1 service.
+package confluentregistryv1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"time"
+
+	common "github.com/IBM/eventstreams-go-sdk/pkg/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// ConfluentregistryV1 : IBM Event Streams Confluent-compatible schema registry API
+//
+// API Version: 1.0.0
+type ConfluentregistryV1 struct {
+	Service *core.BaseService
+
+	// The default timeouts of the operations, applied when their context has no deadline.
+	timeouts common.OperationTimeouts
+}
+
+// DefaultServiceName is the default key used to find external configuration information.
+const DefaultServiceName = "confluentregistry"
+
+// The media type sent and accepted by the Confluent-compatible schema registry API.
+const (
+	acceptHeader      = "application/vnd.schemaregistry.v1+json, application/json"
+	contentTypeHeader = "application/vnd.schemaregistry.v1+json"
+)
+
+// ConfluentregistryV1Options : Service options
+type ConfluentregistryV1Options struct {
+	ServiceName   string
+	URL           string
+	Authenticator core.Authenticator
+}
+
+// NewConfluentregistryV1UsingExternalConfig : constructs an instance of ConfluentregistryV1 with passed in options and external configuration.
+func NewConfluentregistryV1UsingExternalConfig(options *ConfluentregistryV1Options) (confluentregistry *ConfluentregistryV1, err error) {
+	if options.ServiceName == "" {
+		options.ServiceName = DefaultServiceName
+	}
+
+	if options.Authenticator == nil {
+		options.Authenticator, err = core.GetAuthenticatorFromEnvironment(options.ServiceName)
+		if err != nil {
+			err = core.SDKErrorf(err, "", "env-auth-error", common.GetComponentInfo())
+			return
+		}
+	}
+
+	confluentregistry, err = NewConfluentregistryV1(options)
+	err = core.RepurposeSDKProblem(err, "new-client-error")
+	if err != nil {
+		return
+	}
+
+	err = confluentregistry.Service.ConfigureService(options.ServiceName)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "client-config-error", common.GetComponentInfo())
+		return
+	}
+
+	if options.URL != "" {
+		err = confluentregistry.Service.SetServiceURL(options.URL)
+		err = core.RepurposeSDKProblem(err, "url-set-error")
+	}
+	return
+}
+
+// NewConfluentregistryV1 : constructs an instance of ConfluentregistryV1 with passed in options.
+func NewConfluentregistryV1(options *ConfluentregistryV1Options) (service *ConfluentregistryV1, err error) {
+	serviceOptions := &core.ServiceOptions{
+		Authenticator: options.Authenticator,
+	}
+
+	baseService, err := core.NewBaseService(serviceOptions)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "new-base-error", common.GetComponentInfo())
+		return
+	}
+
+	if options.URL != "" {
+		err = baseService.SetServiceURL(options.URL)
+		if err != nil {
+			err = core.SDKErrorf(err, "", "set-url-error", common.GetComponentInfo())
+			return
+		}
+	}
+
+	service = &ConfluentregistryV1{
+		Service: baseService,
+	}
+
+	return
+}
+
+// GetServiceURLForRegion returns the service URL to be used for the specified region
+func GetServiceURLForRegion(region string) (string, error) {
+	return "", core.SDKErrorf(nil, "service does not support regional URLs", "no-regional-support", common.GetComponentInfo())
+}
+
+// Clone makes a copy of "confluentregistry" suitable for processing requests.
+func (confluentregistry *ConfluentregistryV1) Clone() *ConfluentregistryV1 {
+	if core.IsNil(confluentregistry) {
+		return nil
+	}
+	clone := *confluentregistry
+	clone.Service = confluentregistry.Service.Clone()
+	return &clone
+}
+
+// SetServiceURL sets the service URL
+func (confluentregistry *ConfluentregistryV1) SetServiceURL(url string) error {
+	err := confluentregistry.Service.SetServiceURL(url)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "url-set-error", common.GetComponentInfo())
+	}
+	return err
+}
+
+// GetServiceURL returns the service URL
+func (confluentregistry *ConfluentregistryV1) GetServiceURL() string {
+	return confluentregistry.Service.GetServiceURL()
+}
+
+// SetDefaultHeaders sets HTTP headers to be sent in every request
+func (confluentregistry *ConfluentregistryV1) SetDefaultHeaders(headers http.Header) {
+	confluentregistry.Service.SetDefaultHeaders(headers)
+}
+
+// SetEnableGzipCompression sets the service's EnableGzipCompression field
+func (confluentregistry *ConfluentregistryV1) SetEnableGzipCompression(enableGzip bool) {
+	confluentregistry.Service.SetEnableGzipCompression(enableGzip)
+}
+
+// GetEnableGzipCompression returns the service's EnableGzipCompression field
+func (confluentregistry *ConfluentregistryV1) GetEnableGzipCompression() bool {
+	return confluentregistry.Service.GetEnableGzipCompression()
+}
+
+// EnableRetries enables automatic retries for requests invoked for this service instance.
+// If either parameter is specified as 0, then a default value is used instead.
+func (confluentregistry *ConfluentregistryV1) EnableRetries(maxRetries int, maxRetryInterval time.Duration) {
+	confluentregistry.Service.EnableRetries(maxRetries, maxRetryInterval)
+}
+
+// DisableRetries disables automatic retries for requests invoked for this service instance.
+func (confluentregistry *ConfluentregistryV1) DisableRetries() {
+	confluentregistry.Service.DisableRetries()
+}
+
+// SetOperationTimeouts sets the default timeouts of the operations of the service, by operation class. The timeouts
+// apply to operations whose context has no deadline, including those called without a context.
+func (confluentregistry *ConfluentregistryV1) SetOperationTimeouts(timeouts common.OperationTimeouts) {
+	confluentregistry.timeouts = timeouts
+}
+
+// GetOperationTimeouts returns the default timeouts of the operations of the service.
+func (confluentregistry *ConfluentregistryV1) GetOperationTimeouts() common.OperationTimeouts {
+	return confluentregistry.timeouts
+}
+
+// operationContext returns the context of an operation of class, bounded by the default timeout of the class.
+func (confluentregistry *ConfluentregistryV1) operationContext(ctx context.Context, class string) (context.Context, context.CancelFunc) {
+	return common.OperationContext(ctx, confluentregistry.timeouts, class)
+}
+
+// ListSubjects : List subjects
+// Returns the names of all of the subjects that have at least one registered schema version.
+func (confluentregistry *ConfluentregistryV1) ListSubjects(listSubjectsOptions *ListSubjectsOptions) (result []string, response *core.DetailedResponse, err error) {
+	result, response, err = confluentregistry.ListSubjectsWithContext(context.Background(), listSubjectsOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// ListSubjectsWithContext is an alternate form of the ListSubjects method which supports a Context parameter
+func (confluentregistry *ConfluentregistryV1) ListSubjectsWithContext(ctx context.Context, listSubjectsOptions *ListSubjectsOptions) (result []string, response *core.DetailedResponse, err error) {
+	ctx, cancel := confluentregistry.operationContext(ctx, common.OperationClassRead)
+	defer cancel()
+
+	err = core.ValidateStruct(listSubjectsOptions, "listSubjectsOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+
+	builder := core.NewRequestBuilder(core.GET)
+	builder = builder.WithContext(ctx)
+	builder.EnableGzipCompression = confluentregistry.GetEnableGzipCompression()
+	_, err = builder.ResolveRequestURL(confluentregistry.Service.Options.URL, `/subjects`, nil)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "url-resolve-error", common.GetComponentInfo())
+		return
+	}
+
+	for headerName, headerValue := range listSubjectsOptions.Headers {
+		builder.AddHeader(headerName, headerValue)
+	}
+
+	sdkHeaders := common.GetSdkHeaders("confluentregistry", "V1", "ListSubjects")
+	for headerName, headerValue := range sdkHeaders {
+		builder.AddHeader(headerName, headerValue)
+	}
+	builder.AddHeader("Accept", acceptHeader)
+
+	if listSubjectsOptions.Deleted != nil {
+		builder.AddQuery("deleted", fmt.Sprint(*listSubjectsOptions.Deleted))
+	}
+
+	request, err := builder.Build()
+	if err != nil {
+		err = core.SDKErrorf(err, "", "build-error", common.GetComponentInfo())
+		return
+	}
+
+	response, err = confluentregistry.Service.Request(request, &result)
+	if err != nil {
+		core.EnrichHTTPProblem(err, "listSubjects", getServiceComponentInfo())
+		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		return
+	}
+
+	return
+}
+
+// ListSubjectVersions : List the versions of a subject
+// Returns the version numbers of all of the schema versions registered under the subject.
+func (confluentregistry *ConfluentregistryV1) ListSubjectVersions(listSubjectVersionsOptions *ListSubjectVersionsOptions) (result []int64, response *core.DetailedResponse, err error) {
+	result, response, err = confluentregistry.ListSubjectVersionsWithContext(context.Background(), listSubjectVersionsOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// ListSubjectVersionsWithContext is an alternate form of the ListSubjectVersions method which supports a Context parameter
+func (confluentregistry *ConfluentregistryV1) ListSubjectVersionsWithContext(ctx context.Context, listSubjectVersionsOptions *ListSubjectVersionsOptions) (result []int64, response *core.DetailedResponse, err error) {
+	ctx, cancel := confluentregistry.operationContext(ctx, common.OperationClassRead)
+	defer cancel()
+
+	err = core.ValidateNotNil(listSubjectVersionsOptions, "listSubjectVersionsOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(listSubjectVersionsOptions, "listSubjectVersionsOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+
+	pathParamsMap := map[string]string{
+		"subject": *listSubjectVersionsOptions.Subject,
+	}
+
+	builder := core.NewRequestBuilder(core.GET)
+	builder = builder.WithContext(ctx)
+	builder.EnableGzipCompression = confluentregistry.GetEnableGzipCompression()
+	_, err = builder.ResolveRequestURL(confluentregistry.Service.Options.URL, `/subjects/{subject}/versions`, pathParamsMap)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "url-resolve-error", common.GetComponentInfo())
+		return
+	}
+
+	for headerName, headerValue := range listSubjectVersionsOptions.Headers {
+		builder.AddHeader(headerName, headerValue)
+	}
+
+	sdkHeaders := common.GetSdkHeaders("confluentregistry", "V1", "ListSubjectVersions")
+	for headerName, headerValue := range sdkHeaders {
+		builder.AddHeader(headerName, headerValue)
+	}
+	builder.AddHeader("Accept", acceptHeader)
+
+	if listSubjectVersionsOptions.Deleted != nil {
+		builder.AddQuery("deleted", fmt.Sprint(*listSubjectVersionsOptions.Deleted))
+	}
+
+	request, err := builder.Build()
+	if err != nil {
+		err = core.SDKErrorf(err, "", "build-error", common.GetComponentInfo())
+		return
+	}
+
+	response, err = confluentregistry.Service.Request(request, &result)
+	if err != nil {
+		core.EnrichHTTPProblem(err, "listSubjectVersions", getServiceComponentInfo())
+		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		return
+	}
+
+	return
+}
+
+// GetSubjectVersion : Get a version of a subject
+// Retrieve a schema version registered under the subject. The version is either a version number or `latest`.
+func (confluentregistry *ConfluentregistryV1) GetSubjectVersion(getSubjectVersionOptions *GetSubjectVersionOptions) (result *Schema, response *core.DetailedResponse, err error) {
+	result, response, err = confluentregistry.GetSubjectVersionWithContext(context.Background(), getSubjectVersionOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetSubjectVersionWithContext is an alternate form of the GetSubjectVersion method which supports a Context parameter
+func (confluentregistry *ConfluentregistryV1) GetSubjectVersionWithContext(ctx context.Context, getSubjectVersionOptions *GetSubjectVersionOptions) (result *Schema, response *core.DetailedResponse, err error) {
+	ctx, cancel := confluentregistry.operationContext(ctx, common.OperationClassRead)
+	defer cancel()
+
+	err = core.ValidateNotNil(getSubjectVersionOptions, "getSubjectVersionOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(getSubjectVersionOptions, "getSubjectVersionOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+
+	pathParamsMap := map[string]string{
+		"subject": *getSubjectVersionOptions.Subject,
+		"version": *getSubjectVersionOptions.Version,
+	}
+
+	builder := core.NewRequestBuilder(core.GET)
+	builder = builder.WithContext(ctx)
+	builder.EnableGzipCompression = confluentregistry.GetEnableGzipCompression()
+	_, err = builder.ResolveRequestURL(confluentregistry.Service.Options.URL, `/subjects/{subject}/versions/{version}`, pathParamsMap)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "url-resolve-error", common.GetComponentInfo())
+		return
+	}
+
+	for headerName, headerValue := range getSubjectVersionOptions.Headers {
+		builder.AddHeader(headerName, headerValue)
+	}
+
+	sdkHeaders := common.GetSdkHeaders("confluentregistry", "V1", "GetSubjectVersion")
+	for headerName, headerValue := range sdkHeaders {
+		builder.AddHeader(headerName, headerValue)
+	}
+	builder.AddHeader("Accept", acceptHeader)
+
+	request, err := builder.Build()
+	if err != nil {
+		err = core.SDKErrorf(err, "", "build-error", common.GetComponentInfo())
+		return
+	}
+
+	var rawResponse map[string]json.RawMessage
+	response, err = confluentregistry.Service.Request(request, &rawResponse)
+	if err != nil {
+		core.EnrichHTTPProblem(err, "getSubjectVersion", getServiceComponentInfo())
+		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		return
+	}
+	if rawResponse != nil {
+		err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalSchema)
+		if err != nil {
+			err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+			return
+		}
+		response.Result = result
+	}
+
+	return
+}
+
+// RegisterSchema : Register a schema under a subject
+// Registers the schema as a new version of the subject. If an identical schema is already registered under the subject,
+// the ID of the existing schema is returned.
+func (confluentregistry *ConfluentregistryV1) RegisterSchema(registerSchemaOptions *RegisterSchemaOptions) (result *RegisteredSchema, response *core.DetailedResponse, err error) {
+	result, response, err = confluentregistry.RegisterSchemaWithContext(context.Background(), registerSchemaOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// RegisterSchemaWithContext is an alternate form of the RegisterSchema method which supports a Context parameter
+func (confluentregistry *ConfluentregistryV1) RegisterSchemaWithContext(ctx context.Context, registerSchemaOptions *RegisterSchemaOptions) (result *RegisteredSchema, response *core.DetailedResponse, err error) {
+	ctx, cancel := confluentregistry.operationContext(ctx, common.OperationClassWrite)
+	defer cancel()
+
+	err = core.ValidateNotNil(registerSchemaOptions, "registerSchemaOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(registerSchemaOptions, "registerSchemaOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+
+	pathParamsMap := map[string]string{
+		"subject": *registerSchemaOptions.Subject,
+	}
+
+	builder := core.NewRequestBuilder(core.POST)
+	builder = builder.WithContext(ctx)
+	builder.EnableGzipCompression = confluentregistry.GetEnableGzipCompression()
+	_, err = builder.ResolveRequestURL(confluentregistry.Service.Options.URL, `/subjects/{subject}/versions`, pathParamsMap)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "url-resolve-error", common.GetComponentInfo())
+		return
+	}
+
+	for headerName, headerValue := range registerSchemaOptions.Headers {
+		builder.AddHeader(headerName, headerValue)
+	}
+
+	sdkHeaders := common.GetSdkHeaders("confluentregistry", "V1", "RegisterSchema")
+	for headerName, headerValue := range sdkHeaders {
+		builder.AddHeader(headerName, headerValue)
+	}
+	builder.AddHeader("Accept", acceptHeader)
+	builder.AddHeader("Content-Type", contentTypeHeader)
+
+	body := make(map[string]interface{})
+	if registerSchemaOptions.Schema != nil {
+		body["schema"] = registerSchemaOptions.Schema
+	}
+	if registerSchemaOptions.SchemaType != nil {
+		body["schemaType"] = registerSchemaOptions.SchemaType
+	}
+	if registerSchemaOptions.References != nil {
+		body["references"] = registerSchemaOptions.References
+	}
+	_, err = builder.SetBodyContentJSON(body)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "set-json-body-error", common.GetComponentInfo())
+		return
+	}
+
+	request, err := builder.Build()
+	if err != nil {
+		err = core.SDKErrorf(err, "", "build-error", common.GetComponentInfo())
+		return
+	}
+
+	var rawResponse map[string]json.RawMessage
+	response, err = confluentregistry.Service.Request(request, &rawResponse)
+	if err != nil {
+		core.EnrichHTTPProblem(err, "registerSchema", getServiceComponentInfo())
+		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		return
+	}
+	if rawResponse != nil {
+		err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalRegisteredSchema)
+		if err != nil {
+			err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+			return
+		}
+		response.Result = result
+	}
+
+	return
+}
+
+// LookupSchema : Look up a schema under a subject
+// Checks whether the schema has been registered under the subject and returns the matching version.
+func (confluentregistry *ConfluentregistryV1) LookupSchema(lookupSchemaOptions *LookupSchemaOptions) (result *Schema, response *core.DetailedResponse, err error) {
+	result, response, err = confluentregistry.LookupSchemaWithContext(context.Background(), lookupSchemaOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// LookupSchemaWithContext is an alternate form of the LookupSchema method which supports a Context parameter
+func (confluentregistry *ConfluentregistryV1) LookupSchemaWithContext(ctx context.Context, lookupSchemaOptions *LookupSchemaOptions) (result *Schema, response *core.DetailedResponse, err error) {
+	ctx, cancel := confluentregistry.operationContext(ctx, common.OperationClassRead)
+	defer cancel()
+
+	err = core.ValidateNotNil(lookupSchemaOptions, "lookupSchemaOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(lookupSchemaOptions, "lookupSchemaOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+
+	pathParamsMap := map[string]string{
+		"subject": *lookupSchemaOptions.Subject,
+	}
+
+	builder := core.NewRequestBuilder(core.POST)
+	builder = builder.WithContext(ctx)
+	builder.EnableGzipCompression = confluentregistry.GetEnableGzipCompression()
+	_, err = builder.ResolveRequestURL(confluentregistry.Service.Options.URL, `/subjects/{subject}`, pathParamsMap)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "url-resolve-error", common.GetComponentInfo())
+		return
+	}
+
+	for headerName, headerValue := range lookupSchemaOptions.Headers {
+		builder.AddHeader(headerName, headerValue)
+	}
+
+	sdkHeaders := common.GetSdkHeaders("confluentregistry", "V1", "LookupSchema")
+	for headerName, headerValue := range sdkHeaders {
+		builder.AddHeader(headerName, headerValue)
+	}
+	builder.AddHeader("Accept", acceptHeader)
+	builder.AddHeader("Content-Type", contentTypeHeader)
+
+	body := make(map[string]interface{})
+	if lookupSchemaOptions.Schema != nil {
+		body["schema"] = lookupSchemaOptions.Schema
+	}
+	if lookupSchemaOptions.SchemaType != nil {
+		body["schemaType"] = lookupSchemaOptions.SchemaType
+	}
+	if lookupSchemaOptions.References != nil {
+		body["references"] = lookupSchemaOptions.References
+	}
+	_, err = builder.SetBodyContentJSON(body)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "set-json-body-error", common.GetComponentInfo())
+		return
+	}
+
+	request, err := builder.Build()
+	if err != nil {
+		err = core.SDKErrorf(err, "", "build-error", common.GetComponentInfo())
+		return
+	}
+
+	var rawResponse map[string]json.RawMessage
+	response, err = confluentregistry.Service.Request(request, &rawResponse)
+	if err != nil {
+		core.EnrichHTTPProblem(err, "lookupSchema", getServiceComponentInfo())
+		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		return
+	}
+	if rawResponse != nil {
+		err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalSchema)
+		if err != nil {
+			err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+			return
+		}
+		response.Result = result
+	}
+
+	return
+}
+
+// DeleteSubject : Delete a subject
+// Deletes all of the versions registered under the subject and returns their version numbers.
+func (confluentregistry *ConfluentregistryV1) DeleteSubject(deleteSubjectOptions *DeleteSubjectOptions) (result []int64, response *core.DetailedResponse, err error) {
+	result, response, err = confluentregistry.DeleteSubjectWithContext(context.Background(), deleteSubjectOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// DeleteSubjectWithContext is an alternate form of the DeleteSubject method which supports a Context parameter
+func (confluentregistry *ConfluentregistryV1) DeleteSubjectWithContext(ctx context.Context, deleteSubjectOptions *DeleteSubjectOptions) (result []int64, response *core.DetailedResponse, err error) {
+	ctx, cancel := confluentregistry.operationContext(ctx, common.OperationClassWrite)
+	defer cancel()
+
+	err = core.ValidateNotNil(deleteSubjectOptions, "deleteSubjectOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(deleteSubjectOptions, "deleteSubjectOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+
+	pathParamsMap := map[string]string{
+		"subject": *deleteSubjectOptions.Subject,
+	}
+
+	builder := core.NewRequestBuilder(core.DELETE)
+	builder = builder.WithContext(ctx)
+	builder.EnableGzipCompression = confluentregistry.GetEnableGzipCompression()
+	_, err = builder.ResolveRequestURL(confluentregistry.Service.Options.URL, `/subjects/{subject}`, pathParamsMap)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "url-resolve-error", common.GetComponentInfo())
+		return
+	}
+
+	for headerName, headerValue := range deleteSubjectOptions.Headers {
+		builder.AddHeader(headerName, headerValue)
+	}
+
+	sdkHeaders := common.GetSdkHeaders("confluentregistry", "V1", "DeleteSubject")
+	for headerName, headerValue := range sdkHeaders {
+		builder.AddHeader(headerName, headerValue)
+	}
+	builder.AddHeader("Accept", acceptHeader)
+
+	if deleteSubjectOptions.Permanent != nil {
+		builder.AddQuery("permanent", fmt.Sprint(*deleteSubjectOptions.Permanent))
+	}
+
+	request, err := builder.Build()
+	if err != nil {
+		err = core.SDKErrorf(err, "", "build-error", common.GetComponentInfo())
+		return
+	}
+
+	response, err = confluentregistry.Service.Request(request, &result)
+	if err != nil {
+		core.EnrichHTTPProblem(err, "deleteSubject", getServiceComponentInfo())
+		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		return
+	}
+
+	return
+}
+
+// DeleteSubjectVersion : Delete a version of a subject
+// Deletes a schema version registered under the subject and returns its version number.
+func (confluentregistry *ConfluentregistryV1) DeleteSubjectVersion(deleteSubjectVersionOptions *DeleteSubjectVersionOptions) (result int64, response *core.DetailedResponse, err error) {
+	result, response, err = confluentregistry.DeleteSubjectVersionWithContext(context.Background(), deleteSubjectVersionOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// DeleteSubjectVersionWithContext is an alternate form of the DeleteSubjectVersion method which supports a Context parameter
+func (confluentregistry *ConfluentregistryV1) DeleteSubjectVersionWithContext(ctx context.Context, deleteSubjectVersionOptions *DeleteSubjectVersionOptions) (result int64, response *core.DetailedResponse, err error) {
+	ctx, cancel := confluentregistry.operationContext(ctx, common.OperationClassWrite)
+	defer cancel()
+
+	err = core.ValidateNotNil(deleteSubjectVersionOptions, "deleteSubjectVersionOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(deleteSubjectVersionOptions, "deleteSubjectVersionOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+
+	pathParamsMap := map[string]string{
+		"subject": *deleteSubjectVersionOptions.Subject,
+		"version": *deleteSubjectVersionOptions.Version,
+	}
+
+	builder := core.NewRequestBuilder(core.DELETE)
+	builder = builder.WithContext(ctx)
+	builder.EnableGzipCompression = confluentregistry.GetEnableGzipCompression()
+	_, err = builder.ResolveRequestURL(confluentregistry.Service.Options.URL, `/subjects/{subject}/versions/{version}`, pathParamsMap)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "url-resolve-error", common.GetComponentInfo())
+		return
+	}
+
+	for headerName, headerValue := range deleteSubjectVersionOptions.Headers {
+		builder.AddHeader(headerName, headerValue)
+	}
+
+	sdkHeaders := common.GetSdkHeaders("confluentregistry", "V1", "DeleteSubjectVersion")
+	for headerName, headerValue := range sdkHeaders {
+		builder.AddHeader(headerName, headerValue)
+	}
+	builder.AddHeader("Accept", acceptHeader)
+
+	if deleteSubjectVersionOptions.Permanent != nil {
+		builder.AddQuery("permanent", fmt.Sprint(*deleteSubjectVersionOptions.Permanent))
+	}
+
+	request, err := builder.Build()
+	if err != nil {
+		err = core.SDKErrorf(err, "", "build-error", common.GetComponentInfo())
+		return
+	}
+
+	response, err = confluentregistry.Service.Request(request, &result)
+	if err != nil {
+		core.EnrichHTTPProblem(err, "deleteSubjectVersion", getServiceComponentInfo())
+		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		return
+	}
+
+	return
+}
+
+// GetSchemaByID : Get a schema by ID
+// Retrieve the schema identified by the globally unique schema ID.
+func (confluentregistry *ConfluentregistryV1) GetSchemaByID(getSchemaByIDOptions *GetSchemaByIDOptions) (result *SchemaString, response *core.DetailedResponse, err error) {
+	result, response, err = confluentregistry.GetSchemaByIDWithContext(context.Background(), getSchemaByIDOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetSchemaByIDWithContext is an alternate form of the GetSchemaByID method which supports a Context parameter
+func (confluentregistry *ConfluentregistryV1) GetSchemaByIDWithContext(ctx context.Context, getSchemaByIDOptions *GetSchemaByIDOptions) (result *SchemaString, response *core.DetailedResponse, err error) {
+	ctx, cancel := confluentregistry.operationContext(ctx, common.OperationClassRead)
+	defer cancel()
+
+	err = core.ValidateNotNil(getSchemaByIDOptions, "getSchemaByIDOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(getSchemaByIDOptions, "getSchemaByIDOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+
+	pathParamsMap := map[string]string{
+		"id": fmt.Sprint(*getSchemaByIDOptions.ID),
+	}
+
+	builder := core.NewRequestBuilder(core.GET)
+	builder = builder.WithContext(ctx)
+	builder.EnableGzipCompression = confluentregistry.GetEnableGzipCompression()
+	_, err = builder.ResolveRequestURL(confluentregistry.Service.Options.URL, `/schemas/ids/{id}`, pathParamsMap)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "url-resolve-error", common.GetComponentInfo())
+		return
+	}
+
+	for headerName, headerValue := range getSchemaByIDOptions.Headers {
+		builder.AddHeader(headerName, headerValue)
+	}
+
+	sdkHeaders := common.GetSdkHeaders("confluentregistry", "V1", "GetSchemaByID")
+	for headerName, headerValue := range sdkHeaders {
+		builder.AddHeader(headerName, headerValue)
+	}
+	builder.AddHeader("Accept", acceptHeader)
+
+	request, err := builder.Build()
+	if err != nil {
+		err = core.SDKErrorf(err, "", "build-error", common.GetComponentInfo())
+		return
+	}
+
+	var rawResponse map[string]json.RawMessage
+	response, err = confluentregistry.Service.Request(request, &rawResponse)
+	if err != nil {
+		core.EnrichHTTPProblem(err, "getSchemaById", getServiceComponentInfo())
+		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		return
+	}
+	if rawResponse != nil {
+		err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalSchemaString)
+		if err != nil {
+			err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+			return
+		}
+		response.Result = result
+	}
+
+	return
+}
+
+// ListSchemaVersionsByID : List the subject versions of a schema
+// Returns the subject and version pairs under which the schema identified by the ID is registered.
+func (confluentregistry *ConfluentregistryV1) ListSchemaVersionsByID(listSchemaVersionsByIDOptions *ListSchemaVersionsByIDOptions) (result []SubjectVersion, response *core.DetailedResponse, err error) {
+	result, response, err = confluentregistry.ListSchemaVersionsByIDWithContext(context.Background(), listSchemaVersionsByIDOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// ListSchemaVersionsByIDWithContext is an alternate form of the ListSchemaVersionsByID method which supports a Context parameter
+func (confluentregistry *ConfluentregistryV1) ListSchemaVersionsByIDWithContext(ctx context.Context, listSchemaVersionsByIDOptions *ListSchemaVersionsByIDOptions) (result []SubjectVersion, response *core.DetailedResponse, err error) {
+	ctx, cancel := confluentregistry.operationContext(ctx, common.OperationClassRead)
+	defer cancel()
+
+	err = core.ValidateNotNil(listSchemaVersionsByIDOptions, "listSchemaVersionsByIDOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(listSchemaVersionsByIDOptions, "listSchemaVersionsByIDOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+
+	pathParamsMap := map[string]string{
+		"id": fmt.Sprint(*listSchemaVersionsByIDOptions.ID),
+	}
+
+	builder := core.NewRequestBuilder(core.GET)
+	builder = builder.WithContext(ctx)
+	builder.EnableGzipCompression = confluentregistry.GetEnableGzipCompression()
+	_, err = builder.ResolveRequestURL(confluentregistry.Service.Options.URL, `/schemas/ids/{id}/versions`, pathParamsMap)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "url-resolve-error", common.GetComponentInfo())
+		return
+	}
+
+	for headerName, headerValue := range listSchemaVersionsByIDOptions.Headers {
+		builder.AddHeader(headerName, headerValue)
+	}
+
+	sdkHeaders := common.GetSdkHeaders("confluentregistry", "V1", "ListSchemaVersionsByID")
+	for headerName, headerValue := range sdkHeaders {
+		builder.AddHeader(headerName, headerValue)
+	}
+	builder.AddHeader("Accept", acceptHeader)
+
+	request, err := builder.Build()
+	if err != nil {
+		err = core.SDKErrorf(err, "", "build-error", common.GetComponentInfo())
+		return
+	}
+
+	var rawResponse []json.RawMessage
+	response, err = confluentregistry.Service.Request(request, &rawResponse)
+	if err != nil {
+		core.EnrichHTTPProblem(err, "listSchemaVersionsById", getServiceComponentInfo())
+		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		return
+	}
+	if rawResponse != nil {
+		err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalSubjectVersion)
+		if err != nil {
+			err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+			return
+		}
+		response.Result = result
+	}
+
+	return
+}
+
+// TestCompatibility : Test schema compatibility
+// Tests whether the schema is compatible with a version of the subject, using the compatibility level configured for
+// the subject.
+func (confluentregistry *ConfluentregistryV1) TestCompatibility(testCompatibilityOptions *TestCompatibilityOptions) (result *CompatibilityCheck, response *core.DetailedResponse, err error) {
+	result, response, err = confluentregistry.TestCompatibilityWithContext(context.Background(), testCompatibilityOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// TestCompatibilityWithContext is an alternate form of the TestCompatibility method which supports a Context parameter
+func (confluentregistry *ConfluentregistryV1) TestCompatibilityWithContext(ctx context.Context, testCompatibilityOptions *TestCompatibilityOptions) (result *CompatibilityCheck, response *core.DetailedResponse, err error) {
+	ctx, cancel := confluentregistry.operationContext(ctx, common.OperationClassRead)
+	defer cancel()
+
+	err = core.ValidateNotNil(testCompatibilityOptions, "testCompatibilityOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(testCompatibilityOptions, "testCompatibilityOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+
+	pathParamsMap := map[string]string{
+		"subject": *testCompatibilityOptions.Subject,
+		"version": *testCompatibilityOptions.Version,
+	}
+
+	builder := core.NewRequestBuilder(core.POST)
+	builder = builder.WithContext(ctx)
+	builder.EnableGzipCompression = confluentregistry.GetEnableGzipCompression()
+	_, err = builder.ResolveRequestURL(confluentregistry.Service.Options.URL, `/compatibility/subjects/{subject}/versions/{version}`, pathParamsMap)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "url-resolve-error", common.GetComponentInfo())
+		return
+	}
+
+	for headerName, headerValue := range testCompatibilityOptions.Headers {
+		builder.AddHeader(headerName, headerValue)
+	}
+
+	sdkHeaders := common.GetSdkHeaders("confluentregistry", "V1", "TestCompatibility")
+	for headerName, headerValue := range sdkHeaders {
+		builder.AddHeader(headerName, headerValue)
+	}
+	builder.AddHeader("Accept", acceptHeader)
+	builder.AddHeader("Content-Type", contentTypeHeader)
+
+	if testCompatibilityOptions.Verbose != nil {
+		builder.AddQuery("verbose", fmt.Sprint(*testCompatibilityOptions.Verbose))
+	}
+
+	body := make(map[string]interface{})
+	if testCompatibilityOptions.Schema != nil {
+		body["schema"] = testCompatibilityOptions.Schema
+	}
+	if testCompatibilityOptions.SchemaType != nil {
+		body["schemaType"] = testCompatibilityOptions.SchemaType
+	}
+	if testCompatibilityOptions.References != nil {
+		body["references"] = testCompatibilityOptions.References
+	}
+	_, err = builder.SetBodyContentJSON(body)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "set-json-body-error", common.GetComponentInfo())
+		return
+	}
+
+	request, err := builder.Build()
+	if err != nil {
+		err = core.SDKErrorf(err, "", "build-error", common.GetComponentInfo())
+		return
+	}
+
+	var rawResponse map[string]json.RawMessage
+	response, err = confluentregistry.Service.Request(request, &rawResponse)
+	if err != nil {
+		core.EnrichHTTPProblem(err, "testCompatibility", getServiceComponentInfo())
+		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		return
+	}
+	if rawResponse != nil {
+		err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalCompatibilityCheck)
+		if err != nil {
+			err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+			return
+		}
+		response.Result = result
+	}
+
+	return
+}
+
+// GetGlobalConfig : Get the global compatibility level
+// Retrieve the compatibility level that applies to subjects without their own configuration.
+func (confluentregistry *ConfluentregistryV1) GetGlobalConfig(getGlobalConfigOptions *GetGlobalConfigOptions) (result *Config, response *core.DetailedResponse, err error) {
+	result, response, err = confluentregistry.GetGlobalConfigWithContext(context.Background(), getGlobalConfigOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetGlobalConfigWithContext is an alternate form of the GetGlobalConfig method which supports a Context parameter
+func (confluentregistry *ConfluentregistryV1) GetGlobalConfigWithContext(ctx context.Context, getGlobalConfigOptions *GetGlobalConfigOptions) (result *Config, response *core.DetailedResponse, err error) {
+	ctx, cancel := confluentregistry.operationContext(ctx, common.OperationClassRead)
+	defer cancel()
+
+	err = core.ValidateStruct(getGlobalConfigOptions, "getGlobalConfigOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+
+	builder := core.NewRequestBuilder(core.GET)
+	builder = builder.WithContext(ctx)
+	builder.EnableGzipCompression = confluentregistry.GetEnableGzipCompression()
+	_, err = builder.ResolveRequestURL(confluentregistry.Service.Options.URL, `/config`, nil)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "url-resolve-error", common.GetComponentInfo())
+		return
+	}
+
+	for headerName, headerValue := range getGlobalConfigOptions.Headers {
+		builder.AddHeader(headerName, headerValue)
+	}
+
+	sdkHeaders := common.GetSdkHeaders("confluentregistry", "V1", "GetGlobalConfig")
+	for headerName, headerValue := range sdkHeaders {
+		builder.AddHeader(headerName, headerValue)
+	}
+	builder.AddHeader("Accept", acceptHeader)
+
+	request, err := builder.Build()
+	if err != nil {
+		err = core.SDKErrorf(err, "", "build-error", common.GetComponentInfo())
+		return
+	}
+
+	var rawResponse map[string]json.RawMessage
+	response, err = confluentregistry.Service.Request(request, &rawResponse)
+	if err != nil {
+		core.EnrichHTTPProblem(err, "getGlobalConfig", getServiceComponentInfo())
+		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		return
+	}
+	if rawResponse != nil {
+		err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalConfig)
+		if err != nil {
+			err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+			return
+		}
+		response.Result = result
+	}
+
+	return
+}
+
+// UpdateGlobalConfig : Update the global compatibility level
+// Set the compatibility level that applies to subjects without their own configuration.
+func (confluentregistry *ConfluentregistryV1) UpdateGlobalConfig(updateGlobalConfigOptions *UpdateGlobalConfigOptions) (result *ConfigUpdate, response *core.DetailedResponse, err error) {
+	result, response, err = confluentregistry.UpdateGlobalConfigWithContext(context.Background(), updateGlobalConfigOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// UpdateGlobalConfigWithContext is an alternate form of the UpdateGlobalConfig method which supports a Context parameter
+func (confluentregistry *ConfluentregistryV1) UpdateGlobalConfigWithContext(ctx context.Context, updateGlobalConfigOptions *UpdateGlobalConfigOptions) (result *ConfigUpdate, response *core.DetailedResponse, err error) {
+	ctx, cancel := confluentregistry.operationContext(ctx, common.OperationClassWrite)
+	defer cancel()
+
+	err = core.ValidateNotNil(updateGlobalConfigOptions, "updateGlobalConfigOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(updateGlobalConfigOptions, "updateGlobalConfigOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+
+	builder := core.NewRequestBuilder(core.PUT)
+	builder = builder.WithContext(ctx)
+	builder.EnableGzipCompression = confluentregistry.GetEnableGzipCompression()
+	_, err = builder.ResolveRequestURL(confluentregistry.Service.Options.URL, `/config`, nil)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "url-resolve-error", common.GetComponentInfo())
+		return
+	}
+
+	for headerName, headerValue := range updateGlobalConfigOptions.Headers {
+		builder.AddHeader(headerName, headerValue)
+	}
+
+	sdkHeaders := common.GetSdkHeaders("confluentregistry", "V1", "UpdateGlobalConfig")
+	for headerName, headerValue := range sdkHeaders {
+		builder.AddHeader(headerName, headerValue)
+	}
+	builder.AddHeader("Accept", acceptHeader)
+	builder.AddHeader("Content-Type", contentTypeHeader)
+
+	body := make(map[string]interface{})
+	if updateGlobalConfigOptions.Compatibility != nil {
+		body["compatibility"] = updateGlobalConfigOptions.Compatibility
+	}
+	_, err = builder.SetBodyContentJSON(body)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "set-json-body-error", common.GetComponentInfo())
+		return
+	}
+
+	request, err := builder.Build()
+	if err != nil {
+		err = core.SDKErrorf(err, "", "build-error", common.GetComponentInfo())
+		return
+	}
+
+	var rawResponse map[string]json.RawMessage
+	response, err = confluentregistry.Service.Request(request, &rawResponse)
+	if err != nil {
+		core.EnrichHTTPProblem(err, "updateGlobalConfig", getServiceComponentInfo())
+		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		return
+	}
+	if rawResponse != nil {
+		err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalConfigUpdate)
+		if err != nil {
+			err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+			return
+		}
+		response.Result = result
+	}
+
+	return
+}
+
+// GetSubjectConfig : Get the compatibility level of a subject
+// Retrieve the compatibility level configured for the subject.
+func (confluentregistry *ConfluentregistryV1) GetSubjectConfig(getSubjectConfigOptions *GetSubjectConfigOptions) (result *Config, response *core.DetailedResponse, err error) {
+	result, response, err = confluentregistry.GetSubjectConfigWithContext(context.Background(), getSubjectConfigOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetSubjectConfigWithContext is an alternate form of the GetSubjectConfig method which supports a Context parameter
+func (confluentregistry *ConfluentregistryV1) GetSubjectConfigWithContext(ctx context.Context, getSubjectConfigOptions *GetSubjectConfigOptions) (result *Config, response *core.DetailedResponse, err error) {
+	ctx, cancel := confluentregistry.operationContext(ctx, common.OperationClassRead)
+	defer cancel()
+
+	err = core.ValidateNotNil(getSubjectConfigOptions, "getSubjectConfigOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(getSubjectConfigOptions, "getSubjectConfigOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+
+	pathParamsMap := map[string]string{
+		"subject": *getSubjectConfigOptions.Subject,
+	}
+
+	builder := core.NewRequestBuilder(core.GET)
+	builder = builder.WithContext(ctx)
+	builder.EnableGzipCompression = confluentregistry.GetEnableGzipCompression()
+	_, err = builder.ResolveRequestURL(confluentregistry.Service.Options.URL, `/config/{subject}`, pathParamsMap)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "url-resolve-error", common.GetComponentInfo())
+		return
+	}
+
+	for headerName, headerValue := range getSubjectConfigOptions.Headers {
+		builder.AddHeader(headerName, headerValue)
+	}
+
+	sdkHeaders := common.GetSdkHeaders("confluentregistry", "V1", "GetSubjectConfig")
+	for headerName, headerValue := range sdkHeaders {
+		builder.AddHeader(headerName, headerValue)
+	}
+	builder.AddHeader("Accept", acceptHeader)
+
+	if getSubjectConfigOptions.DefaultToGlobal != nil {
+		builder.AddQuery("defaultToGlobal", fmt.Sprint(*getSubjectConfigOptions.DefaultToGlobal))
+	}
+
+	request, err := builder.Build()
+	if err != nil {
+		err = core.SDKErrorf(err, "", "build-error", common.GetComponentInfo())
+		return
+	}
+
+	var rawResponse map[string]json.RawMessage
+	response, err = confluentregistry.Service.Request(request, &rawResponse)
+	if err != nil {
+		core.EnrichHTTPProblem(err, "getSubjectConfig", getServiceComponentInfo())
+		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		return
+	}
+	if rawResponse != nil {
+		err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalConfig)
+		if err != nil {
+			err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+			return
+		}
+		response.Result = result
+	}
+
+	return
+}
+
+// UpdateSubjectConfig : Update the compatibility level of a subject
+// Set the compatibility level of the subject, overriding the global compatibility level.
+func (confluentregistry *ConfluentregistryV1) UpdateSubjectConfig(updateSubjectConfigOptions *UpdateSubjectConfigOptions) (result *ConfigUpdate, response *core.DetailedResponse, err error) {
+	result, response, err = confluentregistry.UpdateSubjectConfigWithContext(context.Background(), updateSubjectConfigOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// UpdateSubjectConfigWithContext is an alternate form of the UpdateSubjectConfig method which supports a Context parameter
+func (confluentregistry *ConfluentregistryV1) UpdateSubjectConfigWithContext(ctx context.Context, updateSubjectConfigOptions *UpdateSubjectConfigOptions) (result *ConfigUpdate, response *core.DetailedResponse, err error) {
+	ctx, cancel := confluentregistry.operationContext(ctx, common.OperationClassWrite)
+	defer cancel()
+
+	err = core.ValidateNotNil(updateSubjectConfigOptions, "updateSubjectConfigOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(updateSubjectConfigOptions, "updateSubjectConfigOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+
+	pathParamsMap := map[string]string{
+		"subject": *updateSubjectConfigOptions.Subject,
+	}
+
+	builder := core.NewRequestBuilder(core.PUT)
+	builder = builder.WithContext(ctx)
+	builder.EnableGzipCompression = confluentregistry.GetEnableGzipCompression()
+	_, err = builder.ResolveRequestURL(confluentregistry.Service.Options.URL, `/config/{subject}`, pathParamsMap)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "url-resolve-error", common.GetComponentInfo())
+		return
+	}
+
+	for headerName, headerValue := range updateSubjectConfigOptions.Headers {
+		builder.AddHeader(headerName, headerValue)
+	}
+
+	sdkHeaders := common.GetSdkHeaders("confluentregistry", "V1", "UpdateSubjectConfig")
+	for headerName, headerValue := range sdkHeaders {
+		builder.AddHeader(headerName, headerValue)
+	}
+	builder.AddHeader("Accept", acceptHeader)
+	builder.AddHeader("Content-Type", contentTypeHeader)
+
+	body := make(map[string]interface{})
+	if updateSubjectConfigOptions.Compatibility != nil {
+		body["compatibility"] = updateSubjectConfigOptions.Compatibility
+	}
+	_, err = builder.SetBodyContentJSON(body)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "set-json-body-error", common.GetComponentInfo())
+		return
+	}
+
+	request, err := builder.Build()
+	if err != nil {
+		err = core.SDKErrorf(err, "", "build-error", common.GetComponentInfo())
+		return
+	}
+
+	var rawResponse map[string]json.RawMessage
+	response, err = confluentregistry.Service.Request(request, &rawResponse)
+	if err != nil {
+		core.EnrichHTTPProblem(err, "updateSubjectConfig", getServiceComponentInfo())
+		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		return
+	}
+	if rawResponse != nil {
+		err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalConfigUpdate)
+		if err != nil {
+			err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+			return
+		}
+		response.Result = result
+	}
+
+	return
+}
+
+// DeleteSubjectConfig : Delete the compatibility level of a subject
+// Removes the compatibility level configured for the subject so that the global compatibility level applies.
+func (confluentregistry *ConfluentregistryV1) DeleteSubjectConfig(deleteSubjectConfigOptions *DeleteSubjectConfigOptions) (result *Config, response *core.DetailedResponse, err error) {
+	result, response, err = confluentregistry.DeleteSubjectConfigWithContext(context.Background(), deleteSubjectConfigOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// DeleteSubjectConfigWithContext is an alternate form of the DeleteSubjectConfig method which supports a Context parameter
+func (confluentregistry *ConfluentregistryV1) DeleteSubjectConfigWithContext(ctx context.Context, deleteSubjectConfigOptions *DeleteSubjectConfigOptions) (result *Config, response *core.DetailedResponse, err error) {
+	ctx, cancel := confluentregistry.operationContext(ctx, common.OperationClassWrite)
+	defer cancel()
+
+	err = core.ValidateNotNil(deleteSubjectConfigOptions, "deleteSubjectConfigOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(deleteSubjectConfigOptions, "deleteSubjectConfigOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+
+	pathParamsMap := map[string]string{
+		"subject": *deleteSubjectConfigOptions.Subject,
+	}
+
+	builder := core.NewRequestBuilder(core.DELETE)
+	builder = builder.WithContext(ctx)
+	builder.EnableGzipCompression = confluentregistry.GetEnableGzipCompression()
+	_, err = builder.ResolveRequestURL(confluentregistry.Service.Options.URL, `/config/{subject}`, pathParamsMap)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "url-resolve-error", common.GetComponentInfo())
+		return
+	}
+
+	for headerName, headerValue := range deleteSubjectConfigOptions.Headers {
+		builder.AddHeader(headerName, headerValue)
+	}
+
+	sdkHeaders := common.GetSdkHeaders("confluentregistry", "V1", "DeleteSubjectConfig")
+	for headerName, headerValue := range sdkHeaders {
+		builder.AddHeader(headerName, headerValue)
+	}
+	builder.AddHeader("Accept", acceptHeader)
+
+	request, err := builder.Build()
+	if err != nil {
+		err = core.SDKErrorf(err, "", "build-error", common.GetComponentInfo())
+		return
+	}
+
+	var rawResponse map[string]json.RawMessage
+	response, err = confluentregistry.Service.Request(request, &rawResponse)
+	if err != nil {
+		core.EnrichHTTPProblem(err, "deleteSubjectConfig", getServiceComponentInfo())
+		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		return
+	}
+	if rawResponse != nil {
+		err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalConfig)
+		if err != nil {
+			err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+			return
+		}
+		response.Result = result
+	}
+
+	return
+}
+
+func getServiceComponentInfo() *core.ProblemComponent {
+	return core.NewProblemComponent(DefaultServiceName, "1.0.0")
+}
+
+// DeleteSubjectOptions : The DeleteSubject options.
+type DeleteSubjectOptions struct {
+	// The name of the subject.
+	Subject *string `json:"subject" validate:"required,ne="`
+
+	// When true, the subject is hard deleted. A subject must be soft deleted before it can be hard deleted.
+	Permanent *bool `json:"permanent,omitempty"`
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewDeleteSubjectOptions : Instantiate DeleteSubjectOptions
+func (*ConfluentregistryV1) NewDeleteSubjectOptions(subject string) *DeleteSubjectOptions {
+	return &DeleteSubjectOptions{
+		Subject: core.StringPtr(subject),
+	}
+}
+
+// SetSubject : Allow user to set Subject
+func (_options *DeleteSubjectOptions) SetSubject(subject string) *DeleteSubjectOptions {
+	_options.Subject = core.StringPtr(subject)
+	return _options
+}
+
+// SetPermanent : Allow user to set Permanent
+func (_options *DeleteSubjectOptions) SetPermanent(permanent bool) *DeleteSubjectOptions {
+	_options.Permanent = core.BoolPtr(permanent)
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *DeleteSubjectOptions) SetHeaders(param map[string]string) *DeleteSubjectOptions {
+	options.Headers = param
+	return options
+}
+
+// DeleteSubjectConfigOptions : The DeleteSubjectConfig options.
+type DeleteSubjectConfigOptions struct {
+	// The name of the subject.
+	Subject *string `json:"subject" validate:"required,ne="`
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewDeleteSubjectConfigOptions : Instantiate DeleteSubjectConfigOptions
+func (*ConfluentregistryV1) NewDeleteSubjectConfigOptions(subject string) *DeleteSubjectConfigOptions {
+	return &DeleteSubjectConfigOptions{
+		Subject: core.StringPtr(subject),
+	}
+}
+
+// SetSubject : Allow user to set Subject
+func (_options *DeleteSubjectConfigOptions) SetSubject(subject string) *DeleteSubjectConfigOptions {
+	_options.Subject = core.StringPtr(subject)
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *DeleteSubjectConfigOptions) SetHeaders(param map[string]string) *DeleteSubjectConfigOptions {
+	options.Headers = param
+	return options
+}
+
+// DeleteSubjectVersionOptions : The DeleteSubjectVersion options.
+type DeleteSubjectVersionOptions struct {
+	// The name of the subject.
+	Subject *string `json:"subject" validate:"required,ne="`
+
+	// The version number, or `latest` for the most recently registered version.
+	Version *string `json:"version" validate:"required,ne="`
+
+	// When true, the version is hard deleted. A version must be soft deleted before it can be hard deleted.
+	Permanent *bool `json:"permanent,omitempty"`
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewDeleteSubjectVersionOptions : Instantiate DeleteSubjectVersionOptions
+func (*ConfluentregistryV1) NewDeleteSubjectVersionOptions(subject string, version string) *DeleteSubjectVersionOptions {
+	return &DeleteSubjectVersionOptions{
+		Subject: core.StringPtr(subject),
+		Version: core.StringPtr(version),
+	}
+}
+
+// SetSubject : Allow user to set Subject
+func (_options *DeleteSubjectVersionOptions) SetSubject(subject string) *DeleteSubjectVersionOptions {
+	_options.Subject = core.StringPtr(subject)
+	return _options
+}
+
+// SetVersion : Allow user to set Version
+func (_options *DeleteSubjectVersionOptions) SetVersion(version string) *DeleteSubjectVersionOptions {
+	_options.Version = core.StringPtr(version)
+	return _options
+}
+
+// SetPermanent : Allow user to set Permanent
+func (_options *DeleteSubjectVersionOptions) SetPermanent(permanent bool) *DeleteSubjectVersionOptions {
+	_options.Permanent = core.BoolPtr(permanent)
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *DeleteSubjectVersionOptions) SetHeaders(param map[string]string) *DeleteSubjectVersionOptions {
+	options.Headers = param
+	return options
+}
+
+// GetGlobalConfigOptions : The GetGlobalConfig options.
+type GetGlobalConfigOptions struct {
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewGetGlobalConfigOptions : Instantiate GetGlobalConfigOptions
+func (*ConfluentregistryV1) NewGetGlobalConfigOptions() *GetGlobalConfigOptions {
+	return &GetGlobalConfigOptions{}
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *GetGlobalConfigOptions) SetHeaders(param map[string]string) *GetGlobalConfigOptions {
+	options.Headers = param
+	return options
+}
+
+// GetSchemaByIDOptions : The GetSchemaByID options.
+type GetSchemaByIDOptions struct {
+	// The globally unique ID of the schema.
+	ID *int64 `json:"id" validate:"required"`
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewGetSchemaByIDOptions : Instantiate GetSchemaByIDOptions
+func (*ConfluentregistryV1) NewGetSchemaByIDOptions(id int64) *GetSchemaByIDOptions {
+	return &GetSchemaByIDOptions{
+		ID: core.Int64Ptr(id),
+	}
+}
+
+// SetID : Allow user to set ID
+func (_options *GetSchemaByIDOptions) SetID(id int64) *GetSchemaByIDOptions {
+	_options.ID = core.Int64Ptr(id)
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *GetSchemaByIDOptions) SetHeaders(param map[string]string) *GetSchemaByIDOptions {
+	options.Headers = param
+	return options
+}
+
+// GetSubjectConfigOptions : The GetSubjectConfig options.
+type GetSubjectConfigOptions struct {
+	// The name of the subject.
+	Subject *string `json:"subject" validate:"required,ne="`
+
+	// When true, the global compatibility level is returned if the subject has no configuration of its own.
+	DefaultToGlobal *bool `json:"defaultToGlobal,omitempty"`
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewGetSubjectConfigOptions : Instantiate GetSubjectConfigOptions
+func (*ConfluentregistryV1) NewGetSubjectConfigOptions(subject string) *GetSubjectConfigOptions {
+	return &GetSubjectConfigOptions{
+		Subject: core.StringPtr(subject),
+	}
+}
+
+// SetSubject : Allow user to set Subject
+func (_options *GetSubjectConfigOptions) SetSubject(subject string) *GetSubjectConfigOptions {
+	_options.Subject = core.StringPtr(subject)
+	return _options
+}
+
+// SetDefaultToGlobal : Allow user to set DefaultToGlobal
+func (_options *GetSubjectConfigOptions) SetDefaultToGlobal(defaultToGlobal bool) *GetSubjectConfigOptions {
+	_options.DefaultToGlobal = core.BoolPtr(defaultToGlobal)
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *GetSubjectConfigOptions) SetHeaders(param map[string]string) *GetSubjectConfigOptions {
+	options.Headers = param
+	return options
+}
+
+// GetSubjectVersionOptions : The GetSubjectVersion options.
+type GetSubjectVersionOptions struct {
+	// The name of the subject.
+	Subject *string `json:"subject" validate:"required,ne="`
+
+	// The version number, or `latest` for the most recently registered version.
+	Version *string `json:"version" validate:"required,ne="`
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewGetSubjectVersionOptions : Instantiate GetSubjectVersionOptions
+func (*ConfluentregistryV1) NewGetSubjectVersionOptions(subject string, version string) *GetSubjectVersionOptions {
+	return &GetSubjectVersionOptions{
+		Subject: core.StringPtr(subject),
+		Version: core.StringPtr(version),
+	}
+}
+
+// SetSubject : Allow user to set Subject
+func (_options *GetSubjectVersionOptions) SetSubject(subject string) *GetSubjectVersionOptions {
+	_options.Subject = core.StringPtr(subject)
+	return _options
+}
+
+// SetVersion : Allow user to set Version
+func (_options *GetSubjectVersionOptions) SetVersion(version string) *GetSubjectVersionOptions {
+	_options.Version = core.StringPtr(version)
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *GetSubjectVersionOptions) SetHeaders(param map[string]string) *GetSubjectVersionOptions {
+	options.Headers = param
+	return options
+}
+
+// ListSchemaVersionsByIDOptions : The ListSchemaVersionsByID options.
+type ListSchemaVersionsByIDOptions struct {
+	// The globally unique ID of the schema.
+	ID *int64 `json:"id" validate:"required"`
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewListSchemaVersionsByIDOptions : Instantiate ListSchemaVersionsByIDOptions
+func (*ConfluentregistryV1) NewListSchemaVersionsByIDOptions(id int64) *ListSchemaVersionsByIDOptions {
+	return &ListSchemaVersionsByIDOptions{
+		ID: core.Int64Ptr(id),
+	}
+}
+
+// SetID : Allow user to set ID
+func (_options *ListSchemaVersionsByIDOptions) SetID(id int64) *ListSchemaVersionsByIDOptions {
+	_options.ID = core.Int64Ptr(id)
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *ListSchemaVersionsByIDOptions) SetHeaders(param map[string]string) *ListSchemaVersionsByIDOptions {
+	options.Headers = param
+	return options
+}
+
+// ListSubjectVersionsOptions : The ListSubjectVersions options.
+type ListSubjectVersionsOptions struct {
+	// The name of the subject.
+	Subject *string `json:"subject" validate:"required,ne="`
+
+	// When true, soft deleted versions are included.
+	Deleted *bool `json:"deleted,omitempty"`
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewListSubjectVersionsOptions : Instantiate ListSubjectVersionsOptions
+func (*ConfluentregistryV1) NewListSubjectVersionsOptions(subject string) *ListSubjectVersionsOptions {
+	return &ListSubjectVersionsOptions{
+		Subject: core.StringPtr(subject),
+	}
+}
+
+// SetSubject : Allow user to set Subject
+func (_options *ListSubjectVersionsOptions) SetSubject(subject string) *ListSubjectVersionsOptions {
+	_options.Subject = core.StringPtr(subject)
+	return _options
+}
+
+// SetDeleted : Allow user to set Deleted
+func (_options *ListSubjectVersionsOptions) SetDeleted(deleted bool) *ListSubjectVersionsOptions {
+	_options.Deleted = core.BoolPtr(deleted)
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *ListSubjectVersionsOptions) SetHeaders(param map[string]string) *ListSubjectVersionsOptions {
+	options.Headers = param
+	return options
+}
+
+// ListSubjectsOptions : The ListSubjects options.
+type ListSubjectsOptions struct {
+	// When true, subjects whose versions have all been soft deleted are included.
+	Deleted *bool `json:"deleted,omitempty"`
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewListSubjectsOptions : Instantiate ListSubjectsOptions
+func (*ConfluentregistryV1) NewListSubjectsOptions() *ListSubjectsOptions {
+	return &ListSubjectsOptions{}
+}
+
+// SetDeleted : Allow user to set Deleted
+func (_options *ListSubjectsOptions) SetDeleted(deleted bool) *ListSubjectsOptions {
+	_options.Deleted = core.BoolPtr(deleted)
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *ListSubjectsOptions) SetHeaders(param map[string]string) *ListSubjectsOptions {
+	options.Headers = param
+	return options
+}
+
+// LookupSchemaOptions : The LookupSchema options.
+type LookupSchemaOptions struct {
+	// The name of the subject.
+	Subject *string `json:"subject" validate:"required,ne="`
+
+	// The schema document, as a string.
+	Schema *string `json:"schema" validate:"required,ne="`
+
+	// The type of the schema. Defaults to `AVRO` when not specified.
+	SchemaType *string `json:"schemaType,omitempty"`
+
+	// References to other schemas used by the schema.
+	References []SchemaReference `json:"references,omitempty"`
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewLookupSchemaOptions : Instantiate LookupSchemaOptions
+func (*ConfluentregistryV1) NewLookupSchemaOptions(subject string, schema string) *LookupSchemaOptions {
+	return &LookupSchemaOptions{
+		Subject: core.StringPtr(subject),
+		Schema:  core.StringPtr(schema),
+	}
+}
+
+// SetSubject : Allow user to set Subject
+func (_options *LookupSchemaOptions) SetSubject(subject string) *LookupSchemaOptions {
+	_options.Subject = core.StringPtr(subject)
+	return _options
+}
+
+// SetSchema : Allow user to set Schema
+func (_options *LookupSchemaOptions) SetSchema(schema string) *LookupSchemaOptions {
+	_options.Schema = core.StringPtr(schema)
+	return _options
+}
+
+// SetSchemaType : Allow user to set SchemaType
+func (_options *LookupSchemaOptions) SetSchemaType(schemaType string) *LookupSchemaOptions {
+	_options.SchemaType = core.StringPtr(schemaType)
+	return _options
+}
+
+// SetReferences : Allow user to set References
+func (_options *LookupSchemaOptions) SetReferences(references []SchemaReference) *LookupSchemaOptions {
+	_options.References = references
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *LookupSchemaOptions) SetHeaders(param map[string]string) *LookupSchemaOptions {
+	options.Headers = param
+	return options
+}
+
+// RegisterSchemaOptions : The RegisterSchema options.
+type RegisterSchemaOptions struct {
+	// The name of the subject.
+	Subject *string `json:"subject" validate:"required,ne="`
+
+	// The schema document, as a string.
+	Schema *string `json:"schema" validate:"required,ne="`
+
+	// The type of the schema. Defaults to `AVRO` when not specified.
+	SchemaType *string `json:"schemaType,omitempty"`
+
+	// References to other schemas used by the schema.
+	References []SchemaReference `json:"references,omitempty"`
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewRegisterSchemaOptions : Instantiate RegisterSchemaOptions
+func (*ConfluentregistryV1) NewRegisterSchemaOptions(subject string, schema string) *RegisterSchemaOptions {
+	return &RegisterSchemaOptions{
+		Subject: core.StringPtr(subject),
+		Schema:  core.StringPtr(schema),
+	}
+}
+
+// SetSubject : Allow user to set Subject
+func (_options *RegisterSchemaOptions) SetSubject(subject string) *RegisterSchemaOptions {
+	_options.Subject = core.StringPtr(subject)
+	return _options
+}
+
+// SetSchema : Allow user to set Schema
+func (_options *RegisterSchemaOptions) SetSchema(schema string) *RegisterSchemaOptions {
+	_options.Schema = core.StringPtr(schema)
+	return _options
+}
+
+// SetSchemaType : Allow user to set SchemaType
+func (_options *RegisterSchemaOptions) SetSchemaType(schemaType string) *RegisterSchemaOptions {
+	_options.SchemaType = core.StringPtr(schemaType)
+	return _options
+}
+
+// SetReferences : Allow user to set References
+func (_options *RegisterSchemaOptions) SetReferences(references []SchemaReference) *RegisterSchemaOptions {
+	_options.References = references
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *RegisterSchemaOptions) SetHeaders(param map[string]string) *RegisterSchemaOptions {
+	options.Headers = param
+	return options
+}
+
+// TestCompatibilityOptions : The TestCompatibility options.
+type TestCompatibilityOptions struct {
+	// The name of the subject.
+	Subject *string `json:"subject" validate:"required,ne="`
+
+	// The version number, or `latest` for the most recently registered version.
+	Version *string `json:"version" validate:"required,ne="`
+
+	// The schema document, as a string.
+	Schema *string `json:"schema" validate:"required,ne="`
+
+	// The type of the schema. Defaults to `AVRO` when not specified.
+	SchemaType *string `json:"schemaType,omitempty"`
+
+	// References to other schemas used by the schema.
+	References []SchemaReference `json:"references,omitempty"`
+
+	// When true, the reasons for an incompatibility are returned.
+	Verbose *bool `json:"verbose,omitempty"`
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewTestCompatibilityOptions : Instantiate TestCompatibilityOptions
+func (*ConfluentregistryV1) NewTestCompatibilityOptions(subject string, version string, schema string) *TestCompatibilityOptions {
+	return &TestCompatibilityOptions{
+		Subject: core.StringPtr(subject),
+		Version: core.StringPtr(version),
+		Schema:  core.StringPtr(schema),
+	}
+}
+
+// SetSubject : Allow user to set Subject
+func (_options *TestCompatibilityOptions) SetSubject(subject string) *TestCompatibilityOptions {
+	_options.Subject = core.StringPtr(subject)
+	return _options
+}
+
+// SetVersion : Allow user to set Version
+func (_options *TestCompatibilityOptions) SetVersion(version string) *TestCompatibilityOptions {
+	_options.Version = core.StringPtr(version)
+	return _options
+}
+
+// SetSchema : Allow user to set Schema
+func (_options *TestCompatibilityOptions) SetSchema(schema string) *TestCompatibilityOptions {
+	_options.Schema = core.StringPtr(schema)
+	return _options
+}
+
+// SetSchemaType : Allow user to set SchemaType
+func (_options *TestCompatibilityOptions) SetSchemaType(schemaType string) *TestCompatibilityOptions {
+	_options.SchemaType = core.StringPtr(schemaType)
+	return _options
+}
+
+// SetReferences : Allow user to set References
+func (_options *TestCompatibilityOptions) SetReferences(references []SchemaReference) *TestCompatibilityOptions {
+	_options.References = references
+	return _options
+}
+
+// SetVerbose : Allow user to set Verbose
+func (_options *TestCompatibilityOptions) SetVerbose(verbose bool) *TestCompatibilityOptions {
+	_options.Verbose = core.BoolPtr(verbose)
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *TestCompatibilityOptions) SetHeaders(param map[string]string) *TestCompatibilityOptions {
+	options.Headers = param
+	return options
+}
+
+// UpdateGlobalConfigOptions : The UpdateGlobalConfig options.
+type UpdateGlobalConfigOptions struct {
+	// The compatibility level.
+	Compatibility *string `json:"compatibility" validate:"required,ne="`
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// Constants associated with the UpdateGlobalConfigOptions.Compatibility property.
+// The compatibility level.
+const (
+	UpdateGlobalConfigOptionsCompatibilityBackwardConst           = "BACKWARD"
+	UpdateGlobalConfigOptionsCompatibilityBackwardTransitiveConst = "BACKWARD_TRANSITIVE"
+	UpdateGlobalConfigOptionsCompatibilityForwardConst            = "FORWARD"
+	UpdateGlobalConfigOptionsCompatibilityForwardTransitiveConst  = "FORWARD_TRANSITIVE"
+	UpdateGlobalConfigOptionsCompatibilityFullConst               = "FULL"
+	UpdateGlobalConfigOptionsCompatibilityFullTransitiveConst     = "FULL_TRANSITIVE"
+	UpdateGlobalConfigOptionsCompatibilityNoneConst               = "NONE"
+)
+
+// NewUpdateGlobalConfigOptions : Instantiate UpdateGlobalConfigOptions
+func (*ConfluentregistryV1) NewUpdateGlobalConfigOptions(compatibility string) *UpdateGlobalConfigOptions {
+	return &UpdateGlobalConfigOptions{
+		Compatibility: core.StringPtr(compatibility),
+	}
+}
+
+// SetCompatibility : Allow user to set Compatibility
+func (_options *UpdateGlobalConfigOptions) SetCompatibility(compatibility string) *UpdateGlobalConfigOptions {
+	_options.Compatibility = core.StringPtr(compatibility)
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *UpdateGlobalConfigOptions) SetHeaders(param map[string]string) *UpdateGlobalConfigOptions {
+	options.Headers = param
+	return options
+}
+
+// UpdateSubjectConfigOptions : The UpdateSubjectConfig options.
+type UpdateSubjectConfigOptions struct {
+	// The name of the subject.
+	Subject *string `json:"subject" validate:"required,ne="`
+
+	// The compatibility level.
+	Compatibility *string `json:"compatibility" validate:"required,ne="`
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// Constants associated with the UpdateSubjectConfigOptions.Compatibility property.
+// The compatibility level.
+const (
+	UpdateSubjectConfigOptionsCompatibilityBackwardConst           = "BACKWARD"
+	UpdateSubjectConfigOptionsCompatibilityBackwardTransitiveConst = "BACKWARD_TRANSITIVE"
+	UpdateSubjectConfigOptionsCompatibilityForwardConst            = "FORWARD"
+	UpdateSubjectConfigOptionsCompatibilityForwardTransitiveConst  = "FORWARD_TRANSITIVE"
+	UpdateSubjectConfigOptionsCompatibilityFullConst               = "FULL"
+	UpdateSubjectConfigOptionsCompatibilityFullTransitiveConst     = "FULL_TRANSITIVE"
+	UpdateSubjectConfigOptionsCompatibilityNoneConst               = "NONE"
+)
+
+// NewUpdateSubjectConfigOptions : Instantiate UpdateSubjectConfigOptions
+func (*ConfluentregistryV1) NewUpdateSubjectConfigOptions(subject string, compatibility string) *UpdateSubjectConfigOptions {
+	return &UpdateSubjectConfigOptions{
+		Subject:       core.StringPtr(subject),
+		Compatibility: core.StringPtr(compatibility),
+	}
+}
+
+// SetSubject : Allow user to set Subject
+func (_options *UpdateSubjectConfigOptions) SetSubject(subject string) *UpdateSubjectConfigOptions {
+	_options.Subject = core.StringPtr(subject)
+	return _options
+}
+
+// SetCompatibility : Allow user to set Compatibility
+func (_options *UpdateSubjectConfigOptions) SetCompatibility(compatibility string) *UpdateSubjectConfigOptions {
+	_options.Compatibility = core.StringPtr(compatibility)
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *UpdateSubjectConfigOptions) SetHeaders(param map[string]string) *UpdateSubjectConfigOptions {
+	options.Headers = param
+	return options
+}
+
+// CompatibilityCheck : The result of a compatibility test.
+type CompatibilityCheck struct {
+	// Whether the schema is compatible.
+	IsCompatible *bool `json:"is_compatible,omitempty"`
+
+	// The reasons the schema is incompatible. Only returned when the test is verbose.
+	Messages []string `json:"messages,omitempty"`
+}
+
+// UnmarshalCompatibilityCheck unmarshals an instance of CompatibilityCheck from the specified map of raw messages.
+func UnmarshalCompatibilityCheck(m map[string]json.RawMessage, result interface{}) (err error) {
+	obj := new(CompatibilityCheck)
+	err = core.UnmarshalPrimitive(m, "is_compatible", &obj.IsCompatible)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "is_compatible-error", common.GetComponentInfo())
+		return
+	}
+	err = core.UnmarshalPrimitive(m, "messages", &obj.Messages)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "messages-error", common.GetComponentInfo())
+		return
+	}
+	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
+	return
+}
+
+// Config : The compatibility configuration of the registry or of a subject.
+type Config struct {
+	// The compatibility level.
+	CompatibilityLevel *string `json:"compatibilityLevel,omitempty"`
+}
+
+// UnmarshalConfig unmarshals an instance of Config from the specified map of raw messages.
+func UnmarshalConfig(m map[string]json.RawMessage, result interface{}) (err error) {
+	obj := new(Config)
+	err = core.UnmarshalPrimitive(m, "compatibilityLevel", &obj.CompatibilityLevel)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "compatibilityLevel-error", common.GetComponentInfo())
+		return
+	}
+	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
+	return
+}
+
+// ConfigUpdate : The compatibility configuration that was set.
+type ConfigUpdate struct {
+	// The compatibility level.
+	Compatibility *string `json:"compatibility,omitempty"`
+}
+
+// UnmarshalConfigUpdate unmarshals an instance of ConfigUpdate from the specified map of raw messages.
+func UnmarshalConfigUpdate(m map[string]json.RawMessage, result interface{}) (err error) {
+	obj := new(ConfigUpdate)
+	err = core.UnmarshalPrimitive(m, "compatibility", &obj.Compatibility)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "compatibility-error", common.GetComponentInfo())
+		return
+	}
+	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
+	return
+}
+
+// RegisteredSchema : The result of registering a schema.
+type RegisteredSchema struct {
+	// The globally unique ID of the schema.
+	ID *int64 `json:"id,omitempty"`
+}
+
+// UnmarshalRegisteredSchema unmarshals an instance of RegisteredSchema from the specified map of raw messages.
+func UnmarshalRegisteredSchema(m map[string]json.RawMessage, result interface{}) (err error) {
+	obj := new(RegisteredSchema)
+	err = core.UnmarshalPrimitive(m, "id", &obj.ID)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "id-error", common.GetComponentInfo())
+		return
+	}
+	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
+	return
+}
+
+// Schema : A schema version registered under a subject.
+type Schema struct {
+	// The name of the subject.
+	Subject *string `json:"subject,omitempty"`
+
+	// The globally unique ID of the schema.
+	ID *int64 `json:"id,omitempty"`
+
+	// The version number of the schema within the subject.
+	Version *int64 `json:"version,omitempty"`
+
+	// The type of the schema. Omitted for `AVRO` schemas.
+	SchemaType *string `json:"schemaType,omitempty"`
+
+	// References to other schemas used by the schema.
+	References []SchemaReference `json:"references,omitempty"`
+
+	// The schema document, as a string.
+	Schema *string `json:"schema,omitempty"`
+}
+
+// Constants associated with the Schema.SchemaType property.
+// The type of the schema.
+const (
+	SchemaSchemaTypeAvroConst     = "AVRO"
+	SchemaSchemaTypeJSONConst     = "JSON"
+	SchemaSchemaTypeProtobufConst = "PROTOBUF"
+)
+
+// UnmarshalSchema unmarshals an instance of Schema from the specified map of raw messages.
+func UnmarshalSchema(m map[string]json.RawMessage, result interface{}) (err error) {
+	obj := new(Schema)
+	err = core.UnmarshalPrimitive(m, "subject", &obj.Subject)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "subject-error", common.GetComponentInfo())
+		return
+	}
+	err = core.UnmarshalPrimitive(m, "id", &obj.ID)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "id-error", common.GetComponentInfo())
+		return
+	}
+	err = core.UnmarshalPrimitive(m, "version", &obj.Version)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "version-error", common.GetComponentInfo())
+		return
+	}
+	err = core.UnmarshalPrimitive(m, "schemaType", &obj.SchemaType)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "schemaType-error", common.GetComponentInfo())
+		return
+	}
+	err = core.UnmarshalModel(m, "references", &obj.References, UnmarshalSchemaReference)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "references-error", common.GetComponentInfo())
+		return
+	}
+	err = core.UnmarshalPrimitive(m, "schema", &obj.Schema)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "schema-error", common.GetComponentInfo())
+		return
+	}
+	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
+	return
+}
+
+// SchemaReference : A reference from a schema to another registered schema.
+type SchemaReference struct {
+	// The name used to refer to the schema.
+	Name *string `json:"name,omitempty"`
+
+	// The subject under which the referenced schema is registered.
+	Subject *string `json:"subject,omitempty"`
+
+	// The version of the referenced schema.
+	Version *int64 `json:"version,omitempty"`
+}
+
+// NewSchemaReference : Instantiate SchemaReference (Generic Model Constructor)
+func (*ConfluentregistryV1) NewSchemaReference(name string, subject string, version int64) (_model *SchemaReference, err error) {
+	_model = &SchemaReference{
+		Name:    core.StringPtr(name),
+		Subject: core.StringPtr(subject),
+		Version: core.Int64Ptr(version),
+	}
+	return
+}
+
+// UnmarshalSchemaReference unmarshals an instance of SchemaReference from the specified map of raw messages.
+func UnmarshalSchemaReference(m map[string]json.RawMessage, result interface{}) (err error) {
+	obj := new(SchemaReference)
+	err = core.UnmarshalPrimitive(m, "name", &obj.Name)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "name-error", common.GetComponentInfo())
+		return
+	}
+	err = core.UnmarshalPrimitive(m, "subject", &obj.Subject)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "subject-error", common.GetComponentInfo())
+		return
+	}
+	err = core.UnmarshalPrimitive(m, "version", &obj.Version)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "version-error", common.GetComponentInfo())
+		return
+	}
+	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
+	return
+}
+
+// SchemaString : A schema retrieved by ID.
+type SchemaString struct {
+	// The type of the schema. Omitted for `AVRO` schemas.
+	SchemaType *string `json:"schemaType,omitempty"`
+
+	// The schema document, as a string.
+	Schema *string `json:"schema,omitempty"`
+
+	// References to other schemas used by the schema.
+	References []SchemaReference `json:"references,omitempty"`
+}
+
+// Constants associated with the SchemaString.SchemaType property.
+// The type of the schema.
+const (
+	SchemaStringSchemaTypeAvroConst     = "AVRO"
+	SchemaStringSchemaTypeJSONConst     = "JSON"
+	SchemaStringSchemaTypeProtobufConst = "PROTOBUF"
+)
+
+// UnmarshalSchemaString unmarshals an instance of SchemaString from the specified map of raw messages.
+func UnmarshalSchemaString(m map[string]json.RawMessage, result interface{}) (err error) {
+	obj := new(SchemaString)
+	err = core.UnmarshalPrimitive(m, "schemaType", &obj.SchemaType)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "schemaType-error", common.GetComponentInfo())
+		return
+	}
+	err = core.UnmarshalPrimitive(m, "schema", &obj.Schema)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "schema-error", common.GetComponentInfo())
+		return
+	}
+	err = core.UnmarshalModel(m, "references", &obj.References, UnmarshalSchemaReference)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "references-error", common.GetComponentInfo())
+		return
+	}
+	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
+	return
+}
+
+// SubjectVersion : A subject and version under which a schema is registered.
+type SubjectVersion struct {
+	// The name of the subject.
+	Subject *string `json:"subject,omitempty"`
+
+	// The version number of the schema within the subject.
+	Version *int64 `json:"version,omitempty"`
+}
+
+// UnmarshalSubjectVersion unmarshals an instance of SubjectVersion from the specified map of raw messages.
+func UnmarshalSubjectVersion(m map[string]json.RawMessage, result interface{}) (err error) {
+	obj := new(SubjectVersion)
+	err = core.UnmarshalPrimitive(m, "subject", &obj.Subject)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "subject-error", common.GetComponentInfo())
+		return
+	}
+	err = core.UnmarshalPrimitive(m, "version", &obj.Version)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "version-error", common.GetComponentInfo())
+		return
+	}
+	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
+	return
+}
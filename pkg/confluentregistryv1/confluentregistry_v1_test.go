@@ -0,0 +1,1215 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package confluentregistryv1_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	common "github.com/IBM/eventstreams-go-sdk/pkg/common"
+	"github.com/IBM/eventstreams-go-sdk/pkg/confluentregistryv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`ConfluentregistryV1`, func() {
+	var testServer *httptest.Server
+	Describe(`Service constructor tests`, func() {
+		It(`Instantiate service client`, func() {
+			confluentregistryService, serviceErr := confluentregistryv1.NewConfluentregistryV1(&confluentregistryv1.ConfluentregistryV1Options{
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(confluentregistryService).ToNot(BeNil())
+			Expect(serviceErr).To(BeNil())
+		})
+		It(`Instantiate service client with error: Invalid URL`, func() {
+			confluentregistryService, serviceErr := confluentregistryv1.NewConfluentregistryV1(&confluentregistryv1.ConfluentregistryV1Options{
+				URL: "{BAD_URL_STRING",
+			})
+			Expect(confluentregistryService).To(BeNil())
+			Expect(serviceErr).ToNot(BeNil())
+		})
+		It(`Instantiate service client with error: Invalid Auth`, func() {
+			confluentregistryService, serviceErr := confluentregistryv1.NewConfluentregistryV1(&confluentregistryv1.ConfluentregistryV1Options{
+				URL: "https://confluentregistryv1/api",
+				Authenticator: &core.BasicAuthenticator{
+					Username: "",
+					Password: "",
+				},
+			})
+			Expect(confluentregistryService).To(BeNil())
+			Expect(serviceErr).ToNot(BeNil())
+		})
+	})
+	Describe(`Service constructor tests using external config`, func() {
+		Context(`Using external config, construct service client instances`, func() {
+			// Map containing environment variables used in testing.
+			var testEnvironment = map[string]string{
+				"CONFLUENTREGISTRY_URL":       "https://confluentregistryv1/api",
+				"CONFLUENTREGISTRY_AUTH_TYPE": "noauth",
+			}
+
+			It(`Create service client using external config successfully`, func() {
+				SetTestEnvironment(testEnvironment)
+				confluentregistryService, serviceErr := confluentregistryv1.NewConfluentregistryV1UsingExternalConfig(&confluentregistryv1.ConfluentregistryV1Options{})
+				Expect(confluentregistryService).ToNot(BeNil())
+				Expect(serviceErr).To(BeNil())
+				ClearTestEnvironment(testEnvironment)
+
+				clone := confluentregistryService.Clone()
+				Expect(clone).ToNot(BeNil())
+				Expect(clone.Service != confluentregistryService.Service).To(BeTrue())
+				Expect(clone.GetServiceURL()).To(Equal(confluentregistryService.GetServiceURL()))
+				Expect(clone.Service.Options.Authenticator).To(Equal(confluentregistryService.Service.Options.Authenticator))
+			})
+			It(`Create service client using external config and set url from constructor successfully`, func() {
+				SetTestEnvironment(testEnvironment)
+				confluentregistryService, serviceErr := confluentregistryv1.NewConfluentregistryV1UsingExternalConfig(&confluentregistryv1.ConfluentregistryV1Options{
+					URL: "https://testService/api",
+				})
+				Expect(confluentregistryService).ToNot(BeNil())
+				Expect(serviceErr).To(BeNil())
+				Expect(confluentregistryService.Service.GetServiceURL()).To(Equal("https://testService/api"))
+				ClearTestEnvironment(testEnvironment)
+
+				clone := confluentregistryService.Clone()
+				Expect(clone).ToNot(BeNil())
+				Expect(clone.Service != confluentregistryService.Service).To(BeTrue())
+				Expect(clone.GetServiceURL()).To(Equal(confluentregistryService.GetServiceURL()))
+				Expect(clone.Service.Options.Authenticator).To(Equal(confluentregistryService.Service.Options.Authenticator))
+			})
+			It(`Create service client using external config and set url programatically successfully`, func() {
+				SetTestEnvironment(testEnvironment)
+				confluentregistryService, serviceErr := confluentregistryv1.NewConfluentregistryV1UsingExternalConfig(&confluentregistryv1.ConfluentregistryV1Options{})
+				err := confluentregistryService.SetServiceURL("https://testService/api")
+				Expect(err).To(BeNil())
+				Expect(confluentregistryService).ToNot(BeNil())
+				Expect(serviceErr).To(BeNil())
+				Expect(confluentregistryService.Service.GetServiceURL()).To(Equal("https://testService/api"))
+				ClearTestEnvironment(testEnvironment)
+
+				clone := confluentregistryService.Clone()
+				Expect(clone).ToNot(BeNil())
+				Expect(clone.Service != confluentregistryService.Service).To(BeTrue())
+				Expect(clone.GetServiceURL()).To(Equal(confluentregistryService.GetServiceURL()))
+				Expect(clone.Service.Options.Authenticator).To(Equal(confluentregistryService.Service.Options.Authenticator))
+			})
+		})
+		Context(`Using external config, construct service client instances with error: Invalid Auth`, func() {
+			// Map containing environment variables used in testing.
+			var testEnvironment = map[string]string{
+				"CONFLUENTREGISTRY_URL":       "https://confluentregistryv1/api",
+				"CONFLUENTREGISTRY_AUTH_TYPE": "someOtherAuth",
+			}
+
+			SetTestEnvironment(testEnvironment)
+			confluentregistryService, serviceErr := confluentregistryv1.NewConfluentregistryV1UsingExternalConfig(&confluentregistryv1.ConfluentregistryV1Options{})
+
+			It(`Instantiate service client with error`, func() {
+				Expect(confluentregistryService).To(BeNil())
+				Expect(serviceErr).ToNot(BeNil())
+				ClearTestEnvironment(testEnvironment)
+			})
+		})
+		Context(`Using external config, construct service client instances with error: Invalid URL`, func() {
+			// Map containing environment variables used in testing.
+			var testEnvironment = map[string]string{
+				"CONFLUENTREGISTRY_AUTH_TYPE": "NOAuth",
+			}
+
+			SetTestEnvironment(testEnvironment)
+			confluentregistryService, serviceErr := confluentregistryv1.NewConfluentregistryV1UsingExternalConfig(&confluentregistryv1.ConfluentregistryV1Options{
+				URL: "{BAD_URL_STRING",
+			})
+
+			It(`Instantiate service client with error`, func() {
+				Expect(confluentregistryService).To(BeNil())
+				Expect(serviceErr).ToNot(BeNil())
+				ClearTestEnvironment(testEnvironment)
+			})
+		})
+	})
+	Describe(`Regional endpoint tests`, func() {
+		It(`GetServiceURLForRegion(region string)`, func() {
+			var url string
+			var err error
+			url, err = confluentregistryv1.GetServiceURLForRegion("INVALID_REGION")
+			Expect(url).To(BeEmpty())
+			Expect(err).ToNot(BeNil())
+			fmt.Fprintf(GinkgoWriter, "Expected error: %s\n", err.Error())
+		})
+	})
+	Describe(`Operation timeouts`, func() {
+		var requests atomic.Int32
+		BeforeEach(func() {
+			requests.Store(0)
+			testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+				requests.Add(1)
+
+				res.Header().Set("Content-type", "application/json")
+				if strings.HasPrefix(req.URL.EscapedPath(), "/subjects/unavailable") {
+					res.WriteHeader(503)
+					fmt.Fprintf(res, "%s", `{"error_code": 503, "message": "unavailable"}`)
+					return
+				}
+
+				// Sleep a short time to support a timeout test
+				time.Sleep(100 * time.Millisecond)
+				res.WriteHeader(200)
+				if req.Method == "DELETE" {
+					fmt.Fprintf(res, "%s", `[1]`)
+					return
+				}
+				fmt.Fprintf(res, "%s", `{"schema": "\"string\""}`)
+			}))
+		})
+		It(`Apply the timeout of the operation class`, func() {
+			confluentregistryService, serviceErr := confluentregistryv1.NewConfluentregistryV1(&confluentregistryv1.ConfluentregistryV1Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+			timeouts := common.OperationTimeouts{Read: 50 * time.Millisecond, Write: 50 * time.Millisecond}
+			confluentregistryService.SetOperationTimeouts(timeouts)
+			Expect(confluentregistryService.GetOperationTimeouts()).To(Equal(timeouts))
+
+			_, _, operationErr := confluentregistryService.GetSchemaByID(confluentregistryService.NewGetSchemaByIDOptions(1))
+			Expect(operationErr).ToNot(BeNil())
+			Expect(operationErr.Error()).To(ContainSubstring("deadline exceeded"))
+			_, _, operationErr = confluentregistryService.DeleteSubject(confluentregistryService.NewDeleteSubjectOptions("testString"))
+			Expect(operationErr).ToNot(BeNil())
+			Expect(operationErr.Error()).To(ContainSubstring("deadline exceeded"))
+
+			// A deadline of the context replaces the default timeout
+			ctx, cancelFunc := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancelFunc()
+			result, _, operationErr := confluentregistryService.GetSchemaByIDWithContext(ctx, confluentregistryService.NewGetSchemaByIDOptions(1))
+			Expect(operationErr).To(BeNil())
+			Expect(*result.Schema).To(Equal(`"string"`))
+
+			// Write operations have no timeout unless one is set
+			confluentregistryService.SetOperationTimeouts(common.OperationTimeouts{Read: 50 * time.Millisecond})
+			_, _, operationErr = confluentregistryService.DeleteSubject(confluentregistryService.NewDeleteSubjectOptions("testString"))
+			Expect(operationErr).To(BeNil())
+		})
+		It(`Stop retrying when the timeout expires`, func() {
+			confluentregistryService, serviceErr := confluentregistryv1.NewConfluentregistryV1(&confluentregistryv1.ConfluentregistryV1Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+			confluentregistryService.EnableRetries(5, 5*time.Second)
+			confluentregistryService.SetOperationTimeouts(common.OperationTimeouts{Read: 200 * time.Millisecond})
+
+			start := time.Now()
+			_, _, operationErr := confluentregistryService.ListSubjectVersions(confluentregistryService.NewListSubjectVersionsOptions("unavailable"))
+			Expect(operationErr).ToNot(BeNil())
+			// Without the timeout, the retries would wait for more than 10 seconds
+			Expect(time.Since(start)).To(BeNumerically("<", 3*time.Second))
+			Expect(requests.Load()).To(BeNumerically("<", int32(6)))
+		})
+		It(`Stop retrying when the context is canceled`, func() {
+			confluentregistryService, serviceErr := confluentregistryv1.NewConfluentregistryV1(&confluentregistryv1.ConfluentregistryV1Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+			confluentregistryService.EnableRetries(5, 5*time.Second)
+
+			ctx, cancelFunc := context.WithCancel(context.Background())
+			time.AfterFunc(200*time.Millisecond, cancelFunc)
+			start := time.Now()
+			_, _, operationErr := confluentregistryService.ListSubjectVersionsWithContext(ctx, confluentregistryService.NewListSubjectVersionsOptions("unavailable"))
+			Expect(operationErr).ToNot(BeNil())
+			Expect(time.Since(start)).To(BeNumerically("<", 3*time.Second))
+			Expect(requests.Load()).To(BeNumerically("<", int32(6)))
+		})
+		AfterEach(func() {
+			testServer.Close()
+		})
+	})
+	Describe(`ListSubjects(listSubjectsOptions *ListSubjectsOptions)`, func() {
+		listSubjectsPath := "/subjects"
+		Context(`Using mock server endpoint`, func() {
+			BeforeEach(func() {
+				testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+					defer GinkgoRecover()
+
+					// Verify the contents of the request
+					Expect(req.URL.EscapedPath()).To(Equal(listSubjectsPath))
+					Expect(req.Method).To(Equal("GET"))
+
+					Expect(req.URL.Query()["deleted"]).To(Equal([]string{"true"}))
+					Expect(req.Header.Get("Accept")).To(ContainSubstring("application/vnd.schemaregistry.v1+json"))
+					// Set mock response
+					res.Header().Set("Content-type", "application/vnd.schemaregistry.v1+json")
+					res.WriteHeader(200)
+					fmt.Fprintf(res, "%s", `["testString"]`)
+				}))
+			})
+			It(`Invoke ListSubjects successfully`, func() {
+				confluentregistryService, serviceErr := confluentregistryv1.NewConfluentregistryV1(&confluentregistryv1.ConfluentregistryV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(confluentregistryService).ToNot(BeNil())
+
+				// Construct an instance of the ListSubjectsOptions model
+				listSubjectsOptionsModel := confluentregistryService.NewListSubjectsOptions()
+				listSubjectsOptionsModel.SetDeleted(true)
+				listSubjectsOptionsModel.SetHeaders(map[string]string{"x-custom-header": "x-custom-value"})
+
+				// Invoke operation with valid options model (positive test)
+				result, response, operationErr := confluentregistryService.ListSubjects(listSubjectsOptionsModel)
+				Expect(operationErr).To(BeNil())
+				Expect(response).ToNot(BeNil())
+				Expect(result).To(Equal([]string{"testString"}))
+
+				// Invoke operation with a Context
+				ctx, cancelFunc := context.WithTimeout(context.Background(), 5*time.Second)
+				defer cancelFunc()
+				_, _, operationErr = confluentregistryService.ListSubjectsWithContext(ctx, listSubjectsOptionsModel)
+				Expect(operationErr).To(BeNil())
+			})
+			AfterEach(func() {
+				testServer.Close()
+			})
+		})
+	})
+	Describe(`ListSubjectVersions(listSubjectVersionsOptions *ListSubjectVersionsOptions)`, func() {
+		listSubjectVersionsPath := "/subjects/testString/versions"
+		Context(`Using mock server endpoint`, func() {
+			BeforeEach(func() {
+				testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+					defer GinkgoRecover()
+
+					// Verify the contents of the request
+					Expect(req.URL.EscapedPath()).To(Equal(listSubjectVersionsPath))
+					Expect(req.Method).To(Equal("GET"))
+
+					Expect(req.URL.Query()["deleted"]).To(Equal([]string{"true"}))
+					Expect(req.Header.Get("Accept")).To(ContainSubstring("application/vnd.schemaregistry.v1+json"))
+					// Set mock response
+					res.Header().Set("Content-type", "application/vnd.schemaregistry.v1+json")
+					res.WriteHeader(200)
+					fmt.Fprintf(res, "%s", `[1]`)
+				}))
+			})
+			It(`Invoke ListSubjectVersions successfully`, func() {
+				confluentregistryService, serviceErr := confluentregistryv1.NewConfluentregistryV1(&confluentregistryv1.ConfluentregistryV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(confluentregistryService).ToNot(BeNil())
+
+				// Invoke operation with nil options model (negative test)
+				_, response, operationErr := confluentregistryService.ListSubjectVersions(nil)
+				Expect(operationErr).NotTo(BeNil())
+				Expect(response).To(BeNil())
+
+				// Construct an instance of the ListSubjectVersionsOptions model
+				listSubjectVersionsOptionsModel := confluentregistryService.NewListSubjectVersionsOptions("testString")
+				listSubjectVersionsOptionsModel.SetDeleted(true)
+				listSubjectVersionsOptionsModel.SetHeaders(map[string]string{"x-custom-header": "x-custom-value"})
+
+				// Invoke operation with valid options model (positive test)
+				result, response, operationErr := confluentregistryService.ListSubjectVersions(listSubjectVersionsOptionsModel)
+				Expect(operationErr).To(BeNil())
+				Expect(response).ToNot(BeNil())
+				Expect(result).To(Equal([]int64{1}))
+
+				// Invoke operation with a Context
+				ctx, cancelFunc := context.WithTimeout(context.Background(), 5*time.Second)
+				defer cancelFunc()
+				_, _, operationErr = confluentregistryService.ListSubjectVersionsWithContext(ctx, listSubjectVersionsOptionsModel)
+				Expect(operationErr).To(BeNil())
+
+				// Construct a second instance of the ListSubjectVersionsOptions model with no property values
+				listSubjectVersionsOptionsModelNew := new(confluentregistryv1.ListSubjectVersionsOptions)
+				// Invoke operation with invalid model (negative test)
+				_, response, operationErr = confluentregistryService.ListSubjectVersions(listSubjectVersionsOptionsModelNew)
+				Expect(operationErr).ToNot(BeNil())
+				Expect(response).To(BeNil())
+			})
+			AfterEach(func() {
+				testServer.Close()
+			})
+		})
+	})
+	Describe(`GetSubjectVersion(getSubjectVersionOptions *GetSubjectVersionOptions)`, func() {
+		getSubjectVersionPath := "/subjects/testString/versions/testString"
+		Context(`Using mock server endpoint`, func() {
+			BeforeEach(func() {
+				testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+					defer GinkgoRecover()
+
+					// Verify the contents of the request
+					Expect(req.URL.EscapedPath()).To(Equal(getSubjectVersionPath))
+					Expect(req.Method).To(Equal("GET"))
+
+					Expect(req.Header.Get("Accept")).To(ContainSubstring("application/vnd.schemaregistry.v1+json"))
+					// Set mock response
+					res.Header().Set("Content-type", "application/vnd.schemaregistry.v1+json")
+					res.WriteHeader(200)
+					fmt.Fprintf(res, "%s", `{"subject": "testString", "id": 38, "version": 1, "schemaType": "JSON", "references": [{"name": "testString", "subject": "testString", "version": 1}], "schema": "testString"}`)
+				}))
+			})
+			It(`Invoke GetSubjectVersion successfully`, func() {
+				confluentregistryService, serviceErr := confluentregistryv1.NewConfluentregistryV1(&confluentregistryv1.ConfluentregistryV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(confluentregistryService).ToNot(BeNil())
+
+				// Invoke operation with nil options model (negative test)
+				_, response, operationErr := confluentregistryService.GetSubjectVersion(nil)
+				Expect(operationErr).NotTo(BeNil())
+				Expect(response).To(BeNil())
+
+				// Construct an instance of the GetSubjectVersionOptions model
+				getSubjectVersionOptionsModel := confluentregistryService.NewGetSubjectVersionOptions("testString", "testString")
+				getSubjectVersionOptionsModel.SetHeaders(map[string]string{"x-custom-header": "x-custom-value"})
+
+				// Invoke operation with valid options model (positive test)
+				result, response, operationErr := confluentregistryService.GetSubjectVersion(getSubjectVersionOptionsModel)
+				Expect(operationErr).To(BeNil())
+				Expect(response).ToNot(BeNil())
+				Expect(*result.ID).To(Equal(int64(38)))
+				Expect(*result.SchemaType).To(Equal(confluentregistryv1.SchemaSchemaTypeJSONConst))
+				Expect(*result.References[0].Version).To(Equal(int64(1)))
+
+				// Invoke operation with a Context
+				ctx, cancelFunc := context.WithTimeout(context.Background(), 5*time.Second)
+				defer cancelFunc()
+				_, _, operationErr = confluentregistryService.GetSubjectVersionWithContext(ctx, getSubjectVersionOptionsModel)
+				Expect(operationErr).To(BeNil())
+
+				// Construct a second instance of the GetSubjectVersionOptions model with no property values
+				getSubjectVersionOptionsModelNew := new(confluentregistryv1.GetSubjectVersionOptions)
+				// Invoke operation with invalid model (negative test)
+				_, response, operationErr = confluentregistryService.GetSubjectVersion(getSubjectVersionOptionsModelNew)
+				Expect(operationErr).ToNot(BeNil())
+				Expect(response).To(BeNil())
+			})
+			AfterEach(func() {
+				testServer.Close()
+			})
+		})
+	})
+	Describe(`RegisterSchema(registerSchemaOptions *RegisterSchemaOptions)`, func() {
+		registerSchemaPath := "/subjects/testString/versions"
+		Context(`Using mock server endpoint`, func() {
+			BeforeEach(func() {
+				testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+					defer GinkgoRecover()
+
+					// Verify the contents of the request
+					Expect(req.URL.EscapedPath()).To(Equal(registerSchemaPath))
+					Expect(req.Method).To(Equal("POST"))
+
+					Expect(req.Header.Get("Content-Type")).To(Equal("application/vnd.schemaregistry.v1+json"))
+					var body map[string]interface{}
+					Expect(json.NewDecoder(req.Body).Decode(&body)).To(Succeed())
+					Expect(body["schema"]).To(Equal("testString"))
+					Expect(body["schemaType"]).To(Equal("JSON"))
+					Expect(body["references"]).To(HaveLen(1))
+					Expect(req.Header.Get("Accept")).To(ContainSubstring("application/vnd.schemaregistry.v1+json"))
+					// Set mock response
+					res.Header().Set("Content-type", "application/vnd.schemaregistry.v1+json")
+					res.WriteHeader(200)
+					fmt.Fprintf(res, "%s", `{"id": 38}`)
+				}))
+			})
+			It(`Invoke RegisterSchema successfully`, func() {
+				confluentregistryService, serviceErr := confluentregistryv1.NewConfluentregistryV1(&confluentregistryv1.ConfluentregistryV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(confluentregistryService).ToNot(BeNil())
+
+				// Invoke operation with nil options model (negative test)
+				_, response, operationErr := confluentregistryService.RegisterSchema(nil)
+				Expect(operationErr).NotTo(BeNil())
+				Expect(response).To(BeNil())
+
+				// Construct an instance of the RegisterSchemaOptions model
+				registerSchemaOptionsModel := confluentregistryService.NewRegisterSchemaOptions("testString", "testString")
+				registerSchemaOptionsModel.SetSchemaType("JSON")
+				schemaReferenceModel, err := confluentregistryService.NewSchemaReference("testString", "testString", int64(1))
+				Expect(err).To(BeNil())
+				registerSchemaOptionsModel.SetReferences([]confluentregistryv1.SchemaReference{*schemaReferenceModel})
+				registerSchemaOptionsModel.SetHeaders(map[string]string{"x-custom-header": "x-custom-value"})
+
+				// Invoke operation with valid options model (positive test)
+				result, response, operationErr := confluentregistryService.RegisterSchema(registerSchemaOptionsModel)
+				Expect(operationErr).To(BeNil())
+				Expect(response).ToNot(BeNil())
+				Expect(*result.ID).To(Equal(int64(38)))
+
+				// Invoke operation with a Context
+				ctx, cancelFunc := context.WithTimeout(context.Background(), 5*time.Second)
+				defer cancelFunc()
+				_, _, operationErr = confluentregistryService.RegisterSchemaWithContext(ctx, registerSchemaOptionsModel)
+				Expect(operationErr).To(BeNil())
+
+				// Construct a second instance of the RegisterSchemaOptions model with no property values
+				registerSchemaOptionsModelNew := new(confluentregistryv1.RegisterSchemaOptions)
+				// Invoke operation with invalid model (negative test)
+				_, response, operationErr = confluentregistryService.RegisterSchema(registerSchemaOptionsModelNew)
+				Expect(operationErr).ToNot(BeNil())
+				Expect(response).To(BeNil())
+			})
+			AfterEach(func() {
+				testServer.Close()
+			})
+		})
+	})
+	Describe(`LookupSchema(lookupSchemaOptions *LookupSchemaOptions)`, func() {
+		lookupSchemaPath := "/subjects/testString"
+		Context(`Using mock server endpoint`, func() {
+			BeforeEach(func() {
+				testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+					defer GinkgoRecover()
+
+					// Verify the contents of the request
+					Expect(req.URL.EscapedPath()).To(Equal(lookupSchemaPath))
+					Expect(req.Method).To(Equal("POST"))
+
+					Expect(req.Header.Get("Content-Type")).To(Equal("application/vnd.schemaregistry.v1+json"))
+					var body map[string]interface{}
+					Expect(json.NewDecoder(req.Body).Decode(&body)).To(Succeed())
+					Expect(body["schema"]).To(Equal("testString"))
+					Expect(body["schemaType"]).To(Equal("JSON"))
+					Expect(body["references"]).To(HaveLen(1))
+					Expect(req.Header.Get("Accept")).To(ContainSubstring("application/vnd.schemaregistry.v1+json"))
+					// Set mock response
+					res.Header().Set("Content-type", "application/vnd.schemaregistry.v1+json")
+					res.WriteHeader(200)
+					fmt.Fprintf(res, "%s", `{"subject": "testString", "id": 38, "version": 1, "schemaType": "JSON", "references": [{"name": "testString", "subject": "testString", "version": 1}], "schema": "testString"}`)
+				}))
+			})
+			It(`Invoke LookupSchema successfully`, func() {
+				confluentregistryService, serviceErr := confluentregistryv1.NewConfluentregistryV1(&confluentregistryv1.ConfluentregistryV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(confluentregistryService).ToNot(BeNil())
+
+				// Invoke operation with nil options model (negative test)
+				_, response, operationErr := confluentregistryService.LookupSchema(nil)
+				Expect(operationErr).NotTo(BeNil())
+				Expect(response).To(BeNil())
+
+				// Construct an instance of the LookupSchemaOptions model
+				lookupSchemaOptionsModel := confluentregistryService.NewLookupSchemaOptions("testString", "testString")
+				lookupSchemaOptionsModel.SetSchemaType("JSON")
+				schemaReferenceModel, err := confluentregistryService.NewSchemaReference("testString", "testString", int64(1))
+				Expect(err).To(BeNil())
+				lookupSchemaOptionsModel.SetReferences([]confluentregistryv1.SchemaReference{*schemaReferenceModel})
+				lookupSchemaOptionsModel.SetHeaders(map[string]string{"x-custom-header": "x-custom-value"})
+
+				// Invoke operation with valid options model (positive test)
+				result, response, operationErr := confluentregistryService.LookupSchema(lookupSchemaOptionsModel)
+				Expect(operationErr).To(BeNil())
+				Expect(response).ToNot(BeNil())
+				Expect(*result.ID).To(Equal(int64(38)))
+				Expect(*result.SchemaType).To(Equal(confluentregistryv1.SchemaSchemaTypeJSONConst))
+				Expect(*result.References[0].Version).To(Equal(int64(1)))
+
+				// Invoke operation with a Context
+				ctx, cancelFunc := context.WithTimeout(context.Background(), 5*time.Second)
+				defer cancelFunc()
+				_, _, operationErr = confluentregistryService.LookupSchemaWithContext(ctx, lookupSchemaOptionsModel)
+				Expect(operationErr).To(BeNil())
+
+				// Construct a second instance of the LookupSchemaOptions model with no property values
+				lookupSchemaOptionsModelNew := new(confluentregistryv1.LookupSchemaOptions)
+				// Invoke operation with invalid model (negative test)
+				_, response, operationErr = confluentregistryService.LookupSchema(lookupSchemaOptionsModelNew)
+				Expect(operationErr).ToNot(BeNil())
+				Expect(response).To(BeNil())
+			})
+			AfterEach(func() {
+				testServer.Close()
+			})
+		})
+	})
+	Describe(`DeleteSubject(deleteSubjectOptions *DeleteSubjectOptions)`, func() {
+		deleteSubjectPath := "/subjects/testString"
+		Context(`Using mock server endpoint`, func() {
+			BeforeEach(func() {
+				testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+					defer GinkgoRecover()
+
+					// Verify the contents of the request
+					Expect(req.URL.EscapedPath()).To(Equal(deleteSubjectPath))
+					Expect(req.Method).To(Equal("DELETE"))
+
+					Expect(req.URL.Query()["permanent"]).To(Equal([]string{"true"}))
+					Expect(req.Header.Get("Accept")).To(ContainSubstring("application/vnd.schemaregistry.v1+json"))
+					// Set mock response
+					res.Header().Set("Content-type", "application/vnd.schemaregistry.v1+json")
+					res.WriteHeader(200)
+					fmt.Fprintf(res, "%s", `[1]`)
+				}))
+			})
+			It(`Invoke DeleteSubject successfully`, func() {
+				confluentregistryService, serviceErr := confluentregistryv1.NewConfluentregistryV1(&confluentregistryv1.ConfluentregistryV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(confluentregistryService).ToNot(BeNil())
+
+				// Invoke operation with nil options model (negative test)
+				_, response, operationErr := confluentregistryService.DeleteSubject(nil)
+				Expect(operationErr).NotTo(BeNil())
+				Expect(response).To(BeNil())
+
+				// Construct an instance of the DeleteSubjectOptions model
+				deleteSubjectOptionsModel := confluentregistryService.NewDeleteSubjectOptions("testString")
+				deleteSubjectOptionsModel.SetPermanent(true)
+				deleteSubjectOptionsModel.SetHeaders(map[string]string{"x-custom-header": "x-custom-value"})
+
+				// Invoke operation with valid options model (positive test)
+				result, response, operationErr := confluentregistryService.DeleteSubject(deleteSubjectOptionsModel)
+				Expect(operationErr).To(BeNil())
+				Expect(response).ToNot(BeNil())
+				Expect(result).To(Equal([]int64{1}))
+
+				// Invoke operation with a Context
+				ctx, cancelFunc := context.WithTimeout(context.Background(), 5*time.Second)
+				defer cancelFunc()
+				_, _, operationErr = confluentregistryService.DeleteSubjectWithContext(ctx, deleteSubjectOptionsModel)
+				Expect(operationErr).To(BeNil())
+
+				// Construct a second instance of the DeleteSubjectOptions model with no property values
+				deleteSubjectOptionsModelNew := new(confluentregistryv1.DeleteSubjectOptions)
+				// Invoke operation with invalid model (negative test)
+				_, response, operationErr = confluentregistryService.DeleteSubject(deleteSubjectOptionsModelNew)
+				Expect(operationErr).ToNot(BeNil())
+				Expect(response).To(BeNil())
+			})
+			AfterEach(func() {
+				testServer.Close()
+			})
+		})
+	})
+	Describe(`DeleteSubjectVersion(deleteSubjectVersionOptions *DeleteSubjectVersionOptions)`, func() {
+		deleteSubjectVersionPath := "/subjects/testString/versions/testString"
+		Context(`Using mock server endpoint`, func() {
+			BeforeEach(func() {
+				testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+					defer GinkgoRecover()
+
+					// Verify the contents of the request
+					Expect(req.URL.EscapedPath()).To(Equal(deleteSubjectVersionPath))
+					Expect(req.Method).To(Equal("DELETE"))
+
+					Expect(req.URL.Query()["permanent"]).To(Equal([]string{"true"}))
+					Expect(req.Header.Get("Accept")).To(ContainSubstring("application/vnd.schemaregistry.v1+json"))
+					// Set mock response
+					res.Header().Set("Content-type", "application/vnd.schemaregistry.v1+json")
+					res.WriteHeader(200)
+					fmt.Fprintf(res, "%s", `1`)
+				}))
+			})
+			It(`Invoke DeleteSubjectVersion successfully`, func() {
+				confluentregistryService, serviceErr := confluentregistryv1.NewConfluentregistryV1(&confluentregistryv1.ConfluentregistryV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(confluentregistryService).ToNot(BeNil())
+
+				// Invoke operation with nil options model (negative test)
+				_, response, operationErr := confluentregistryService.DeleteSubjectVersion(nil)
+				Expect(operationErr).NotTo(BeNil())
+				Expect(response).To(BeNil())
+
+				// Construct an instance of the DeleteSubjectVersionOptions model
+				deleteSubjectVersionOptionsModel := confluentregistryService.NewDeleteSubjectVersionOptions("testString", "testString")
+				deleteSubjectVersionOptionsModel.SetPermanent(true)
+				deleteSubjectVersionOptionsModel.SetHeaders(map[string]string{"x-custom-header": "x-custom-value"})
+
+				// Invoke operation with valid options model (positive test)
+				result, response, operationErr := confluentregistryService.DeleteSubjectVersion(deleteSubjectVersionOptionsModel)
+				Expect(operationErr).To(BeNil())
+				Expect(response).ToNot(BeNil())
+				Expect(result).To(Equal(int64(1)))
+
+				// Invoke operation with a Context
+				ctx, cancelFunc := context.WithTimeout(context.Background(), 5*time.Second)
+				defer cancelFunc()
+				_, _, operationErr = confluentregistryService.DeleteSubjectVersionWithContext(ctx, deleteSubjectVersionOptionsModel)
+				Expect(operationErr).To(BeNil())
+
+				// Construct a second instance of the DeleteSubjectVersionOptions model with no property values
+				deleteSubjectVersionOptionsModelNew := new(confluentregistryv1.DeleteSubjectVersionOptions)
+				// Invoke operation with invalid model (negative test)
+				_, response, operationErr = confluentregistryService.DeleteSubjectVersion(deleteSubjectVersionOptionsModelNew)
+				Expect(operationErr).ToNot(BeNil())
+				Expect(response).To(BeNil())
+			})
+			AfterEach(func() {
+				testServer.Close()
+			})
+		})
+	})
+	Describe(`GetSchemaByID(getSchemaByIDOptions *GetSchemaByIDOptions)`, func() {
+		getSchemaByIDPath := "/schemas/ids/38"
+		Context(`Using mock server endpoint`, func() {
+			BeforeEach(func() {
+				testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+					defer GinkgoRecover()
+
+					// Verify the contents of the request
+					Expect(req.URL.EscapedPath()).To(Equal(getSchemaByIDPath))
+					Expect(req.Method).To(Equal("GET"))
+
+					Expect(req.Header.Get("Accept")).To(ContainSubstring("application/vnd.schemaregistry.v1+json"))
+					// Set mock response
+					res.Header().Set("Content-type", "application/vnd.schemaregistry.v1+json")
+					res.WriteHeader(200)
+					fmt.Fprintf(res, "%s", `{"schemaType": "JSON", "schema": "testString", "references": [{"name": "testString", "subject": "testString", "version": 1}]}`)
+				}))
+			})
+			It(`Invoke GetSchemaByID successfully`, func() {
+				confluentregistryService, serviceErr := confluentregistryv1.NewConfluentregistryV1(&confluentregistryv1.ConfluentregistryV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(confluentregistryService).ToNot(BeNil())
+
+				// Invoke operation with nil options model (negative test)
+				_, response, operationErr := confluentregistryService.GetSchemaByID(nil)
+				Expect(operationErr).NotTo(BeNil())
+				Expect(response).To(BeNil())
+
+				// Construct an instance of the GetSchemaByIDOptions model
+				getSchemaByIDOptionsModel := confluentregistryService.NewGetSchemaByIDOptions(38)
+				getSchemaByIDOptionsModel.SetHeaders(map[string]string{"x-custom-header": "x-custom-value"})
+
+				// Invoke operation with valid options model (positive test)
+				result, response, operationErr := confluentregistryService.GetSchemaByID(getSchemaByIDOptionsModel)
+				Expect(operationErr).To(BeNil())
+				Expect(response).ToNot(BeNil())
+				Expect(*result.Schema).To(Equal("testString"))
+				Expect(*result.References[0].Name).To(Equal("testString"))
+
+				// Invoke operation with a Context
+				ctx, cancelFunc := context.WithTimeout(context.Background(), 5*time.Second)
+				defer cancelFunc()
+				_, _, operationErr = confluentregistryService.GetSchemaByIDWithContext(ctx, getSchemaByIDOptionsModel)
+				Expect(operationErr).To(BeNil())
+
+				// Construct a second instance of the GetSchemaByIDOptions model with no property values
+				getSchemaByIDOptionsModelNew := new(confluentregistryv1.GetSchemaByIDOptions)
+				// Invoke operation with invalid model (negative test)
+				_, response, operationErr = confluentregistryService.GetSchemaByID(getSchemaByIDOptionsModelNew)
+				Expect(operationErr).ToNot(BeNil())
+				Expect(response).To(BeNil())
+			})
+			AfterEach(func() {
+				testServer.Close()
+			})
+		})
+	})
+	Describe(`ListSchemaVersionsByID(listSchemaVersionsByIDOptions *ListSchemaVersionsByIDOptions)`, func() {
+		listSchemaVersionsByIDPath := "/schemas/ids/38/versions"
+		Context(`Using mock server endpoint`, func() {
+			BeforeEach(func() {
+				testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+					defer GinkgoRecover()
+
+					// Verify the contents of the request
+					Expect(req.URL.EscapedPath()).To(Equal(listSchemaVersionsByIDPath))
+					Expect(req.Method).To(Equal("GET"))
+
+					Expect(req.Header.Get("Accept")).To(ContainSubstring("application/vnd.schemaregistry.v1+json"))
+					// Set mock response
+					res.Header().Set("Content-type", "application/vnd.schemaregistry.v1+json")
+					res.WriteHeader(200)
+					fmt.Fprintf(res, "%s", `[{"subject": "testString", "version": 1}]`)
+				}))
+			})
+			It(`Invoke ListSchemaVersionsByID successfully`, func() {
+				confluentregistryService, serviceErr := confluentregistryv1.NewConfluentregistryV1(&confluentregistryv1.ConfluentregistryV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(confluentregistryService).ToNot(BeNil())
+
+				// Invoke operation with nil options model (negative test)
+				_, response, operationErr := confluentregistryService.ListSchemaVersionsByID(nil)
+				Expect(operationErr).NotTo(BeNil())
+				Expect(response).To(BeNil())
+
+				// Construct an instance of the ListSchemaVersionsByIDOptions model
+				listSchemaVersionsByIDOptionsModel := confluentregistryService.NewListSchemaVersionsByIDOptions(38)
+				listSchemaVersionsByIDOptionsModel.SetHeaders(map[string]string{"x-custom-header": "x-custom-value"})
+
+				// Invoke operation with valid options model (positive test)
+				result, response, operationErr := confluentregistryService.ListSchemaVersionsByID(listSchemaVersionsByIDOptionsModel)
+				Expect(operationErr).To(BeNil())
+				Expect(response).ToNot(BeNil())
+				Expect(*result[0].Subject).To(Equal("testString"))
+
+				// Invoke operation with a Context
+				ctx, cancelFunc := context.WithTimeout(context.Background(), 5*time.Second)
+				defer cancelFunc()
+				_, _, operationErr = confluentregistryService.ListSchemaVersionsByIDWithContext(ctx, listSchemaVersionsByIDOptionsModel)
+				Expect(operationErr).To(BeNil())
+
+				// Construct a second instance of the ListSchemaVersionsByIDOptions model with no property values
+				listSchemaVersionsByIDOptionsModelNew := new(confluentregistryv1.ListSchemaVersionsByIDOptions)
+				// Invoke operation with invalid model (negative test)
+				_, response, operationErr = confluentregistryService.ListSchemaVersionsByID(listSchemaVersionsByIDOptionsModelNew)
+				Expect(operationErr).ToNot(BeNil())
+				Expect(response).To(BeNil())
+			})
+			AfterEach(func() {
+				testServer.Close()
+			})
+		})
+	})
+	Describe(`TestCompatibility(testCompatibilityOptions *TestCompatibilityOptions)`, func() {
+		testCompatibilityPath := "/compatibility/subjects/testString/versions/testString"
+		Context(`Using mock server endpoint`, func() {
+			BeforeEach(func() {
+				testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+					defer GinkgoRecover()
+
+					// Verify the contents of the request
+					Expect(req.URL.EscapedPath()).To(Equal(testCompatibilityPath))
+					Expect(req.Method).To(Equal("POST"))
+
+					Expect(req.URL.Query()["verbose"]).To(Equal([]string{"true"}))
+					Expect(req.Header.Get("Content-Type")).To(Equal("application/vnd.schemaregistry.v1+json"))
+					var body map[string]interface{}
+					Expect(json.NewDecoder(req.Body).Decode(&body)).To(Succeed())
+					Expect(body["schema"]).To(Equal("testString"))
+					Expect(body["schemaType"]).To(Equal("JSON"))
+					Expect(body["references"]).To(HaveLen(1))
+					Expect(req.Header.Get("Accept")).To(ContainSubstring("application/vnd.schemaregistry.v1+json"))
+					// Set mock response
+					res.Header().Set("Content-type", "application/vnd.schemaregistry.v1+json")
+					res.WriteHeader(200)
+					fmt.Fprintf(res, "%s", `{"is_compatible": false, "messages": ["testString"]}`)
+				}))
+			})
+			It(`Invoke TestCompatibility successfully`, func() {
+				confluentregistryService, serviceErr := confluentregistryv1.NewConfluentregistryV1(&confluentregistryv1.ConfluentregistryV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(confluentregistryService).ToNot(BeNil())
+
+				// Invoke operation with nil options model (negative test)
+				_, response, operationErr := confluentregistryService.TestCompatibility(nil)
+				Expect(operationErr).NotTo(BeNil())
+				Expect(response).To(BeNil())
+
+				// Construct an instance of the TestCompatibilityOptions model
+				testCompatibilityOptionsModel := confluentregistryService.NewTestCompatibilityOptions("testString", "testString", "testString")
+				testCompatibilityOptionsModel.SetSchemaType("JSON")
+				schemaReferenceModel, err := confluentregistryService.NewSchemaReference("testString", "testString", int64(1))
+				Expect(err).To(BeNil())
+				testCompatibilityOptionsModel.SetReferences([]confluentregistryv1.SchemaReference{*schemaReferenceModel})
+				testCompatibilityOptionsModel.SetVerbose(true)
+				testCompatibilityOptionsModel.SetHeaders(map[string]string{"x-custom-header": "x-custom-value"})
+
+				// Invoke operation with valid options model (positive test)
+				result, response, operationErr := confluentregistryService.TestCompatibility(testCompatibilityOptionsModel)
+				Expect(operationErr).To(BeNil())
+				Expect(response).ToNot(BeNil())
+				Expect(*result.IsCompatible).To(BeFalse())
+				Expect(result.Messages).To(Equal([]string{"testString"}))
+
+				// Invoke operation with a Context
+				ctx, cancelFunc := context.WithTimeout(context.Background(), 5*time.Second)
+				defer cancelFunc()
+				_, _, operationErr = confluentregistryService.TestCompatibilityWithContext(ctx, testCompatibilityOptionsModel)
+				Expect(operationErr).To(BeNil())
+
+				// Construct a second instance of the TestCompatibilityOptions model with no property values
+				testCompatibilityOptionsModelNew := new(confluentregistryv1.TestCompatibilityOptions)
+				// Invoke operation with invalid model (negative test)
+				_, response, operationErr = confluentregistryService.TestCompatibility(testCompatibilityOptionsModelNew)
+				Expect(operationErr).ToNot(BeNil())
+				Expect(response).To(BeNil())
+			})
+			AfterEach(func() {
+				testServer.Close()
+			})
+		})
+	})
+	Describe(`GetGlobalConfig(getGlobalConfigOptions *GetGlobalConfigOptions)`, func() {
+		getGlobalConfigPath := "/config"
+		Context(`Using mock server endpoint`, func() {
+			BeforeEach(func() {
+				testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+					defer GinkgoRecover()
+
+					// Verify the contents of the request
+					Expect(req.URL.EscapedPath()).To(Equal(getGlobalConfigPath))
+					Expect(req.Method).To(Equal("GET"))
+
+					Expect(req.Header.Get("Accept")).To(ContainSubstring("application/vnd.schemaregistry.v1+json"))
+					// Set mock response
+					res.Header().Set("Content-type", "application/vnd.schemaregistry.v1+json")
+					res.WriteHeader(200)
+					fmt.Fprintf(res, "%s", `{"compatibilityLevel": "BACKWARD"}`)
+				}))
+			})
+			It(`Invoke GetGlobalConfig successfully`, func() {
+				confluentregistryService, serviceErr := confluentregistryv1.NewConfluentregistryV1(&confluentregistryv1.ConfluentregistryV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(confluentregistryService).ToNot(BeNil())
+
+				// Construct an instance of the GetGlobalConfigOptions model
+				getGlobalConfigOptionsModel := confluentregistryService.NewGetGlobalConfigOptions()
+				getGlobalConfigOptionsModel.SetHeaders(map[string]string{"x-custom-header": "x-custom-value"})
+
+				// Invoke operation with valid options model (positive test)
+				result, response, operationErr := confluentregistryService.GetGlobalConfig(getGlobalConfigOptionsModel)
+				Expect(operationErr).To(BeNil())
+				Expect(response).ToNot(BeNil())
+				Expect(*result.CompatibilityLevel).To(Equal("BACKWARD"))
+
+				// Invoke operation with a Context
+				ctx, cancelFunc := context.WithTimeout(context.Background(), 5*time.Second)
+				defer cancelFunc()
+				_, _, operationErr = confluentregistryService.GetGlobalConfigWithContext(ctx, getGlobalConfigOptionsModel)
+				Expect(operationErr).To(BeNil())
+			})
+			AfterEach(func() {
+				testServer.Close()
+			})
+		})
+	})
+	Describe(`UpdateGlobalConfig(updateGlobalConfigOptions *UpdateGlobalConfigOptions)`, func() {
+		updateGlobalConfigPath := "/config"
+		Context(`Using mock server endpoint`, func() {
+			BeforeEach(func() {
+				testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+					defer GinkgoRecover()
+
+					// Verify the contents of the request
+					Expect(req.URL.EscapedPath()).To(Equal(updateGlobalConfigPath))
+					Expect(req.Method).To(Equal("PUT"))
+
+					Expect(req.Header.Get("Content-Type")).To(Equal("application/vnd.schemaregistry.v1+json"))
+					var body map[string]interface{}
+					Expect(json.NewDecoder(req.Body).Decode(&body)).To(Succeed())
+					Expect(body["compatibility"]).To(Equal("BACKWARD"))
+					Expect(req.Header.Get("Accept")).To(ContainSubstring("application/vnd.schemaregistry.v1+json"))
+					// Set mock response
+					res.Header().Set("Content-type", "application/vnd.schemaregistry.v1+json")
+					res.WriteHeader(200)
+					fmt.Fprintf(res, "%s", `{"compatibility": "BACKWARD"}`)
+				}))
+			})
+			It(`Invoke UpdateGlobalConfig successfully`, func() {
+				confluentregistryService, serviceErr := confluentregistryv1.NewConfluentregistryV1(&confluentregistryv1.ConfluentregistryV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(confluentregistryService).ToNot(BeNil())
+
+				// Invoke operation with nil options model (negative test)
+				_, response, operationErr := confluentregistryService.UpdateGlobalConfig(nil)
+				Expect(operationErr).NotTo(BeNil())
+				Expect(response).To(BeNil())
+
+				// Construct an instance of the UpdateGlobalConfigOptions model
+				updateGlobalConfigOptionsModel := confluentregistryService.NewUpdateGlobalConfigOptions(confluentregistryv1.UpdateGlobalConfigOptionsCompatibilityBackwardConst)
+				updateGlobalConfigOptionsModel.SetHeaders(map[string]string{"x-custom-header": "x-custom-value"})
+
+				// Invoke operation with valid options model (positive test)
+				result, response, operationErr := confluentregistryService.UpdateGlobalConfig(updateGlobalConfigOptionsModel)
+				Expect(operationErr).To(BeNil())
+				Expect(response).ToNot(BeNil())
+				Expect(*result.Compatibility).To(Equal("BACKWARD"))
+
+				// Invoke operation with a Context
+				ctx, cancelFunc := context.WithTimeout(context.Background(), 5*time.Second)
+				defer cancelFunc()
+				_, _, operationErr = confluentregistryService.UpdateGlobalConfigWithContext(ctx, updateGlobalConfigOptionsModel)
+				Expect(operationErr).To(BeNil())
+
+				// Construct a second instance of the UpdateGlobalConfigOptions model with no property values
+				updateGlobalConfigOptionsModelNew := new(confluentregistryv1.UpdateGlobalConfigOptions)
+				// Invoke operation with invalid model (negative test)
+				_, response, operationErr = confluentregistryService.UpdateGlobalConfig(updateGlobalConfigOptionsModelNew)
+				Expect(operationErr).ToNot(BeNil())
+				Expect(response).To(BeNil())
+			})
+			AfterEach(func() {
+				testServer.Close()
+			})
+		})
+	})
+	Describe(`GetSubjectConfig(getSubjectConfigOptions *GetSubjectConfigOptions)`, func() {
+		getSubjectConfigPath := "/config/testString"
+		Context(`Using mock server endpoint`, func() {
+			BeforeEach(func() {
+				testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+					defer GinkgoRecover()
+
+					// Verify the contents of the request
+					Expect(req.URL.EscapedPath()).To(Equal(getSubjectConfigPath))
+					Expect(req.Method).To(Equal("GET"))
+
+					Expect(req.URL.Query()["defaultToGlobal"]).To(Equal([]string{"true"}))
+					Expect(req.Header.Get("Accept")).To(ContainSubstring("application/vnd.schemaregistry.v1+json"))
+					// Set mock response
+					res.Header().Set("Content-type", "application/vnd.schemaregistry.v1+json")
+					res.WriteHeader(200)
+					fmt.Fprintf(res, "%s", `{"compatibilityLevel": "BACKWARD"}`)
+				}))
+			})
+			It(`Invoke GetSubjectConfig successfully`, func() {
+				confluentregistryService, serviceErr := confluentregistryv1.NewConfluentregistryV1(&confluentregistryv1.ConfluentregistryV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(confluentregistryService).ToNot(BeNil())
+
+				// Invoke operation with nil options model (negative test)
+				_, response, operationErr := confluentregistryService.GetSubjectConfig(nil)
+				Expect(operationErr).NotTo(BeNil())
+				Expect(response).To(BeNil())
+
+				// Construct an instance of the GetSubjectConfigOptions model
+				getSubjectConfigOptionsModel := confluentregistryService.NewGetSubjectConfigOptions("testString")
+				getSubjectConfigOptionsModel.SetDefaultToGlobal(true)
+				getSubjectConfigOptionsModel.SetHeaders(map[string]string{"x-custom-header": "x-custom-value"})
+
+				// Invoke operation with valid options model (positive test)
+				result, response, operationErr := confluentregistryService.GetSubjectConfig(getSubjectConfigOptionsModel)
+				Expect(operationErr).To(BeNil())
+				Expect(response).ToNot(BeNil())
+				Expect(*result.CompatibilityLevel).To(Equal("BACKWARD"))
+
+				// Invoke operation with a Context
+				ctx, cancelFunc := context.WithTimeout(context.Background(), 5*time.Second)
+				defer cancelFunc()
+				_, _, operationErr = confluentregistryService.GetSubjectConfigWithContext(ctx, getSubjectConfigOptionsModel)
+				Expect(operationErr).To(BeNil())
+
+				// Construct a second instance of the GetSubjectConfigOptions model with no property values
+				getSubjectConfigOptionsModelNew := new(confluentregistryv1.GetSubjectConfigOptions)
+				// Invoke operation with invalid model (negative test)
+				_, response, operationErr = confluentregistryService.GetSubjectConfig(getSubjectConfigOptionsModelNew)
+				Expect(operationErr).ToNot(BeNil())
+				Expect(response).To(BeNil())
+			})
+			AfterEach(func() {
+				testServer.Close()
+			})
+		})
+	})
+	Describe(`UpdateSubjectConfig(updateSubjectConfigOptions *UpdateSubjectConfigOptions)`, func() {
+		updateSubjectConfigPath := "/config/testString"
+		Context(`Using mock server endpoint`, func() {
+			BeforeEach(func() {
+				testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+					defer GinkgoRecover()
+
+					// Verify the contents of the request
+					Expect(req.URL.EscapedPath()).To(Equal(updateSubjectConfigPath))
+					Expect(req.Method).To(Equal("PUT"))
+
+					Expect(req.Header.Get("Content-Type")).To(Equal("application/vnd.schemaregistry.v1+json"))
+					var body map[string]interface{}
+					Expect(json.NewDecoder(req.Body).Decode(&body)).To(Succeed())
+					Expect(body["compatibility"]).To(Equal("BACKWARD"))
+					Expect(req.Header.Get("Accept")).To(ContainSubstring("application/vnd.schemaregistry.v1+json"))
+					// Set mock response
+					res.Header().Set("Content-type", "application/vnd.schemaregistry.v1+json")
+					res.WriteHeader(200)
+					fmt.Fprintf(res, "%s", `{"compatibility": "BACKWARD"}`)
+				}))
+			})
+			It(`Invoke UpdateSubjectConfig successfully`, func() {
+				confluentregistryService, serviceErr := confluentregistryv1.NewConfluentregistryV1(&confluentregistryv1.ConfluentregistryV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(confluentregistryService).ToNot(BeNil())
+
+				// Invoke operation with nil options model (negative test)
+				_, response, operationErr := confluentregistryService.UpdateSubjectConfig(nil)
+				Expect(operationErr).NotTo(BeNil())
+				Expect(response).To(BeNil())
+
+				// Construct an instance of the UpdateSubjectConfigOptions model
+				updateSubjectConfigOptionsModel := confluentregistryService.NewUpdateSubjectConfigOptions("testString", confluentregistryv1.UpdateSubjectConfigOptionsCompatibilityBackwardConst)
+				updateSubjectConfigOptionsModel.SetHeaders(map[string]string{"x-custom-header": "x-custom-value"})
+
+				// Invoke operation with valid options model (positive test)
+				result, response, operationErr := confluentregistryService.UpdateSubjectConfig(updateSubjectConfigOptionsModel)
+				Expect(operationErr).To(BeNil())
+				Expect(response).ToNot(BeNil())
+				Expect(*result.Compatibility).To(Equal("BACKWARD"))
+
+				// Invoke operation with a Context
+				ctx, cancelFunc := context.WithTimeout(context.Background(), 5*time.Second)
+				defer cancelFunc()
+				_, _, operationErr = confluentregistryService.UpdateSubjectConfigWithContext(ctx, updateSubjectConfigOptionsModel)
+				Expect(operationErr).To(BeNil())
+
+				// Construct a second instance of the UpdateSubjectConfigOptions model with no property values
+				updateSubjectConfigOptionsModelNew := new(confluentregistryv1.UpdateSubjectConfigOptions)
+				// Invoke operation with invalid model (negative test)
+				_, response, operationErr = confluentregistryService.UpdateSubjectConfig(updateSubjectConfigOptionsModelNew)
+				Expect(operationErr).ToNot(BeNil())
+				Expect(response).To(BeNil())
+			})
+			AfterEach(func() {
+				testServer.Close()
+			})
+		})
+	})
+	Describe(`DeleteSubjectConfig(deleteSubjectConfigOptions *DeleteSubjectConfigOptions)`, func() {
+		deleteSubjectConfigPath := "/config/testString"
+		Context(`Using mock server endpoint`, func() {
+			BeforeEach(func() {
+				testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+					defer GinkgoRecover()
+
+					// Verify the contents of the request
+					Expect(req.URL.EscapedPath()).To(Equal(deleteSubjectConfigPath))
+					Expect(req.Method).To(Equal("DELETE"))
+
+					Expect(req.Header.Get("Accept")).To(ContainSubstring("application/vnd.schemaregistry.v1+json"))
+					// Set mock response
+					res.Header().Set("Content-type", "application/vnd.schemaregistry.v1+json")
+					res.WriteHeader(200)
+					fmt.Fprintf(res, "%s", `{"compatibilityLevel": "BACKWARD"}`)
+				}))
+			})
+			It(`Invoke DeleteSubjectConfig successfully`, func() {
+				confluentregistryService, serviceErr := confluentregistryv1.NewConfluentregistryV1(&confluentregistryv1.ConfluentregistryV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(confluentregistryService).ToNot(BeNil())
+
+				// Invoke operation with nil options model (negative test)
+				_, response, operationErr := confluentregistryService.DeleteSubjectConfig(nil)
+				Expect(operationErr).NotTo(BeNil())
+				Expect(response).To(BeNil())
+
+				// Construct an instance of the DeleteSubjectConfigOptions model
+				deleteSubjectConfigOptionsModel := confluentregistryService.NewDeleteSubjectConfigOptions("testString")
+				deleteSubjectConfigOptionsModel.SetHeaders(map[string]string{"x-custom-header": "x-custom-value"})
+
+				// Invoke operation with valid options model (positive test)
+				result, response, operationErr := confluentregistryService.DeleteSubjectConfig(deleteSubjectConfigOptionsModel)
+				Expect(operationErr).To(BeNil())
+				Expect(response).ToNot(BeNil())
+				Expect(*result.CompatibilityLevel).To(Equal("BACKWARD"))
+
+				// Invoke operation with a Context
+				ctx, cancelFunc := context.WithTimeout(context.Background(), 5*time.Second)
+				defer cancelFunc()
+				_, _, operationErr = confluentregistryService.DeleteSubjectConfigWithContext(ctx, deleteSubjectConfigOptionsModel)
+				Expect(operationErr).To(BeNil())
+
+				// Construct a second instance of the DeleteSubjectConfigOptions model with no property values
+				deleteSubjectConfigOptionsModelNew := new(confluentregistryv1.DeleteSubjectConfigOptions)
+				// Invoke operation with invalid model (negative test)
+				_, response, operationErr = confluentregistryService.DeleteSubjectConfig(deleteSubjectConfigOptionsModelNew)
+				Expect(operationErr).ToNot(BeNil())
+				Expect(response).To(BeNil())
+			})
+			AfterEach(func() {
+				testServer.Close()
+			})
+		})
+	})
+	Describe(`Operation response error`, func() {
+		BeforeEach(func() {
+			testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				res.Header().Set("Content-type", "application/vnd.schemaregistry.v1+json")
+				res.WriteHeader(404)
+				fmt.Fprintf(res, "%s", `{"error_code": 40401, "message": "Subject 'testString' not found."}`)
+			}))
+		})
+		It(`Invoke GetSubjectVersion with error response`, func() {
+			confluentregistryService, serviceErr := confluentregistryv1.NewConfluentregistryV1(&confluentregistryv1.ConfluentregistryV1Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+
+			getSubjectVersionOptionsModel := confluentregistryService.NewGetSubjectVersionOptions("testString", "latest")
+			result, response, operationErr := confluentregistryService.GetSubjectVersion(getSubjectVersionOptionsModel)
+			Expect(operationErr).ToNot(BeNil())
+			Expect(operationErr.Error()).To(ContainSubstring("not found"))
+			Expect(response.StatusCode).To(Equal(404))
+			Expect(result).To(BeNil())
+		})
+		AfterEach(func() {
+			testServer.Close()
+		})
+	})
+})
+
+//
+// Utility functions used by the generated test code
+//
+
+func SetTestEnvironment(testEnvironment map[string]string) {
+	for key, value := range testEnvironment {
+		os.Setenv(key, value)
+	}
+}
+
+func ClearTestEnvironment(testEnvironment map[string]string) {
+	for key := range testEnvironment {
+		os.Unsetenv(key)
+	}
+}
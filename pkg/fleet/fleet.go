@@ -0,0 +1,268 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package fleet manages the clients of many Event Streams instances, selected by labels such as region or
+// environment, and runs operations across them concurrently, collecting a result or an error for each instance.
+package fleet
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM/eventstreams-go-sdk/pkg/adminrestv1"
+	common "github.com/IBM/eventstreams-go-sdk/pkg/common"
+	"github.com/IBM/eventstreams-go-sdk/pkg/config"
+	"github.com/IBM/eventstreams-go-sdk/pkg/schemaregistryv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Instance : The clients of one Event Streams instance of a fleet.
+type Instance struct {
+	// The unique name of the instance in the fleet.
+	Name string
+
+	// Labels that describe the instance, such as `region` or `environment`.
+	Labels map[string]string
+
+	// The admin REST API client.
+	Adminrest *adminrestv1.AdminrestV1
+
+	// The schema registry client, nil if the instance has no registry.
+	Registry *schemaregistryv1.SchemaregistryV1
+}
+
+// Selector : Selects the instances whose labels have all the values of the selector. An empty selector selects every
+// instance.
+type Selector map[string]string
+
+// Matches returns whether labels have all the values of the selector.
+func (selector Selector) Matches(labels map[string]string) bool {
+	for name, value := range selector {
+		if labelValue, ok := labels[name]; !ok || labelValue != value {
+			return false
+		}
+	}
+	return true
+}
+
+// Result : The outcome of an operation on one instance.
+type Result struct {
+	// The name of the instance.
+	Instance string
+
+	// The value returned by the operation, whose type depends on the operation.
+	Value interface{}
+
+	// The error returned by the operation.
+	Err error
+
+	// How long the operation took.
+	Duration time.Duration
+}
+
+// Results : The outcomes of an operation on the selected instances, sorted by instance name.
+type Results []Result
+
+// Errors returns the errors of the instances on which the operation failed, keyed by instance name.
+func (results Results) Errors() map[string]error {
+	errs := map[string]error{}
+	for _, result := range results {
+		if result.Err != nil {
+			errs[result.Instance] = result.Err
+		}
+	}
+	return errs
+}
+
+// Err returns an error that lists the instances on which the operation failed, or nil if it succeeded everywhere.
+func (results Results) Err() error {
+	var failures []string
+	for _, result := range results {
+		if result.Err != nil {
+			failures = append(failures, fmt.Sprintf("%s: %s", result.Instance, result.Err.Error()))
+		}
+	}
+	if len(failures) == 0 {
+		return nil
+	}
+	return core.SDKErrorf(nil, fmt.Sprintf("operation failed on %d of %d instances: %s", len(failures), len(results), strings.Join(failures, "; ")), "fleet-operation-error", common.GetComponentInfo())
+}
+
+// Values returns the values of the instances on which the operation succeeded, keyed by instance name.
+func (results Results) Values() map[string]interface{} {
+	values := map[string]interface{}{}
+	for _, result := range results {
+		if result.Err == nil {
+			values[result.Instance] = result.Value
+		}
+	}
+	return values
+}
+
+// Operation : An operation run on each instance by Fleet.Run.
+type Operation func(ctx context.Context, instance *Instance) (interface{}, error)
+
+// Fleet : A set of named Event Streams instances. A Fleet is safe for concurrent use.
+type Fleet struct {
+	mutex       sync.RWMutex
+	instances   map[string]*Instance
+	concurrency int
+}
+
+// NewFleet : constructs an empty Fleet.
+func NewFleet() *Fleet {
+	return &Fleet{instances: map[string]*Instance{}}
+}
+
+// SetConcurrency sets the maximum number of instances that Run operates on at the same time. Zero, the default,
+// means no limit.
+func (fleet *Fleet) SetConcurrency(concurrency int) *Fleet {
+	fleet.mutex.Lock()
+	defer fleet.mutex.Unlock()
+	fleet.concurrency = concurrency
+	return fleet
+}
+
+// Add adds an instance to the fleet. The instance must have a name that is not already used and an admin REST API
+// client.
+func (fleet *Fleet) Add(instance *Instance) (err error) {
+	err = core.ValidateNotNil(instance, "instance cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	if instance.Name == "" || instance.Adminrest == nil {
+		err = core.SDKErrorf(nil, "an instance needs a name and an admin REST API client", "invalid-instance", common.GetComponentInfo())
+		return
+	}
+	fleet.mutex.Lock()
+	defer fleet.mutex.Unlock()
+	if _, ok := fleet.instances[instance.Name]; ok {
+		err = core.SDKErrorf(nil, fmt.Sprintf("instance '%s' is already in the fleet", instance.Name), "duplicate-instance", common.GetComponentInfo())
+		return
+	}
+	fleet.instances[instance.Name] = instance
+	return
+}
+
+// AddFromConfig adds an instance whose clients are built by config.NewClients.
+func (fleet *Fleet) AddFromConfig(name string, labels map[string]string, instanceConfig *config.Config) (err error) {
+	err = core.ValidateNotNil(instanceConfig, "instanceConfig cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	adminrest, registry, err := instanceConfig.NewClients()
+	if err != nil {
+		return
+	}
+	return fleet.Add(&Instance{Name: name, Labels: labels, Adminrest: adminrest, Registry: registry})
+}
+
+// Remove removes the named instance from the fleet, if it is there.
+func (fleet *Fleet) Remove(name string) {
+	fleet.mutex.Lock()
+	defer fleet.mutex.Unlock()
+	delete(fleet.instances, name)
+}
+
+// Get returns the named instance, or nil if it is not in the fleet.
+func (fleet *Fleet) Get(name string) *Instance {
+	fleet.mutex.RLock()
+	defer fleet.mutex.RUnlock()
+	return fleet.instances[name]
+}
+
+// Instances returns the instances selected by selector, sorted by name.
+func (fleet *Fleet) Instances(selector Selector) []*Instance {
+	fleet.mutex.RLock()
+	defer fleet.mutex.RUnlock()
+	instances := []*Instance{}
+	for _, instance := range fleet.instances {
+		if selector.Matches(instance.Labels) {
+			instances = append(instances, instance)
+		}
+	}
+	sort.Slice(instances, func(i, j int) bool { return instances[i].Name < instances[j].Name })
+	return instances
+}
+
+// Run runs operation on the instances selected by selector concurrently, within the concurrency limit, and returns
+// the outcome on each of them. Instances that have not started when ctx is done fail with the context error.
+func (fleet *Fleet) Run(ctx context.Context, selector Selector, operation Operation) Results {
+	instances := fleet.Instances(selector)
+	fleet.mutex.RLock()
+	concurrency := fleet.concurrency
+	fleet.mutex.RUnlock()
+	if concurrency <= 0 || concurrency > len(instances) {
+		concurrency = len(instances)
+	}
+
+	results := make(Results, len(instances))
+	slots := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for i, instance := range instances {
+		results[i].Instance = instance.Name
+		wg.Add(1)
+		go func(result *Result, instance *Instance) {
+			defer wg.Done()
+			select {
+			case slots <- struct{}{}:
+			case <-ctx.Done():
+				result.Err = core.SDKErrorf(ctx.Err(), "", "context-done", common.GetComponentInfo())
+				return
+			}
+			defer func() { <-slots }()
+			if ctx.Err() != nil {
+				result.Err = core.SDKErrorf(ctx.Err(), "", "context-done", common.GetComponentInfo())
+				return
+			}
+			start := time.Now()
+			result.Value, result.Err = operation(ctx, instance)
+			result.Duration = time.Since(start)
+		}(&results[i], instance)
+	}
+	wg.Wait()
+	return results
+}
+
+// ListTopics runs ListAllTopics on the selected instances, so that every page of topics is read. The values are
+// []adminrestv1.TopicDetail.
+func (fleet *Fleet) ListTopics(ctx context.Context, selector Selector) Results {
+	return fleet.Run(ctx, selector, func(ctx context.Context, instance *Instance) (interface{}, error) {
+		return instance.Adminrest.ListAllTopicsWithContext(ctx, instance.Adminrest.NewListTopicsOptions())
+	})
+}
+
+// GetStatus runs GetStatus on the selected instances. The values are *adminrestv1.InstanceStatus.
+func (fleet *Fleet) GetStatus(ctx context.Context, selector Selector) Results {
+	return fleet.Run(ctx, selector, func(ctx context.Context, instance *Instance) (interface{}, error) {
+		status, _, err := instance.Adminrest.GetStatusWithContext(ctx, instance.Adminrest.NewGetStatusOptions())
+		return status, err
+	})
+}
+
+// ApplyQuotas runs ApplyQuotas with the same options on the selected instances. The values are *adminrestv1.QuotaPlan,
+// which are also returned with errors to show the changes made before the failure.
+func (fleet *Fleet) ApplyQuotas(ctx context.Context, selector Selector, applyQuotasOptions *adminrestv1.ApplyQuotasOptions) Results {
+	return fleet.Run(ctx, selector, func(ctx context.Context, instance *Instance) (interface{}, error) {
+		return instance.Adminrest.ApplyQuotasWithContext(ctx, applyQuotasOptions)
+	})
+}
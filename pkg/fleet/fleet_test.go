@@ -0,0 +1,181 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package fleet
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/IBM/eventstreams-go-sdk/pkg/adminrestv1"
+	"github.com/IBM/eventstreams-go-sdk/pkg/config"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/stretchr/testify/assert"
+)
+
+// newTestFleet starts an admin REST API for each instance, which reports the given status, or fails when the status
+// is empty.
+func newTestFleet(t *testing.T, statuses map[string]string, labels map[string]map[string]string) (*Fleet, func()) {
+	fleet := NewFleet()
+	var servers []*httptest.Server
+	for name, status := range statuses {
+		status := status
+		server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			res.Header().Set("Content-type", "application/json")
+			if status == "" {
+				res.WriteHeader(500)
+				fmt.Fprint(res, `{"error_code": 500, "message": "unavailable"}`)
+				return
+			}
+			switch req.URL.EscapedPath() {
+			case "/admin/status":
+				res.WriteHeader(200)
+				fmt.Fprintf(res, `{"status": %q}`, status)
+			case "/admin/topics":
+				// The topics are listed on two pages.
+				res.Header().Set("X-Total-Count", "2")
+				res.WriteHeader(200)
+				if req.URL.Query().Get("page") == "2" {
+					fmt.Fprintf(res, `[{"name": "payments-%s"}]`, status)
+					return
+				}
+				fmt.Fprintf(res, `[{"name": "orders-%s"}]`, status)
+			default:
+				t.Errorf("unexpected request %s", req.URL.EscapedPath())
+			}
+		}))
+		servers = append(servers, server)
+		adminrest, err := adminrestv1.NewAdminrestV1(&adminrestv1.AdminrestV1Options{
+			URL:           server.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		assert.Nil(t, err)
+		assert.Nil(t, fleet.Add(&Instance{Name: name, Labels: labels[name], Adminrest: adminrest}))
+	}
+	return fleet, func() {
+		for _, server := range servers {
+			server.Close()
+		}
+	}
+}
+
+func TestSelector(t *testing.T) {
+	assert.True(t, Selector{}.Matches(nil))
+	assert.True(t, Selector{"region": "eu-de"}.Matches(map[string]string{"region": "eu-de", "environment": "prod"}))
+	assert.False(t, Selector{"region": "eu-de", "environment": "dev"}.Matches(map[string]string{"region": "eu-de", "environment": "prod"}))
+	assert.False(t, Selector{"region": ""}.Matches(map[string]string{}))
+}
+
+func TestInstances(t *testing.T) {
+	fleet, closeServers := newTestFleet(t, map[string]string{"b": "available", "a": "available", "c": "available"}, map[string]map[string]string{
+		"a": {"region": "us-south", "environment": "prod"},
+		"b": {"region": "eu-de", "environment": "prod"},
+		"c": {"region": "eu-de", "environment": "dev"},
+	})
+	defer closeServers()
+
+	names := func(instances []*Instance) (names []string) {
+		for _, instance := range instances {
+			names = append(names, instance.Name)
+		}
+		return
+	}
+	assert.Equal(t, []string{"a", "b", "c"}, names(fleet.Instances(nil)))
+	assert.Equal(t, []string{"b", "c"}, names(fleet.Instances(Selector{"region": "eu-de"})))
+	assert.Equal(t, []string{"a", "b"}, names(fleet.Instances(Selector{"environment": "prod"})))
+
+	assert.NotNil(t, fleet.Add(&Instance{Name: "a", Adminrest: fleet.Get("a").Adminrest}))
+	assert.NotNil(t, fleet.Add(&Instance{Name: "d"}))
+	assert.NotNil(t, fleet.Add(nil))
+	fleet.Remove("a")
+	assert.Nil(t, fleet.Get("a"))
+
+	assert.Nil(t, fleet.AddFromConfig("d", map[string]string{"region": "eu-gb"}, &config.Config{AdminURL: "https://admin.example.com", APIKey: "key"}))
+	assert.NotNil(t, fleet.Get("d").Registry)
+	assert.NotNil(t, fleet.AddFromConfig("e", nil, &config.Config{AdminURL: "https://admin.example.com"}))
+}
+
+func TestRun(t *testing.T) {
+	fleet, closeServers := newTestFleet(t, map[string]string{"a": "available", "b": "", "c": "degraded"}, map[string]map[string]string{
+		"a": {"region": "us-south"},
+		"b": {"region": "eu-de"},
+		"c": {"region": "eu-de"},
+	})
+	defer closeServers()
+	ctx := context.Background()
+
+	results := fleet.GetStatus(ctx, nil)
+	assert.Len(t, results, 3)
+	assert.Equal(t, []string{"a", "b", "c"}, []string{results[0].Instance, results[1].Instance, results[2].Instance})
+	assert.Equal(t, "available", *results[0].Value.(*adminrestv1.InstanceStatus).Status)
+	assert.Equal(t, "degraded", *results[2].Value.(*adminrestv1.InstanceStatus).Status)
+	assert.Len(t, results.Errors(), 1)
+	assert.NotNil(t, results.Errors()["b"])
+	assert.Len(t, results.Values(), 2)
+	assert.Contains(t, results.Err().Error(), "operation failed on 1 of 3 instances: b: ")
+
+	results = fleet.ListTopics(ctx, Selector{"region": "eu-de"})
+	assert.Len(t, results, 2)
+	topics := results[1].Value.([]adminrestv1.TopicDetail)
+	assert.Len(t, topics, 2)
+	assert.Equal(t, "orders-degraded", *topics[0].Name)
+	assert.Equal(t, "payments-degraded", *topics[1].Name)
+
+	results = fleet.ListTopics(ctx, Selector{"region": "us-south"})
+	assert.Nil(t, results.Err())
+
+	results = fleet.ApplyQuotas(ctx, nil, nil)
+	assert.Len(t, results.Errors(), 3)
+}
+
+func TestRunConcurrency(t *testing.T) {
+	statuses := map[string]string{}
+	for i := 0; i < 6; i++ {
+		statuses[fmt.Sprintf("instance-%d", i)] = "available"
+	}
+	fleet, closeServers := newTestFleet(t, statuses, nil)
+	defer closeServers()
+
+	var running, peak int32
+	var mutex sync.Mutex
+	operation := func(ctx context.Context, instance *Instance) (interface{}, error) {
+		current := atomic.AddInt32(&running, 1)
+		mutex.Lock()
+		if current > peak {
+			peak = current
+		}
+		mutex.Unlock()
+		time.Sleep(10 * time.Millisecond)
+		atomic.AddInt32(&running, -1)
+		return instance.Name, nil
+	}
+
+	results := fleet.SetConcurrency(2).Run(context.Background(), nil, operation)
+	assert.Nil(t, results.Err())
+	assert.Equal(t, int32(2), peak)
+	assert.Equal(t, "instance-5", results[5].Value)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	results = fleet.Run(ctx, nil, operation)
+	assert.Len(t, results.Errors(), 6)
+}
@@ -0,0 +1,304 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package health checks that an Event Streams instance is usable, combining the admin REST API liveness and instance
+// status with the state of the cluster and the reachability of the schema registry, and serves the result to
+// net/http health endpoints such as Kubernetes readiness probes.
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/IBM/eventstreams-go-sdk/pkg/adminrestv1"
+	"github.com/IBM/eventstreams-go-sdk/pkg/schemaregistryv1"
+)
+
+// Status : The health of an instance or of one of its checks.
+type Status string
+
+// The health statuses, from best to worst.
+const (
+	// StatusHealthy means that the check passed.
+	StatusHealthy Status = "healthy"
+
+	// StatusDegraded means that the instance can be used, but some operations may fail.
+	StatusDegraded Status = "degraded"
+
+	// StatusUnhealthy means that the instance cannot be used.
+	StatusUnhealthy Status = "unhealthy"
+)
+
+var statusRank = map[Status]int{
+	StatusHealthy:   0,
+	StatusDegraded:  1,
+	StatusUnhealthy: 2,
+}
+
+// Constants associated with the CheckResult.Name property.
+const (
+	// CheckAlive calls Alive on the admin REST API.
+	CheckAlive = "alive"
+
+	// CheckInstanceStatus calls GetStatus on the admin REST API.
+	CheckInstanceStatus = "instance_status"
+
+	// CheckBrokers compares the number of brokers reported by GetCluster with the expected number.
+	CheckBrokers = "brokers"
+
+	// CheckController checks that GetCluster reports a controller.
+	CheckController = "controller"
+
+	// CheckSchemaRegistry gets the global compatibility rule of the schema registry.
+	CheckSchemaRegistry = "schema_registry"
+)
+
+// DefaultTimeout is the time allowed for all the checks of a Check call when the checker has no timeout set.
+const DefaultTimeout = 10 * time.Second
+
+// CheckResult : The result of a single check.
+type CheckResult struct {
+	// The name of the check, one of the Check constants.
+	Name string `json:"name"`
+
+	// The result of the check.
+	Status Status `json:"status"`
+
+	// A description of the result.
+	Message string `json:"message"`
+
+	// The time taken by the request that the check made. Checks that share a request report the same latency.
+	Latency time.Duration `json:"-"`
+}
+
+// MarshalJSON writes the latency of the check in milliseconds, as latency_ms.
+func (result CheckResult) MarshalJSON() ([]byte, error) {
+	type checkResult CheckResult
+	return json.Marshal(struct {
+		checkResult
+		LatencyMillis float64 `json:"latency_ms"`
+	}{checkResult(result), float64(result.Latency) / float64(time.Millisecond)})
+}
+
+// Result : The aggregated health of an instance.
+type Result struct {
+	// The worst status of the checks.
+	Status Status `json:"status"`
+
+	// The results of the checks, in the order of the Check constants.
+	Checks []CheckResult `json:"checks"`
+
+	// When the checks started.
+	Time time.Time `json:"time"`
+}
+
+// Ready returns whether the instance can be used, that is whether it is healthy or degraded.
+func (result *Result) Ready() bool {
+	return result.Status != StatusUnhealthy
+}
+
+// Check returns the result of the named check, or nil if it was not run.
+func (result *Result) Check(name string) *CheckResult {
+	for i := range result.Checks {
+		if result.Checks[i].Name == name {
+			return &result.Checks[i]
+		}
+	}
+	return nil
+}
+
+// Checker : Checks the health of an Event Streams instance. The schema registry check only runs when a registry
+// client is set. A Checker is safe for concurrent use once configured.
+type Checker struct {
+	adminrest       *adminrestv1.AdminrestV1
+	registry        *schemaregistryv1.SchemaregistryV1
+	expectedBrokers int
+	timeout         time.Duration
+}
+
+// NewChecker : constructs a Checker for the instance served by adminrest. registry may be nil to skip the schema
+// registry check.
+func NewChecker(adminrest *adminrestv1.AdminrestV1, registry *schemaregistryv1.SchemaregistryV1) *Checker {
+	return &Checker{
+		adminrest: adminrest,
+		registry:  registry,
+		timeout:   DefaultTimeout,
+	}
+}
+
+// SetExpectedBrokers sets the number of brokers the cluster should have. Fewer brokers make the instance degraded.
+// When not set, any number of brokers other than zero is healthy.
+func (checker *Checker) SetExpectedBrokers(expectedBrokers int) *Checker {
+	checker.expectedBrokers = expectedBrokers
+	return checker
+}
+
+// SetTimeout sets the time allowed for all the checks of a Check call. Zero means no timeout beyond that of the
+// context passed to Check.
+func (checker *Checker) SetTimeout(timeout time.Duration) *Checker {
+	checker.timeout = timeout
+	return checker
+}
+
+// Check runs the checks concurrently and returns their aggregated result. Failing checks are reported in the result
+// rather than as an error.
+//
+// The instance is unhealthy when the admin REST API is not alive, its status cannot be read or is offline, or the
+// cluster has no brokers. It is degraded when its status is degraded or unknown, it has fewer brokers than expected,
+// it has no controller, or the schema registry cannot be reached.
+func (checker *Checker) Check(ctx context.Context) *Result {
+	if checker.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, checker.timeout)
+		defer cancel()
+	}
+	result := &Result{Time: time.Now()}
+
+	var alive, status, registry []CheckResult
+	var cluster []CheckResult
+	var wg sync.WaitGroup
+	run := func(target *[]CheckResult, check func(context.Context) []CheckResult) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			*target = check(ctx)
+		}()
+	}
+	run(&alive, checker.checkAlive)
+	run(&status, checker.checkInstanceStatus)
+	run(&cluster, checker.checkCluster)
+	if checker.registry != nil {
+		run(&registry, checker.checkSchemaRegistry)
+	}
+	wg.Wait()
+
+	for _, checks := range [][]CheckResult{alive, status, cluster, registry} {
+		result.Checks = append(result.Checks, checks...)
+	}
+	result.Status = StatusHealthy
+	for _, check := range result.Checks {
+		if statusRank[check.Status] > statusRank[result.Status] {
+			result.Status = check.Status
+		}
+	}
+	return result
+}
+
+func (checker *Checker) checkAlive(ctx context.Context) []CheckResult {
+	start := time.Now()
+	_, err := checker.adminrest.AliveWithContext(ctx, checker.adminrest.NewAliveOptions())
+	check := CheckResult{Name: CheckAlive, Status: StatusHealthy, Message: "admin REST API is alive", Latency: time.Since(start)}
+	if err != nil {
+		check.Status = StatusUnhealthy
+		check.Message = "admin REST API is not alive: " + err.Error()
+	}
+	return []CheckResult{check}
+}
+
+func (checker *Checker) checkInstanceStatus(ctx context.Context) []CheckResult {
+	start := time.Now()
+	instanceStatus, _, err := checker.adminrest.GetStatusWithContext(ctx, checker.adminrest.NewGetStatusOptions())
+	check := CheckResult{Name: CheckInstanceStatus, Latency: time.Since(start)}
+	if err != nil {
+		check.Status = StatusUnhealthy
+		check.Message = "failed to get instance status: " + err.Error()
+		return []CheckResult{check}
+	}
+	value := adminrestv1.InstanceStatusStatusUnknownConst
+	if instanceStatus != nil && instanceStatus.Status != nil {
+		value = *instanceStatus.Status
+	}
+	check.Message = "instance is " + value
+	switch value {
+	case adminrestv1.InstanceStatusStatusAvailableConst:
+		check.Status = StatusHealthy
+	case adminrestv1.InstanceStatusStatusOfflineConst:
+		check.Status = StatusUnhealthy
+	default:
+		check.Status = StatusDegraded
+	}
+	return []CheckResult{check}
+}
+
+func (checker *Checker) checkCluster(ctx context.Context) []CheckResult {
+	start := time.Now()
+	cluster, _, err := checker.adminrest.GetClusterWithContext(ctx, checker.adminrest.NewGetClusterOptions())
+	latency := time.Since(start)
+	brokers := CheckResult{Name: CheckBrokers, Latency: latency}
+	controller := CheckResult{Name: CheckController, Latency: latency}
+	if err != nil {
+		brokers.Status = StatusUnhealthy
+		brokers.Message = "failed to get cluster: " + err.Error()
+		controller.Status = StatusUnhealthy
+		controller.Message = brokers.Message
+		return []CheckResult{brokers, controller}
+	}
+
+	count := len(cluster.Brokers)
+	switch {
+	case count == 0:
+		brokers.Status = StatusUnhealthy
+		brokers.Message = "cluster has no brokers"
+	case count < checker.expectedBrokers:
+		brokers.Status = StatusDegraded
+		brokers.Message = fmt.Sprintf("cluster has %d brokers, expected %d", count, checker.expectedBrokers)
+	default:
+		brokers.Status = StatusHealthy
+		brokers.Message = fmt.Sprintf("cluster has %d brokers", count)
+	}
+
+	if cluster.Controller != nil && cluster.Controller.ID != nil {
+		controller.Status = StatusHealthy
+		controller.Message = fmt.Sprintf("broker %d is the controller", *cluster.Controller.ID)
+	} else {
+		controller.Status = StatusDegraded
+		controller.Message = "cluster has no controller"
+	}
+	return []CheckResult{brokers, controller}
+}
+
+func (checker *Checker) checkSchemaRegistry(ctx context.Context) []CheckResult {
+	start := time.Now()
+	getGlobalRuleOptions := checker.registry.NewGetGlobalRuleOptions(schemaregistryv1.RuleTypeCompatibilityConst)
+	_, _, err := checker.registry.GetGlobalRuleWithContext(ctx, getGlobalRuleOptions)
+	check := CheckResult{Name: CheckSchemaRegistry, Status: StatusHealthy, Message: "schema registry is reachable", Latency: time.Since(start)}
+	if err != nil {
+		check.Status = StatusDegraded
+		check.Message = "schema registry is not reachable: " + err.Error()
+	}
+	return []CheckResult{check}
+}
+
+// Handler returns a net/http handler that runs the checks for every request and writes the Result as JSON. The
+// response status is 200 OK when the instance is ready, and 503 Service Unavailable when it is unhealthy, so that the
+// handler can serve a Kubernetes readiness probe.
+func (checker *Checker) Handler() http.Handler {
+	return http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		result := checker.Check(req.Context())
+		res.Header().Set("Content-Type", "application/json")
+		res.Header().Set("Cache-Control", "no-store")
+		if result.Ready() {
+			res.WriteHeader(http.StatusOK)
+		} else {
+			res.WriteHeader(http.StatusServiceUnavailable)
+		}
+		_ = json.NewEncoder(res).Encode(result)
+	})
+}
@@ -0,0 +1,211 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/IBM/eventstreams-go-sdk/pkg/adminrestv1"
+	"github.com/IBM/eventstreams-go-sdk/pkg/schemaregistryv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/stretchr/testify/assert"
+)
+
+type testInstance struct {
+	alive      bool
+	status     string
+	cluster    string
+	registryUp bool
+}
+
+// newTestChecker starts an admin REST API and a schema registry that behave as described by instance.
+func newTestChecker(t *testing.T, instance *testInstance) (*Checker, func()) {
+	adminServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		res.Header().Set("Content-type", "application/json")
+		switch req.URL.EscapedPath() {
+		case "/alive":
+			if !instance.alive {
+				res.WriteHeader(503)
+				return
+			}
+			res.WriteHeader(200)
+		case "/admin/status":
+			res.WriteHeader(200)
+			fmt.Fprintf(res, `{"status": %q}`, instance.status)
+		case "/admin/cluster":
+			res.WriteHeader(200)
+			fmt.Fprint(res, instance.cluster)
+		default:
+			t.Errorf("unexpected admin request %s", req.URL.EscapedPath())
+		}
+	}))
+	registryServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "/rules/COMPATIBILITY", req.URL.EscapedPath())
+		res.Header().Set("Content-type", "application/json")
+		if !instance.registryUp {
+			res.WriteHeader(500)
+			return
+		}
+		res.WriteHeader(200)
+		fmt.Fprint(res, `{"type": "COMPATIBILITY", "config": "BACKWARD"}`)
+	}))
+
+	adminrest, err := adminrestv1.NewAdminrestV1(&adminrestv1.AdminrestV1Options{
+		URL:           adminServer.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	assert.Nil(t, err)
+	registry, err := schemaregistryv1.NewSchemaregistryV1(&schemaregistryv1.SchemaregistryV1Options{
+		URL:           registryServer.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	assert.Nil(t, err)
+	return NewChecker(adminrest, registry).SetExpectedBrokers(3), func() {
+		adminServer.Close()
+		registryServer.Close()
+	}
+}
+
+func checkStatuses(result *Result) map[string]Status {
+	statuses := map[string]Status{}
+	for _, check := range result.Checks {
+		statuses[check.Name] = check.Status
+	}
+	return statuses
+}
+
+func TestCheckHealthy(t *testing.T) {
+	checker, closeServers := newTestChecker(t, &testInstance{
+		alive:      true,
+		status:     "available",
+		cluster:    `{"id": "c", "controller": {"id": 1}, "brokers": [{"id": 0}, {"id": 1}, {"id": 2}]}`,
+		registryUp: true,
+	})
+	defer closeServers()
+
+	result := checker.Check(context.Background())
+	assert.Equal(t, StatusHealthy, result.Status)
+	assert.True(t, result.Ready())
+	names := []string{}
+	for _, check := range result.Checks {
+		names = append(names, check.Name)
+		assert.True(t, check.Latency > 0)
+	}
+	assert.Equal(t, []string{CheckAlive, CheckInstanceStatus, CheckBrokers, CheckController, CheckSchemaRegistry}, names)
+	assert.Equal(t, "cluster has 3 brokers", result.Check(CheckBrokers).Message)
+	assert.Equal(t, "broker 1 is the controller", result.Check(CheckController).Message)
+}
+
+func TestCheckDegraded(t *testing.T) {
+	checker, closeServers := newTestChecker(t, &testInstance{
+		alive:      true,
+		status:     "degraded",
+		cluster:    `{"id": "c", "brokers": [{"id": 0}, {"id": 1}]}`,
+		registryUp: false,
+	})
+	defer closeServers()
+
+	result := checker.Check(context.Background())
+	assert.Equal(t, StatusDegraded, result.Status)
+	assert.True(t, result.Ready())
+	assert.Equal(t, map[string]Status{
+		CheckAlive:          StatusHealthy,
+		CheckInstanceStatus: StatusDegraded,
+		CheckBrokers:        StatusDegraded,
+		CheckController:     StatusDegraded,
+		CheckSchemaRegistry: StatusDegraded,
+	}, checkStatuses(result))
+	assert.Equal(t, "cluster has 2 brokers, expected 3", result.Check(CheckBrokers).Message)
+}
+
+func TestCheckUnhealthy(t *testing.T) {
+	instance := &testInstance{
+		alive:      false,
+		status:     "offline",
+		cluster:    `{"id": "c", "brokers": []}`,
+		registryUp: true,
+	}
+	checker, closeServers := newTestChecker(t, instance)
+	defer closeServers()
+
+	result := checker.Check(context.Background())
+	assert.Equal(t, StatusUnhealthy, result.Status)
+	assert.False(t, result.Ready())
+	statuses := checkStatuses(result)
+	assert.Equal(t, StatusUnhealthy, statuses[CheckAlive])
+	assert.Equal(t, StatusUnhealthy, statuses[CheckInstanceStatus])
+	assert.Equal(t, StatusUnhealthy, statuses[CheckBrokers])
+
+	checker.registry = nil
+	result = checker.Check(context.Background())
+	assert.Nil(t, result.Check(CheckSchemaRegistry))
+}
+
+func TestCheckTimeout(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		<-release
+	}))
+	defer server.Close()
+	defer close(release)
+	adminrest, err := adminrestv1.NewAdminrestV1(&adminrestv1.AdminrestV1Options{
+		URL:           server.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	assert.Nil(t, err)
+
+	start := time.Now()
+	result := NewChecker(adminrest, nil).SetTimeout(50 * time.Millisecond).Check(context.Background())
+	assert.Equal(t, StatusUnhealthy, result.Status)
+	assert.Less(t, time.Since(start), 5*time.Second)
+}
+
+func TestHandler(t *testing.T) {
+	instance := &testInstance{
+		alive:      true,
+		status:     "available",
+		cluster:    `{"id": "c", "controller": {"id": 0}, "brokers": [{"id": 0}, {"id": 1}, {"id": 2}]}`,
+		registryUp: true,
+	}
+	checker, closeServers := newTestChecker(t, instance)
+	defer closeServers()
+	server := httptest.NewServer(checker.Handler())
+	defer server.Close()
+
+	response, err := http.Get(server.URL)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	var body map[string]interface{}
+	assert.Nil(t, json.NewDecoder(response.Body).Decode(&body))
+	response.Body.Close()
+	assert.Equal(t, "healthy", body["status"])
+	checks := body["checks"].([]interface{})
+	assert.Len(t, checks, 5)
+	assert.Contains(t, checks[0], "latency_ms")
+
+	instance.alive = false
+	response, err = http.Get(server.URL)
+	assert.Nil(t, err)
+	response.Body.Close()
+	assert.Equal(t, http.StatusServiceUnavailable, response.StatusCode)
+}
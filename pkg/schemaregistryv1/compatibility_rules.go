@@ -0,0 +1,425 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schemaregistryv1
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+
+	common "github.com/IBM/eventstreams-go-sdk/pkg/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Constants associated with the EffectiveRule.Source property.
+// Where the rule that applies to a schema is configured.
+const (
+	EffectiveRuleSourceSchemaConst = "schema"
+	EffectiveRuleSourceGlobalConst = "global"
+)
+
+// Constants associated with the SchemaRuleChange.Action property.
+// The operation needed to bring the compatibility rule of a schema to the desired config.
+const (
+	SchemaRuleChangeActionCreateConst = "create"
+	SchemaRuleChangeActionUpdateConst = "update"
+	SchemaRuleChangeActionDeleteConst = "delete"
+)
+
+// The configs of the COMPATIBILITY rule.
+var compatibilityConfigs = map[string]bool{
+	UpdateSchemaRuleOptionsConfigBackwardConst:           true,
+	UpdateSchemaRuleOptionsConfigBackwardTransitiveConst: true,
+	UpdateSchemaRuleOptionsConfigForwardConst:            true,
+	UpdateSchemaRuleOptionsConfigForwardTransitiveConst:  true,
+	UpdateSchemaRuleOptionsConfigFullConst:               true,
+	UpdateSchemaRuleOptionsConfigFullTransitiveConst:     true,
+	UpdateSchemaRuleOptionsConfigNoneConst:               true,
+}
+
+// EffectiveRule : The compatibility rule that applies to a schema.
+type EffectiveRule struct {
+	// The ID of the schema.
+	SchemaID string `json:"schema_id"`
+
+	// The config of the COMPATIBILITY rule that applies.
+	Config string `json:"config"`
+
+	// Where the rule is configured, one of the EffectiveRuleSource constants.
+	Source string `json:"source"`
+}
+
+// GetEffectiveRuleOptions : The GetEffectiveRule options.
+type GetEffectiveRuleOptions struct {
+	// The ID of the schema.
+	ID *string `json:"id" validate:"required,ne="`
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewGetEffectiveRuleOptions : Instantiate GetEffectiveRuleOptions
+func (*SchemaregistryV1) NewGetEffectiveRuleOptions(id string) *GetEffectiveRuleOptions {
+	return &GetEffectiveRuleOptions{
+		ID: core.StringPtr(id),
+	}
+}
+
+// SetID : Allow user to set ID
+func (_options *GetEffectiveRuleOptions) SetID(id string) *GetEffectiveRuleOptions {
+	_options.ID = core.StringPtr(id)
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *GetEffectiveRuleOptions) SetHeaders(param map[string]string) *GetEffectiveRuleOptions {
+	options.Headers = param
+	return options
+}
+
+// ListEffectiveRulesOptions : The ListEffectiveRules options.
+type ListEffectiveRulesOptions struct {
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewListEffectiveRulesOptions : Instantiate ListEffectiveRulesOptions
+func (*SchemaregistryV1) NewListEffectiveRulesOptions() *ListEffectiveRulesOptions {
+	return &ListEffectiveRulesOptions{}
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *ListEffectiveRulesOptions) SetHeaders(param map[string]string) *ListEffectiveRulesOptions {
+	options.Headers = param
+	return options
+}
+
+// SchemaRuleChange : A single change needed to bring the compatibility rule of a schema to the desired config.
+type SchemaRuleChange struct {
+	// The operation, one of the SchemaRuleChangeAction constants.
+	Action string `json:"action"`
+
+	// The ID of the schema whose rule changes.
+	SchemaID string `json:"schema_id"`
+
+	// The current config of the schema's rule, nil when the schema has no rule of its own.
+	Current *string `json:"current,omitempty"`
+
+	// The desired config, nil when the schema's rule is deleted so that the global rule applies.
+	Desired *string `json:"desired,omitempty"`
+
+	// Whether the change has been applied.
+	Applied bool `json:"applied"`
+}
+
+// SchemaRulePlan : The changes needed to reconcile the compatibility rules of schemas with a desired state.
+type SchemaRulePlan struct {
+	// The changes, ordered by schema ID.
+	Changes []SchemaRuleChange `json:"changes"`
+}
+
+// ApplySchemaRulesOptions : The PlanSchemaRules and ApplySchemaRules options.
+type ApplySchemaRulesOptions struct {
+	// The desired configs of the COMPATIBILITY rules of schemas, keyed by schema ID. An empty config means that the
+	// schema has no rule of its own, so that the global rule applies.
+	Desired map[string]string `validate:"required"`
+
+	// When true, the rules of schemas that are not in Desired are deleted.
+	Prune *bool
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewApplySchemaRulesOptions : Instantiate ApplySchemaRulesOptions
+func (*SchemaregistryV1) NewApplySchemaRulesOptions(desired map[string]string) *ApplySchemaRulesOptions {
+	return &ApplySchemaRulesOptions{
+		Desired: desired,
+	}
+}
+
+// SetDesired : Allow user to set Desired
+func (_options *ApplySchemaRulesOptions) SetDesired(desired map[string]string) *ApplySchemaRulesOptions {
+	_options.Desired = desired
+	return _options
+}
+
+// SetPrune : Allow user to set Prune
+func (_options *ApplySchemaRulesOptions) SetPrune(prune bool) *ApplySchemaRulesOptions {
+	_options.Prune = core.BoolPtr(prune)
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *ApplySchemaRulesOptions) SetHeaders(param map[string]string) *ApplySchemaRulesOptions {
+	options.Headers = param
+	return options
+}
+
+// GetEffectiveRule : Get the compatibility rule that applies to a schema
+// Returns the schema's own COMPATIBILITY rule if it has one, and the global rule otherwise.
+func (schemaregistry *SchemaregistryV1) GetEffectiveRule(getEffectiveRuleOptions *GetEffectiveRuleOptions) (result *EffectiveRule, err error) {
+	result, err = schemaregistry.GetEffectiveRuleWithContext(context.Background(), getEffectiveRuleOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetEffectiveRuleWithContext is an alternate form of the GetEffectiveRule method which supports a Context parameter
+func (schemaregistry *SchemaregistryV1) GetEffectiveRuleWithContext(ctx context.Context, getEffectiveRuleOptions *GetEffectiveRuleOptions) (result *EffectiveRule, err error) {
+	err = core.ValidateNotNil(getEffectiveRuleOptions, "getEffectiveRuleOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(getEffectiveRuleOptions, "getEffectiveRuleOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+
+	id := *getEffectiveRuleOptions.ID
+	config, err := schemaregistry.schemaRuleConfig(ctx, id, getEffectiveRuleOptions.Headers)
+	if err != nil {
+		return
+	}
+	if config != nil {
+		result = &EffectiveRule{SchemaID: id, Config: *config, Source: EffectiveRuleSourceSchemaConst}
+		return
+	}
+	globalConfig, err := schemaregistry.globalRuleConfig(ctx, getEffectiveRuleOptions.Headers)
+	if err != nil {
+		return
+	}
+	result = &EffectiveRule{SchemaID: id, Config: globalConfig, Source: EffectiveRuleSourceGlobalConst}
+	return
+}
+
+// ListEffectiveRules : List the compatibility rules that apply to all schemas
+// Lists the schemas with ListSchemas and returns the effective rule of each, ordered by schema ID. The global rule is
+// retrieved once.
+func (schemaregistry *SchemaregistryV1) ListEffectiveRules(listEffectiveRulesOptions *ListEffectiveRulesOptions) (result []EffectiveRule, err error) {
+	result, err = schemaregistry.ListEffectiveRulesWithContext(context.Background(), listEffectiveRulesOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// ListEffectiveRulesWithContext is an alternate form of the ListEffectiveRules method which supports a Context parameter
+func (schemaregistry *SchemaregistryV1) ListEffectiveRulesWithContext(ctx context.Context, listEffectiveRulesOptions *ListEffectiveRulesOptions) (result []EffectiveRule, err error) {
+	var headers map[string]string
+	if listEffectiveRulesOptions != nil {
+		headers = listEffectiveRulesOptions.Headers
+	}
+	schemaIDs, err := schemaregistry.listSchemaIDs(ctx, headers)
+	if err != nil {
+		return
+	}
+	globalConfig, err := schemaregistry.globalRuleConfig(ctx, headers)
+	if err != nil {
+		return
+	}
+
+	result = []EffectiveRule{}
+	for _, id := range schemaIDs {
+		var config *string
+		config, err = schemaregistry.schemaRuleConfig(ctx, id, headers)
+		if err != nil {
+			return
+		}
+		if config != nil {
+			result = append(result, EffectiveRule{SchemaID: id, Config: *config, Source: EffectiveRuleSourceSchemaConst})
+		} else {
+			result = append(result, EffectiveRule{SchemaID: id, Config: globalConfig, Source: EffectiveRuleSourceGlobalConst})
+		}
+	}
+	return
+}
+
+// PlanSchemaRules : Compute the schema rule changes needed to match a desired state
+// Validates the desired configs, retrieves the current rule of every schema concerned and returns the changes that
+// ApplySchemaRules would make, without making them.
+func (schemaregistry *SchemaregistryV1) PlanSchemaRules(applySchemaRulesOptions *ApplySchemaRulesOptions) (result *SchemaRulePlan, err error) {
+	result, err = schemaregistry.PlanSchemaRulesWithContext(context.Background(), applySchemaRulesOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// PlanSchemaRulesWithContext is an alternate form of the PlanSchemaRules method which supports a Context parameter
+func (schemaregistry *SchemaregistryV1) PlanSchemaRulesWithContext(ctx context.Context, applySchemaRulesOptions *ApplySchemaRulesOptions) (result *SchemaRulePlan, err error) {
+	err = core.ValidateNotNil(applySchemaRulesOptions, "applySchemaRulesOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(applySchemaRulesOptions, "applySchemaRulesOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+	for id, config := range applySchemaRulesOptions.Desired {
+		if config != "" && !compatibilityConfigs[config] {
+			err = core.SDKErrorf(nil, fmt.Sprintf("invalid compatibility config '%s' for schema '%s'", config, id), "invalid-compatibility-config", common.GetComponentInfo())
+			return
+		}
+	}
+
+	headers := applySchemaRulesOptions.Headers
+	schemaIDs, err := schemaregistry.listSchemaIDs(ctx, headers)
+	if err != nil {
+		return
+	}
+	existing := make(map[string]bool, len(schemaIDs))
+	for _, id := range schemaIDs {
+		existing[id] = true
+	}
+	for id := range applySchemaRulesOptions.Desired {
+		if !existing[id] {
+			err = core.SDKErrorf(nil, fmt.Sprintf("schema '%s' does not exist", id), "schema-not-found", common.GetComponentInfo())
+			return
+		}
+	}
+
+	prune := applySchemaRulesOptions.Prune != nil && *applySchemaRulesOptions.Prune
+	current := map[string]string{}
+	for _, id := range schemaIDs {
+		if _, managed := applySchemaRulesOptions.Desired[id]; !managed && !prune {
+			continue
+		}
+		var config *string
+		config, err = schemaregistry.schemaRuleConfig(ctx, id, headers)
+		if err != nil {
+			return
+		}
+		if config != nil {
+			current[id] = *config
+		}
+	}
+	result = DiffSchemaRules(current, applySchemaRulesOptions.Desired, prune)
+	return
+}
+
+// ApplySchemaRules : Reconcile schema rules with a desired state
+// Computes the plan returned by PlanSchemaRules and applies it with CreateSchemaRule, UpdateSchemaRule and
+// DeleteSchemaRule. Changes are applied in plan order; if one fails, the error is returned together with the plan, in
+// which the changes made so far are marked as applied.
+func (schemaregistry *SchemaregistryV1) ApplySchemaRules(applySchemaRulesOptions *ApplySchemaRulesOptions) (result *SchemaRulePlan, err error) {
+	result, err = schemaregistry.ApplySchemaRulesWithContext(context.Background(), applySchemaRulesOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// ApplySchemaRulesWithContext is an alternate form of the ApplySchemaRules method which supports a Context parameter
+func (schemaregistry *SchemaregistryV1) ApplySchemaRulesWithContext(ctx context.Context, applySchemaRulesOptions *ApplySchemaRulesOptions) (result *SchemaRulePlan, err error) {
+	result, err = schemaregistry.PlanSchemaRulesWithContext(ctx, applySchemaRulesOptions)
+	if err != nil {
+		return
+	}
+	headers := applySchemaRulesOptions.Headers
+
+	for i := range result.Changes {
+		change := &result.Changes[i]
+		switch change.Action {
+		case SchemaRuleChangeActionCreateConst:
+			createSchemaRuleOptions := schemaregistry.NewCreateSchemaRuleOptions(change.SchemaID, RuleTypeCompatibilityConst, *change.Desired).SetHeaders(headers)
+			_, _, err = schemaregistry.CreateSchemaRuleWithContext(ctx, createSchemaRuleOptions)
+		case SchemaRuleChangeActionUpdateConst:
+			updateSchemaRuleOptions := schemaregistry.NewUpdateSchemaRuleOptions(change.SchemaID, RuleTypeCompatibilityConst, RuleTypeCompatibilityConst, *change.Desired).SetHeaders(headers)
+			_, _, err = schemaregistry.UpdateSchemaRuleWithContext(ctx, updateSchemaRuleOptions)
+		case SchemaRuleChangeActionDeleteConst:
+			deleteSchemaRuleOptions := schemaregistry.NewDeleteSchemaRuleOptions(change.SchemaID, RuleTypeCompatibilityConst).SetHeaders(headers)
+			_, err = schemaregistry.DeleteSchemaRuleWithContext(ctx, deleteSchemaRuleOptions)
+		}
+		if err != nil {
+			err = core.SDKErrorf(err, fmt.Sprintf("failed to %s compatibility rule for schema '%s'", change.Action, change.SchemaID), "apply-schema-rule-error", common.GetComponentInfo())
+			return
+		}
+		change.Applied = true
+	}
+	return
+}
+
+// DiffSchemaRules returns the changes needed to turn the current compatibility configs of schemas into the desired
+// ones. Both maps are keyed by schema ID; current only holds schemas that have a rule of their own, and an empty
+// desired config means that the schema should have no rule of its own. When prune is true, the rules of schemas
+// missing from desired are deleted.
+func DiffSchemaRules(current map[string]string, desired map[string]string, prune bool) (plan *SchemaRulePlan) {
+	plan = &SchemaRulePlan{Changes: []SchemaRuleChange{}}
+
+	ids := make([]string, 0, len(current)+len(desired))
+	for id := range desired {
+		ids = append(ids, id)
+	}
+	for id := range current {
+		if _, ok := desired[id]; !ok {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+
+	for _, id := range ids {
+		existing, exists := current[id]
+		wanted, managed := desired[id]
+		switch {
+		case managed && wanted != "" && !exists:
+			plan.Changes = append(plan.Changes, SchemaRuleChange{Action: SchemaRuleChangeActionCreateConst, SchemaID: id, Desired: core.StringPtr(wanted)})
+		case managed && wanted != "" && wanted != existing:
+			plan.Changes = append(plan.Changes, SchemaRuleChange{Action: SchemaRuleChangeActionUpdateConst, SchemaID: id, Current: core.StringPtr(existing), Desired: core.StringPtr(wanted)})
+		case exists && ((managed && wanted == "") || (!managed && prune)):
+			plan.Changes = append(plan.Changes, SchemaRuleChange{Action: SchemaRuleChangeActionDeleteConst, SchemaID: id, Current: core.StringPtr(existing)})
+		}
+	}
+	return
+}
+
+// schemaRuleConfig returns the config of the COMPATIBILITY rule of a schema, or nil if it has no rule of its own.
+func (schemaregistry *SchemaregistryV1) schemaRuleConfig(ctx context.Context, id string, headers map[string]string) (config *string, err error) {
+	getSchemaRuleOptions := schemaregistry.NewGetSchemaRuleOptions(id, RuleTypeCompatibilityConst).SetHeaders(headers)
+	rule, response, err := schemaregistry.GetSchemaRuleWithContext(ctx, getSchemaRuleOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == http.StatusNotFound {
+			err = nil
+			return
+		}
+		err = core.SDKErrorf(err, "", "get-schema-rule-error", common.GetComponentInfo())
+		return
+	}
+	config = rule.Config
+	return
+}
+
+func (schemaregistry *SchemaregistryV1) globalRuleConfig(ctx context.Context, headers map[string]string) (config string, err error) {
+	getGlobalRuleOptions := schemaregistry.NewGetGlobalRuleOptions(RuleTypeCompatibilityConst).SetHeaders(headers)
+	rule, _, err := schemaregistry.GetGlobalRuleWithContext(ctx, getGlobalRuleOptions)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "get-global-rule-error", common.GetComponentInfo())
+		return
+	}
+	if rule.Config != nil {
+		config = *rule.Config
+	}
+	return
+}
+
+func (schemaregistry *SchemaregistryV1) listSchemaIDs(ctx context.Context, headers map[string]string) (schemaIDs []string, err error) {
+	schemaIDs, _, err = schemaregistry.ListSchemasWithContext(ctx, schemaregistry.NewListSchemasOptions().SetHeaders(headers))
+	if err != nil {
+		err = core.SDKErrorf(err, "", "list-schemas-error", common.GetComponentInfo())
+		return
+	}
+	sort.Strings(schemaIDs)
+	return
+}
@@ -0,0 +1,190 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schemaregistryv1_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/IBM/eventstreams-go-sdk/pkg/schemaregistryv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Compatibility rules`, func() {
+	var testServer *httptest.Server
+	var requests []string
+	var rules map[string]string
+	var failDeletes bool
+	var schemaregistryService *schemaregistryv1.SchemaregistryV1
+
+	BeforeEach(func() {
+		requests = nil
+		failDeletes = false
+		rules = map[string]string{"orders": "FULL", "payments": "NONE"}
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			body, _ := io.ReadAll(req.Body)
+			if req.Method != http.MethodGet {
+				requests = append(requests, fmt.Sprintf("%s %s %s", req.Method, req.URL.EscapedPath(), bytes.TrimSpace(body)))
+			}
+			res.Header().Set("Content-type", "application/json")
+			path := strings.Split(strings.Trim(req.URL.EscapedPath(), "/"), "/")
+			var rule schemaregistryv1.Rule
+			switch {
+			case req.Method == http.MethodGet && req.URL.Path == "/artifacts":
+				res.WriteHeader(200)
+				fmt.Fprint(res, `["payments", "orders", "users"]`)
+			case req.Method == http.MethodGet && req.URL.Path == "/rules/COMPATIBILITY":
+				res.WriteHeader(200)
+				fmt.Fprint(res, `{"type": "COMPATIBILITY", "config": "BACKWARD"}`)
+			case req.Method == http.MethodGet && len(path) == 4:
+				config, ok := rules[path[1]]
+				if !ok {
+					res.WriteHeader(404)
+					fmt.Fprint(res, `{"error_code": 404, "message": "no rule"}`)
+					return
+				}
+				res.WriteHeader(200)
+				fmt.Fprintf(res, `{"type": "COMPATIBILITY", "config": "%s"}`, config)
+			case req.Method == http.MethodPost && len(path) == 3:
+				_ = json.Unmarshal(body, &rule)
+				rules[path[1]] = *rule.Config
+				res.WriteHeader(200)
+				fmt.Fprint(res, string(body))
+			case req.Method == http.MethodPut && len(path) == 4:
+				_ = json.Unmarshal(body, &rule)
+				rules[path[1]] = *rule.Config
+				res.WriteHeader(200)
+				fmt.Fprint(res, string(body))
+			case req.Method == http.MethodDelete && len(path) == 4:
+				if failDeletes {
+					res.WriteHeader(500)
+					fmt.Fprint(res, `{"error_code": 500, "message": "boom"}`)
+					return
+				}
+				delete(rules, path[1])
+				res.WriteHeader(204)
+			default:
+				res.WriteHeader(400)
+			}
+		}))
+		var err error
+		schemaregistryService, err = schemaregistryv1.NewSchemaregistryV1(&schemaregistryv1.SchemaregistryV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Resolves the rule of a schema before the global rule`, func() {
+		result, err := schemaregistryService.GetEffectiveRule(schemaregistryService.NewGetEffectiveRuleOptions("orders"))
+		Expect(err).To(BeNil())
+		Expect(*result).To(Equal(schemaregistryv1.EffectiveRule{SchemaID: "orders", Config: "FULL", Source: schemaregistryv1.EffectiveRuleSourceSchemaConst}))
+
+		result, err = schemaregistryService.GetEffectiveRule(schemaregistryService.NewGetEffectiveRuleOptions("users"))
+		Expect(err).To(BeNil())
+		Expect(*result).To(Equal(schemaregistryv1.EffectiveRule{SchemaID: "users", Config: "BACKWARD", Source: schemaregistryv1.EffectiveRuleSourceGlobalConst}))
+
+		_, err = schemaregistryService.GetEffectiveRule(nil)
+		Expect(err).ToNot(BeNil())
+	})
+	It(`Lists the effective rules of all schemas`, func() {
+		result, err := schemaregistryService.ListEffectiveRules(schemaregistryService.NewListEffectiveRulesOptions())
+		Expect(err).To(BeNil())
+		Expect(result).To(Equal([]schemaregistryv1.EffectiveRule{
+			{SchemaID: "orders", Config: "FULL", Source: schemaregistryv1.EffectiveRuleSourceSchemaConst},
+			{SchemaID: "payments", Config: "NONE", Source: schemaregistryv1.EffectiveRuleSourceSchemaConst},
+			{SchemaID: "users", Config: "BACKWARD", Source: schemaregistryv1.EffectiveRuleSourceGlobalConst},
+		}))
+	})
+	It(`Diffs current and desired rules`, func() {
+		current := map[string]string{"a": "FULL", "b": "NONE", "c": "FORWARD"}
+		desired := map[string]string{"a": "FULL", "b": "BACKWARD", "d": "FULL_TRANSITIVE", "e": ""}
+
+		plan := schemaregistryv1.DiffSchemaRules(current, desired, false)
+		Expect(plan.Changes).To(Equal([]schemaregistryv1.SchemaRuleChange{
+			{Action: schemaregistryv1.SchemaRuleChangeActionUpdateConst, SchemaID: "b", Current: core.StringPtr("NONE"), Desired: core.StringPtr("BACKWARD")},
+			{Action: schemaregistryv1.SchemaRuleChangeActionCreateConst, SchemaID: "d", Desired: core.StringPtr("FULL_TRANSITIVE")},
+		}))
+
+		plan = schemaregistryv1.DiffSchemaRules(current, desired, true)
+		Expect(plan.Changes).To(HaveLen(3))
+		Expect(plan.Changes[1]).To(Equal(schemaregistryv1.SchemaRuleChange{Action: schemaregistryv1.SchemaRuleChangeActionDeleteConst, SchemaID: "c", Current: core.StringPtr("FORWARD")}))
+	})
+	It(`Applies desired rules`, func() {
+		options := schemaregistryService.NewApplySchemaRulesOptions(map[string]string{
+			"orders": "",
+			"users":  "FORWARD",
+		})
+		plan, err := schemaregistryService.PlanSchemaRules(options)
+		Expect(err).To(BeNil())
+		Expect(plan.Changes).To(HaveLen(2))
+		Expect(requests).To(BeEmpty())
+
+		plan, err = schemaregistryService.ApplySchemaRules(options)
+		Expect(err).To(BeNil())
+		Expect(plan.Changes).To(Equal([]schemaregistryv1.SchemaRuleChange{
+			{Action: schemaregistryv1.SchemaRuleChangeActionDeleteConst, SchemaID: "orders", Current: core.StringPtr("FULL"), Applied: true},
+			{Action: schemaregistryv1.SchemaRuleChangeActionCreateConst, SchemaID: "users", Desired: core.StringPtr("FORWARD"), Applied: true},
+		}))
+		Expect(requests).To(Equal([]string{
+			"DELETE /artifacts/orders/rules/COMPATIBILITY ",
+			`POST /artifacts/users/rules {"config":"FORWARD","type":"COMPATIBILITY"}`,
+		}))
+
+		requests = nil
+		plan, err = schemaregistryService.ApplySchemaRules(options.SetDesired(map[string]string{"users": "FULL"}).SetPrune(true))
+		Expect(err).To(BeNil())
+		Expect(plan.Changes).To(HaveLen(2))
+		Expect(requests).To(Equal([]string{
+			"DELETE /artifacts/payments/rules/COMPATIBILITY ",
+			`PUT /artifacts/users/rules/COMPATIBILITY {"config":"FULL","type":"COMPATIBILITY"}`,
+		}))
+		Expect(rules).To(Equal(map[string]string{"users": "FULL"}))
+	})
+	It(`Stops at the first failed change`, func() {
+		failDeletes = true
+		options := schemaregistryService.NewApplySchemaRulesOptions(map[string]string{"orders": "", "users": "FULL"})
+		plan, err := schemaregistryService.ApplySchemaRules(options)
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("failed to delete compatibility rule for schema 'orders'"))
+		Expect(plan.Changes).To(HaveLen(2))
+		Expect(plan.Changes[0].Applied).To(BeFalse())
+		Expect(plan.Changes[1].Applied).To(BeFalse())
+	})
+	It(`Rejects invalid desired rules`, func() {
+		_, err := schemaregistryService.PlanSchemaRules(schemaregistryService.NewApplySchemaRulesOptions(map[string]string{"orders": "SIDEWAYS"}))
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("invalid compatibility config 'SIDEWAYS'"))
+
+		_, err = schemaregistryService.PlanSchemaRules(schemaregistryService.NewApplySchemaRulesOptions(map[string]string{"missing": "FULL"}))
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("schema 'missing' does not exist"))
+
+		_, err = schemaregistryService.PlanSchemaRules(&schemaregistryv1.ApplySchemaRulesOptions{})
+		Expect(err).ToNot(BeNil())
+	})
+})
@@ -0,0 +1,793 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schemaregistryv1
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	common "github.com/IBM/eventstreams-go-sdk/pkg/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// SchemaState : The lifecycle state of a schema or schema version.
+//
+// The registry itself only knows the ENABLED and DISABLED states. A DEPRECATED schema or version is still ENABLED in
+// the registry; the deprecation is recorded in the LifecycleStore of the SchemaLifecycle.
+type SchemaState string
+
+// Constants associated with the SchemaState type.
+// A schema or version moves from ENABLED to DEPRECATED to DISABLED to DELETED, and can be enabled again until it is
+// deleted.
+const (
+	SchemaStateEnabled    SchemaState = "ENABLED"
+	SchemaStateDeprecated SchemaState = "DEPRECATED"
+	SchemaStateDisabled   SchemaState = "DISABLED"
+	SchemaStateDeleted    SchemaState = "DELETED"
+)
+
+// Constants associated with the LifecycleAction.Action property.
+// The operation that a retention policy applies to a schema version.
+const (
+	LifecycleActionDeleteConst = "delete"
+)
+
+// LifecycleKey : Identifies a schema, or a version of a schema, in a LifecycleStore.
+type LifecycleKey struct {
+	// The ID of the schema.
+	SchemaID string
+
+	// The version of the schema, or 0 for the schema as a whole.
+	Version int64
+}
+
+// LifecycleRecord : The lifecycle state of a schema or schema version, and when it was entered.
+type LifecycleRecord struct {
+	// The state.
+	State SchemaState `json:"state"`
+
+	// When the state was entered. The zero time means that the state was never recorded.
+	Since time.Time `json:"since"`
+}
+
+// LifecycleStore : Stores the lifecycle records of schemas and schema versions. The registry does not report when
+// the state of a schema changed, so the minimum dwell times of a SchemaLifecycle are only as durable as its store;
+// applications that restart should provide a persistent implementation.
+type LifecycleStore interface {
+	// Get returns the record for key, or nil if there is none.
+	Get(key LifecycleKey) (*LifecycleRecord, error)
+
+	// Put stores the record for key.
+	Put(key LifecycleKey, record LifecycleRecord) error
+
+	// Delete removes the record for key, if any.
+	Delete(key LifecycleKey) error
+}
+
+// NewMemoryLifecycleStore returns a LifecycleStore that keeps records in memory. It is safe for concurrent use.
+func NewMemoryLifecycleStore() LifecycleStore {
+	return &memoryLifecycleStore{records: map[LifecycleKey]LifecycleRecord{}}
+}
+
+type memoryLifecycleStore struct {
+	mutex   sync.RWMutex
+	records map[LifecycleKey]LifecycleRecord
+}
+
+func (store *memoryLifecycleStore) Get(key LifecycleKey) (*LifecycleRecord, error) {
+	store.mutex.RLock()
+	defer store.mutex.RUnlock()
+	record, ok := store.records[key]
+	if !ok {
+		return nil, nil
+	}
+	return &record, nil
+}
+
+func (store *memoryLifecycleStore) Put(key LifecycleKey, record LifecycleRecord) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	store.records[key] = record
+	return nil
+}
+
+func (store *memoryLifecycleStore) Delete(key LifecycleKey) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	delete(store.records, key)
+	return nil
+}
+
+// SchemaLifecycleOptions : The options used to construct a SchemaLifecycle.
+type SchemaLifecycleOptions struct {
+	// Where lifecycle records are kept. Defaults to a store created with NewMemoryLifecycleStore.
+	Store LifecycleStore
+
+	// How long a schema or version must be DEPRECATED before it can be disabled.
+	MinDeprecatedDuration time.Duration
+
+	// How long a schema or version must be DISABLED before it can be deleted.
+	MinDisabledDuration time.Duration
+
+	// Returns the current time. Defaults to time.Now.
+	Clock func() time.Time
+
+	// Allows users to set headers on the API requests made by the lifecycle. The headers of a call override them.
+	Headers map[string]string
+}
+
+// NewSchemaLifecycleOptions : Instantiate SchemaLifecycleOptions
+func (*SchemaregistryV1) NewSchemaLifecycleOptions() *SchemaLifecycleOptions {
+	return &SchemaLifecycleOptions{}
+}
+
+// SetStore : Allow user to set Store
+func (_options *SchemaLifecycleOptions) SetStore(store LifecycleStore) *SchemaLifecycleOptions {
+	_options.Store = store
+	return _options
+}
+
+// SetMinDeprecatedDuration : Allow user to set MinDeprecatedDuration
+func (_options *SchemaLifecycleOptions) SetMinDeprecatedDuration(minDeprecatedDuration time.Duration) *SchemaLifecycleOptions {
+	_options.MinDeprecatedDuration = minDeprecatedDuration
+	return _options
+}
+
+// SetMinDisabledDuration : Allow user to set MinDisabledDuration
+func (_options *SchemaLifecycleOptions) SetMinDisabledDuration(minDisabledDuration time.Duration) *SchemaLifecycleOptions {
+	_options.MinDisabledDuration = minDisabledDuration
+	return _options
+}
+
+// SetClock : Allow user to set Clock
+func (_options *SchemaLifecycleOptions) SetClock(clock func() time.Time) *SchemaLifecycleOptions {
+	_options.Clock = clock
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *SchemaLifecycleOptions) SetHeaders(param map[string]string) *SchemaLifecycleOptions {
+	options.Headers = param
+	return options
+}
+
+// GetVersionLifecycleStateOptions : The GetVersionLifecycleState options.
+type GetVersionLifecycleStateOptions struct {
+	// The ID of the schema.
+	ID *string `json:"id" validate:"required,ne="`
+
+	// The version of the schema.
+	Version *int64 `json:"version" validate:"required"`
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewGetVersionLifecycleStateOptions : Instantiate GetVersionLifecycleStateOptions
+func (*SchemaregistryV1) NewGetVersionLifecycleStateOptions(id string, version int64) *GetVersionLifecycleStateOptions {
+	return &GetVersionLifecycleStateOptions{
+		ID:      core.StringPtr(id),
+		Version: core.Int64Ptr(version),
+	}
+}
+
+// SetID : Allow user to set ID
+func (_options *GetVersionLifecycleStateOptions) SetID(id string) *GetVersionLifecycleStateOptions {
+	_options.ID = core.StringPtr(id)
+	return _options
+}
+
+// SetVersion : Allow user to set Version
+func (_options *GetVersionLifecycleStateOptions) SetVersion(version int64) *GetVersionLifecycleStateOptions {
+	_options.Version = core.Int64Ptr(version)
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *GetVersionLifecycleStateOptions) SetHeaders(param map[string]string) *GetVersionLifecycleStateOptions {
+	options.Headers = param
+	return options
+}
+
+// GetSchemaLifecycleStateOptions : The GetSchemaLifecycleState options.
+type GetSchemaLifecycleStateOptions struct {
+	// The ID of the schema.
+	ID *string `json:"id" validate:"required,ne="`
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewGetSchemaLifecycleStateOptions : Instantiate GetSchemaLifecycleStateOptions
+func (*SchemaregistryV1) NewGetSchemaLifecycleStateOptions(id string) *GetSchemaLifecycleStateOptions {
+	return &GetSchemaLifecycleStateOptions{
+		ID: core.StringPtr(id),
+	}
+}
+
+// SetID : Allow user to set ID
+func (_options *GetSchemaLifecycleStateOptions) SetID(id string) *GetSchemaLifecycleStateOptions {
+	_options.ID = core.StringPtr(id)
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *GetSchemaLifecycleStateOptions) SetHeaders(param map[string]string) *GetSchemaLifecycleStateOptions {
+	options.Headers = param
+	return options
+}
+
+// SetVersionLifecycleStateOptions : The SetVersionLifecycleState options.
+type SetVersionLifecycleStateOptions struct {
+	// The ID of the schema.
+	ID *string `json:"id" validate:"required,ne="`
+
+	// The version of the schema.
+	Version *int64 `json:"version" validate:"required"`
+
+	// The state to move the version to.
+	State *SchemaState `json:"state" validate:"required"`
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewSetVersionLifecycleStateOptions : Instantiate SetVersionLifecycleStateOptions
+func (*SchemaregistryV1) NewSetVersionLifecycleStateOptions(id string, version int64, state SchemaState) *SetVersionLifecycleStateOptions {
+	return &SetVersionLifecycleStateOptions{
+		ID:      core.StringPtr(id),
+		Version: core.Int64Ptr(version),
+		State:   &state,
+	}
+}
+
+// SetID : Allow user to set ID
+func (_options *SetVersionLifecycleStateOptions) SetID(id string) *SetVersionLifecycleStateOptions {
+	_options.ID = core.StringPtr(id)
+	return _options
+}
+
+// SetVersion : Allow user to set Version
+func (_options *SetVersionLifecycleStateOptions) SetVersion(version int64) *SetVersionLifecycleStateOptions {
+	_options.Version = core.Int64Ptr(version)
+	return _options
+}
+
+// SetState : Allow user to set State
+func (_options *SetVersionLifecycleStateOptions) SetState(state SchemaState) *SetVersionLifecycleStateOptions {
+	_options.State = &state
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *SetVersionLifecycleStateOptions) SetHeaders(param map[string]string) *SetVersionLifecycleStateOptions {
+	options.Headers = param
+	return options
+}
+
+// SetSchemaLifecycleStateOptions : The SetSchemaLifecycleState options.
+type SetSchemaLifecycleStateOptions struct {
+	// The ID of the schema.
+	ID *string `json:"id" validate:"required,ne="`
+
+	// The state to move the schema to.
+	State *SchemaState `json:"state" validate:"required"`
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewSetSchemaLifecycleStateOptions : Instantiate SetSchemaLifecycleStateOptions
+func (*SchemaregistryV1) NewSetSchemaLifecycleStateOptions(id string, state SchemaState) *SetSchemaLifecycleStateOptions {
+	return &SetSchemaLifecycleStateOptions{
+		ID:    core.StringPtr(id),
+		State: &state,
+	}
+}
+
+// SetID : Allow user to set ID
+func (_options *SetSchemaLifecycleStateOptions) SetID(id string) *SetSchemaLifecycleStateOptions {
+	_options.ID = core.StringPtr(id)
+	return _options
+}
+
+// SetState : Allow user to set State
+func (_options *SetSchemaLifecycleStateOptions) SetState(state SchemaState) *SetSchemaLifecycleStateOptions {
+	_options.State = &state
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *SetSchemaLifecycleStateOptions) SetHeaders(param map[string]string) *SetSchemaLifecycleStateOptions {
+	options.Headers = param
+	return options
+}
+
+// RetentionPolicy : Which versions of a schema ApplyRetention deletes.
+type RetentionPolicy struct {
+	// The number of most recent versions that are always kept, whatever their state.
+	KeepLast int
+
+	// Versions that have been DISABLED for longer than this are deleted. Versions are never deleted before the
+	// MinDisabledDuration of the SchemaLifecycle has passed, even if this is shorter.
+	DeleteDisabledOlderThan time.Duration
+}
+
+// ApplyRetentionOptions : The PlanRetention and ApplyRetention options.
+type ApplyRetentionOptions struct {
+	// The ID of the schema.
+	ID *string `json:"id" validate:"required,ne="`
+
+	// Which versions of the schema are deleted.
+	Policy *RetentionPolicy `validate:"required"`
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewApplyRetentionOptions : Instantiate ApplyRetentionOptions
+func (*SchemaregistryV1) NewApplyRetentionOptions(id string, policy RetentionPolicy) *ApplyRetentionOptions {
+	return &ApplyRetentionOptions{
+		ID:     core.StringPtr(id),
+		Policy: &policy,
+	}
+}
+
+// SetID : Allow user to set ID
+func (_options *ApplyRetentionOptions) SetID(id string) *ApplyRetentionOptions {
+	_options.ID = core.StringPtr(id)
+	return _options
+}
+
+// SetPolicy : Allow user to set Policy
+func (_options *ApplyRetentionOptions) SetPolicy(policy RetentionPolicy) *ApplyRetentionOptions {
+	_options.Policy = &policy
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *ApplyRetentionOptions) SetHeaders(param map[string]string) *ApplyRetentionOptions {
+	options.Headers = param
+	return options
+}
+
+// LifecycleAction : A change made, or planned, by a retention policy.
+type LifecycleAction struct {
+	// The ID of the schema.
+	SchemaID string `json:"schema_id"`
+
+	// The version of the schema.
+	Version int64 `json:"version"`
+
+	// The operation, one of the LifecycleAction constants.
+	Action string `json:"action"`
+
+	// Why the action is taken.
+	Reason string `json:"reason"`
+
+	// Whether the action has been applied.
+	Applied bool `json:"applied"`
+}
+
+// RetentionPlan : The versions of a schema that a retention policy deletes.
+type RetentionPlan struct {
+	// The actions, from the oldest version to the newest.
+	Actions []LifecycleAction `json:"actions"`
+}
+
+// SchemaLifecycle : Moves schemas and schema versions through the ENABLED, DEPRECATED, DISABLED and DELETED states,
+// enforcing minimum dwell times between the states and refusing to disable or delete the latest enabled version of a
+// schema.
+type SchemaLifecycle struct {
+	schemaregistry *SchemaregistryV1
+	options        SchemaLifecycleOptions
+}
+
+// NewSchemaLifecycle : constructs a SchemaLifecycle backed by this service instance.
+func (schemaregistry *SchemaregistryV1) NewSchemaLifecycle(options *SchemaLifecycleOptions) *SchemaLifecycle {
+	lifecycle := &SchemaLifecycle{schemaregistry: schemaregistry}
+	if options != nil {
+		lifecycle.options = *options
+	}
+	if lifecycle.options.Store == nil {
+		lifecycle.options.Store = NewMemoryLifecycleStore()
+	}
+	if lifecycle.options.Clock == nil {
+		lifecycle.options.Clock = time.Now
+	}
+	return lifecycle
+}
+
+// GetVersionLifecycleState : Get the lifecycle state of a schema version
+// Returns the recorded state of a schema version. Versions without a record are ENABLED, with a zero Since time.
+func (lifecycle *SchemaLifecycle) GetVersionLifecycleState(getVersionLifecycleStateOptions *GetVersionLifecycleStateOptions) (result *LifecycleRecord, err error) {
+	result, err = lifecycle.GetVersionLifecycleStateWithContext(context.Background(), getVersionLifecycleStateOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetVersionLifecycleStateWithContext is an alternate form of the GetVersionLifecycleState method which supports a Context parameter
+func (lifecycle *SchemaLifecycle) GetVersionLifecycleStateWithContext(ctx context.Context, getVersionLifecycleStateOptions *GetVersionLifecycleStateOptions) (result *LifecycleRecord, err error) {
+	err = core.ValidateNotNil(getVersionLifecycleStateOptions, "getVersionLifecycleStateOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(getVersionLifecycleStateOptions, "getVersionLifecycleStateOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+
+	return lifecycle.record(LifecycleKey{SchemaID: *getVersionLifecycleStateOptions.ID, Version: *getVersionLifecycleStateOptions.Version})
+}
+
+// GetSchemaLifecycleState : Get the lifecycle state of a schema
+// Returns the recorded state of a schema. Schemas without a record are ENABLED, with a zero Since time.
+func (lifecycle *SchemaLifecycle) GetSchemaLifecycleState(getSchemaLifecycleStateOptions *GetSchemaLifecycleStateOptions) (result *LifecycleRecord, err error) {
+	result, err = lifecycle.GetSchemaLifecycleStateWithContext(context.Background(), getSchemaLifecycleStateOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetSchemaLifecycleStateWithContext is an alternate form of the GetSchemaLifecycleState method which supports a Context parameter
+func (lifecycle *SchemaLifecycle) GetSchemaLifecycleStateWithContext(ctx context.Context, getSchemaLifecycleStateOptions *GetSchemaLifecycleStateOptions) (result *LifecycleRecord, err error) {
+	err = core.ValidateNotNil(getSchemaLifecycleStateOptions, "getSchemaLifecycleStateOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(getSchemaLifecycleStateOptions, "getSchemaLifecycleStateOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+
+	return lifecycle.record(LifecycleKey{SchemaID: *getSchemaLifecycleStateOptions.ID})
+}
+
+// SetVersionLifecycleState : Move a schema version to a lifecycle state
+// Moves a schema version to the given state. Moving to the current state does nothing.
+//
+// A version can move one step forward, from ENABLED to DEPRECATED, DEPRECATED to DISABLED or DISABLED to DELETED, or
+// back to ENABLED from DEPRECATED or DISABLED. The move is refused if the version has not been in its current state
+// for the minimum dwell time, or if it disables or deletes the latest version of the schema that is not DISABLED.
+func (lifecycle *SchemaLifecycle) SetVersionLifecycleState(setVersionLifecycleStateOptions *SetVersionLifecycleStateOptions) (result *LifecycleRecord, err error) {
+	result, err = lifecycle.SetVersionLifecycleStateWithContext(context.Background(), setVersionLifecycleStateOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// SetVersionLifecycleStateWithContext is an alternate form of the SetVersionLifecycleState method which supports a Context parameter
+func (lifecycle *SchemaLifecycle) SetVersionLifecycleStateWithContext(ctx context.Context, setVersionLifecycleStateOptions *SetVersionLifecycleStateOptions) (result *LifecycleRecord, err error) {
+	err = core.ValidateNotNil(setVersionLifecycleStateOptions, "setVersionLifecycleStateOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(setVersionLifecycleStateOptions, "setVersionLifecycleStateOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+
+	schemaID := *setVersionLifecycleStateOptions.ID
+	version := *setVersionLifecycleStateOptions.Version
+	state := *setVersionLifecycleStateOptions.State
+	headers := lifecycle.headers(setVersionLifecycleStateOptions.Headers)
+	key := LifecycleKey{SchemaID: schemaID, Version: version}
+	current, err := lifecycle.checkTransition(key, state)
+	if err != nil || current.State == state {
+		result = current
+		return
+	}
+	if state == SchemaStateDisabled || state == SchemaStateDeleted {
+		var latest int64
+		latest, err = lifecycle.latestEnabledVersion(ctx, schemaID, headers)
+		if err != nil {
+			return
+		}
+		if latest == version {
+			err = core.SDKErrorf(nil, fmt.Sprintf("version %d is the latest enabled version of schema '%s'", version, schemaID), "latest-enabled-version", common.GetComponentInfo())
+			return
+		}
+	}
+
+	switch {
+	case state == SchemaStateDeleted:
+		_, err = lifecycle.schemaregistry.DeleteVersionWithContext(ctx, lifecycle.schemaregistry.NewDeleteVersionOptions(schemaID, version).SetHeaders(headers))
+	case state == SchemaStateDisabled, current.State == SchemaStateDisabled:
+		registryState := SetSchemaVersionStateOptionsStateDisabledConst
+		if state == SchemaStateEnabled {
+			registryState = SetSchemaVersionStateOptionsStateEnabledConst
+		}
+		_, err = lifecycle.schemaregistry.SetSchemaVersionStateWithContext(ctx, lifecycle.schemaregistry.NewSetSchemaVersionStateOptions(schemaID, version, registryState).SetHeaders(headers))
+	}
+	if err != nil {
+		err = core.SDKErrorf(err, fmt.Sprintf("failed to set version %d of schema '%s' to %s", version, schemaID, state), "set-lifecycle-state-error", common.GetComponentInfo())
+		return
+	}
+	return lifecycle.save(key, state)
+}
+
+// SetSchemaLifecycleState : Move a schema to a lifecycle state
+// Moves a schema, with all of its versions, to the given state, following the same rules as SetVersionLifecycleState
+// except that there is no latest version check. When the schema is DELETED, the records of its versions are removed
+// from the store too.
+func (lifecycle *SchemaLifecycle) SetSchemaLifecycleState(setSchemaLifecycleStateOptions *SetSchemaLifecycleStateOptions) (result *LifecycleRecord, err error) {
+	result, err = lifecycle.SetSchemaLifecycleStateWithContext(context.Background(), setSchemaLifecycleStateOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// SetSchemaLifecycleStateWithContext is an alternate form of the SetSchemaLifecycleState method which supports a Context parameter
+func (lifecycle *SchemaLifecycle) SetSchemaLifecycleStateWithContext(ctx context.Context, setSchemaLifecycleStateOptions *SetSchemaLifecycleStateOptions) (result *LifecycleRecord, err error) {
+	err = core.ValidateNotNil(setSchemaLifecycleStateOptions, "setSchemaLifecycleStateOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(setSchemaLifecycleStateOptions, "setSchemaLifecycleStateOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+
+	schemaID := *setSchemaLifecycleStateOptions.ID
+	state := *setSchemaLifecycleStateOptions.State
+	headers := lifecycle.headers(setSchemaLifecycleStateOptions.Headers)
+	key := LifecycleKey{SchemaID: schemaID}
+	current, err := lifecycle.checkTransition(key, state)
+	if err != nil || current.State == state {
+		result = current
+		return
+	}
+
+	var versions []int64
+	switch {
+	case state == SchemaStateDeleted:
+		// The versions are listed first, because the registry forgets them with the schema.
+		versions, _, err = lifecycle.schemaregistry.ListVersionsWithContext(ctx, lifecycle.schemaregistry.NewListVersionsOptions(schemaID).SetHeaders(headers))
+		if err != nil {
+			err = core.SDKErrorf(err, "", "list-versions-error", common.GetComponentInfo())
+			return
+		}
+		_, err = lifecycle.schemaregistry.DeleteSchemaWithContext(ctx, lifecycle.schemaregistry.NewDeleteSchemaOptions(schemaID).SetHeaders(headers))
+	case state == SchemaStateDisabled, current.State == SchemaStateDisabled:
+		registryState := SetSchemaStateOptionsStateDisabledConst
+		if state == SchemaStateEnabled {
+			registryState = SetSchemaStateOptionsStateEnabledConst
+		}
+		_, err = lifecycle.schemaregistry.SetSchemaStateWithContext(ctx, lifecycle.schemaregistry.NewSetSchemaStateOptions(schemaID, registryState).SetHeaders(headers))
+	}
+	if err != nil {
+		err = core.SDKErrorf(err, fmt.Sprintf("failed to set schema '%s' to %s", schemaID, state), "set-lifecycle-state-error", common.GetComponentInfo())
+		return
+	}
+	for _, version := range versions {
+		if err = lifecycle.options.Store.Delete(LifecycleKey{SchemaID: schemaID, Version: version}); err != nil {
+			err = core.SDKErrorf(err, "", "lifecycle-store-error", common.GetComponentInfo())
+			return
+		}
+	}
+	return lifecycle.save(key, state)
+}
+
+// PlanRetention : Plan a retention policy
+// Returns the versions of a schema that ApplyRetention would delete, without deleting them.
+func (lifecycle *SchemaLifecycle) PlanRetention(applyRetentionOptions *ApplyRetentionOptions) (result *RetentionPlan, err error) {
+	result, err = lifecycle.PlanRetentionWithContext(context.Background(), applyRetentionOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// PlanRetentionWithContext is an alternate form of the PlanRetention method which supports a Context parameter
+func (lifecycle *SchemaLifecycle) PlanRetentionWithContext(ctx context.Context, applyRetentionOptions *ApplyRetentionOptions) (result *RetentionPlan, err error) {
+	err = core.ValidateNotNil(applyRetentionOptions, "applyRetentionOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(applyRetentionOptions, "applyRetentionOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+
+	schemaID := *applyRetentionOptions.ID
+	policy := *applyRetentionOptions.Policy
+	versions, err := lifecycle.listVersions(ctx, schemaID, lifecycle.headers(applyRetentionOptions.Headers))
+	if err != nil {
+		return
+	}
+	result = &RetentionPlan{Actions: []LifecycleAction{}}
+
+	minAge := policy.DeleteDisabledOlderThan
+	if minAge < lifecycle.options.MinDisabledDuration {
+		minAge = lifecycle.options.MinDisabledDuration
+	}
+	now := lifecycle.options.Clock()
+	latest := latestEnabled(versions)
+
+	// Versions are sorted from the newest, so the first KeepLast of them are kept.
+	for i := len(versions) - 1; i >= policy.KeepLast && i >= 0; i-- {
+		version := versions[i]
+		if version.number == latest || version.record.State != SchemaStateDisabled || version.record.Since.IsZero() {
+			continue
+		}
+		age := now.Sub(version.record.Since)
+		if age < minAge {
+			continue
+		}
+		result.Actions = append(result.Actions, LifecycleAction{
+			SchemaID: schemaID,
+			Version:  version.number,
+			Action:   LifecycleActionDeleteConst,
+			Reason:   fmt.Sprintf("disabled for %s", age.Truncate(time.Second)),
+		})
+	}
+	return
+}
+
+// ApplyRetention : Apply a retention policy
+// Deletes the versions of a schema returned by PlanRetention. Versions are deleted from the oldest; if a deletion
+// fails, the error is returned together with the plan, in which the deletions made so far are marked as applied.
+func (lifecycle *SchemaLifecycle) ApplyRetention(applyRetentionOptions *ApplyRetentionOptions) (result *RetentionPlan, err error) {
+	result, err = lifecycle.ApplyRetentionWithContext(context.Background(), applyRetentionOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// ApplyRetentionWithContext is an alternate form of the ApplyRetention method which supports a Context parameter
+func (lifecycle *SchemaLifecycle) ApplyRetentionWithContext(ctx context.Context, applyRetentionOptions *ApplyRetentionOptions) (result *RetentionPlan, err error) {
+	result, err = lifecycle.PlanRetentionWithContext(ctx, applyRetentionOptions)
+	if err != nil {
+		return
+	}
+	for i := range result.Actions {
+		action := &result.Actions[i]
+		setVersionLifecycleStateOptions := lifecycle.schemaregistry.NewSetVersionLifecycleStateOptions(action.SchemaID, action.Version, SchemaStateDeleted).
+			SetHeaders(applyRetentionOptions.Headers)
+		_, err = lifecycle.SetVersionLifecycleStateWithContext(ctx, setVersionLifecycleStateOptions)
+		if err != nil {
+			return
+		}
+		action.Applied = true
+	}
+	return
+}
+
+// headers returns the headers of the lifecycle, overridden by those of a call.
+func (lifecycle *SchemaLifecycle) headers(headers map[string]string) map[string]string {
+	if len(lifecycle.options.Headers) == 0 {
+		return headers
+	}
+	merged := map[string]string{}
+	for name, value := range lifecycle.options.Headers {
+		merged[name] = value
+	}
+	for name, value := range headers {
+		merged[name] = value
+	}
+	return merged
+}
+
+func (lifecycle *SchemaLifecycle) record(key LifecycleKey) (result *LifecycleRecord, err error) {
+	result, err = lifecycle.options.Store.Get(key)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "lifecycle-store-error", common.GetComponentInfo())
+		return
+	}
+	if result == nil {
+		result = &LifecycleRecord{State: SchemaStateEnabled}
+	}
+	return
+}
+
+func (lifecycle *SchemaLifecycle) save(key LifecycleKey, state SchemaState) (result *LifecycleRecord, err error) {
+	result = &LifecycleRecord{State: state, Since: lifecycle.options.Clock()}
+	if state == SchemaStateDeleted {
+		err = lifecycle.options.Store.Delete(key)
+	} else {
+		err = lifecycle.options.Store.Put(key, *result)
+	}
+	if err != nil {
+		err = core.SDKErrorf(err, "", "lifecycle-store-error", common.GetComponentInfo())
+	}
+	return
+}
+
+// checkTransition returns the current record of key if it may move to state.
+func (lifecycle *SchemaLifecycle) checkTransition(key LifecycleKey, state SchemaState) (current *LifecycleRecord, err error) {
+	current, err = lifecycle.record(key)
+	if err != nil || current.State == state {
+		return
+	}
+
+	var minDuration time.Duration
+	switch {
+	case state == SchemaStateEnabled && (current.State == SchemaStateDeprecated || current.State == SchemaStateDisabled):
+		return
+	case state == SchemaStateDeprecated && current.State == SchemaStateEnabled:
+		return
+	case state == SchemaStateDisabled && current.State == SchemaStateDeprecated:
+		minDuration = lifecycle.options.MinDeprecatedDuration
+	case state == SchemaStateDeleted && current.State == SchemaStateDisabled:
+		minDuration = lifecycle.options.MinDisabledDuration
+	default:
+		err = core.SDKErrorf(nil, fmt.Sprintf("%s cannot move from %s to %s", key, current.State, state), "invalid-lifecycle-transition", common.GetComponentInfo())
+		return
+	}
+	if elapsed := lifecycle.options.Clock().Sub(current.Since); minDuration > 0 && elapsed < minDuration {
+		err = core.SDKErrorf(nil, fmt.Sprintf("%s has been %s for %s, less than the minimum of %s", key, current.State, elapsed.Truncate(time.Second), minDuration), "lifecycle-dwell-time", common.GetComponentInfo())
+	}
+	return
+}
+
+type lifecycleVersion struct {
+	number int64
+	record *LifecycleRecord
+}
+
+// listVersions returns the versions of a schema with their records, from the newest.
+func (lifecycle *SchemaLifecycle) listVersions(ctx context.Context, schemaID string, headers map[string]string) (versions []lifecycleVersion, err error) {
+	numbers, _, err := lifecycle.schemaregistry.ListVersionsWithContext(ctx, lifecycle.schemaregistry.NewListVersionsOptions(schemaID).SetHeaders(headers))
+	if err != nil {
+		err = core.SDKErrorf(err, "", "list-versions-error", common.GetComponentInfo())
+		return
+	}
+	sort.Slice(numbers, func(i, j int) bool { return numbers[i] > numbers[j] })
+	for _, number := range numbers {
+		var record *LifecycleRecord
+		record, err = lifecycle.record(LifecycleKey{SchemaID: schemaID, Version: number})
+		if err != nil {
+			return
+		}
+		versions = append(versions, lifecycleVersion{number: number, record: record})
+	}
+	return
+}
+
+func (lifecycle *SchemaLifecycle) latestEnabledVersion(ctx context.Context, schemaID string, headers map[string]string) (latest int64, err error) {
+	versions, err := lifecycle.listVersions(ctx, schemaID, headers)
+	if err != nil {
+		return
+	}
+	latest = latestEnabled(versions)
+	return
+}
+
+// latestEnabled returns the newest version that is not DISABLED, or 0 if there is none.
+func latestEnabled(versions []lifecycleVersion) int64 {
+	for _, version := range versions {
+		if version.record.State != SchemaStateDisabled {
+			return version.number
+		}
+	}
+	return 0
+}
+
+// String returns the key as it appears in error messages.
+func (key LifecycleKey) String() string {
+	if key.Version == 0 {
+		return fmt.Sprintf("schema '%s'", key.SchemaID)
+	}
+	return fmt.Sprintf("version %d of schema '%s'", key.Version, key.SchemaID)
+}
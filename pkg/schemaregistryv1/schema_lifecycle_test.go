@@ -0,0 +1,237 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schemaregistryv1_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/IBM/eventstreams-go-sdk/pkg/schemaregistryv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Schema lifecycle`, func() {
+	var testServer *httptest.Server
+	var requests []string
+	var schemaregistryService *schemaregistryv1.SchemaregistryV1
+	var lifecycle *schemaregistryv1.SchemaLifecycle
+	var store schemaregistryv1.LifecycleStore
+	var now time.Time
+	ctx := context.Background()
+
+	BeforeEach(func() {
+		requests = nil
+		now = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			body, _ := io.ReadAll(req.Body)
+			requests = append(requests, fmt.Sprintf("%s %s %s", req.Method, req.URL.EscapedPath(), bytes.TrimSpace(body)))
+			res.Header().Set("Content-type", "application/json")
+			if req.Method == http.MethodGet {
+				res.WriteHeader(200)
+				fmt.Fprint(res, `[1, 2, 3]`)
+				return
+			}
+			res.WriteHeader(204)
+		}))
+		var err error
+		schemaregistryService, err = schemaregistryv1.NewSchemaregistryV1(&schemaregistryv1.SchemaregistryV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+		store = schemaregistryv1.NewMemoryLifecycleStore()
+		options := schemaregistryService.NewSchemaLifecycleOptions().
+			SetStore(store).
+			SetMinDeprecatedDuration(24 * time.Hour).
+			SetMinDisabledDuration(7 * 24 * time.Hour).
+			SetClock(func() time.Time { return now })
+		lifecycle = schemaregistryService.NewSchemaLifecycle(options)
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Moves a version from ENABLED to DELETED respecting the dwell times`, func() {
+		record, err := lifecycle.GetVersionLifecycleState(schemaregistryService.NewGetVersionLifecycleStateOptions("orders", 1))
+		Expect(err).To(BeNil())
+		Expect(record.State).To(Equal(schemaregistryv1.SchemaStateEnabled))
+		Expect(record.Since.IsZero()).To(BeTrue())
+
+		record, err = lifecycle.SetVersionLifecycleStateWithContext(ctx, schemaregistryService.NewSetVersionLifecycleStateOptions("orders", 1, schemaregistryv1.SchemaStateDeprecated))
+		Expect(err).To(BeNil())
+		Expect(record.State).To(Equal(schemaregistryv1.SchemaStateDeprecated))
+		Expect(record.Since).To(Equal(now))
+		Expect(requests).To(BeEmpty())
+
+		_, err = lifecycle.SetVersionLifecycleStateWithContext(ctx, schemaregistryService.NewSetVersionLifecycleStateOptions("orders", 1, schemaregistryv1.SchemaStateDisabled))
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("has been DEPRECATED for 0s, less than the minimum of 24h0m0s"))
+
+		now = now.Add(25 * time.Hour)
+		_, err = lifecycle.SetVersionLifecycleStateWithContext(ctx, schemaregistryService.NewSetVersionLifecycleStateOptions("orders", 1, schemaregistryv1.SchemaStateDisabled))
+		Expect(err).To(BeNil())
+		Expect(requests).To(Equal([]string{
+			"GET /artifacts/orders/versions ",
+			`PUT /artifacts/orders/versions/1/state {"state":"DISABLED"}`,
+		}))
+
+		_, err = lifecycle.SetVersionLifecycleStateWithContext(ctx, schemaregistryService.NewSetVersionLifecycleStateOptions("orders", 1, schemaregistryv1.SchemaStateDeleted))
+		Expect(err).ToNot(BeNil())
+
+		requests = nil
+		now = now.Add(8 * 24 * time.Hour)
+		_, err = lifecycle.SetVersionLifecycleStateWithContext(ctx, schemaregistryService.NewSetVersionLifecycleStateOptions("orders", 1, schemaregistryv1.SchemaStateDeleted))
+		Expect(err).To(BeNil())
+		Expect(requests).To(Equal([]string{
+			"GET /artifacts/orders/versions ",
+			"DELETE /artifacts/orders/versions/1 ",
+		}))
+		record, err = lifecycle.GetVersionLifecycleState(schemaregistryService.NewGetVersionLifecycleStateOptions("orders", 1))
+		Expect(err).To(BeNil())
+		Expect(record.State).To(Equal(schemaregistryv1.SchemaStateEnabled))
+	})
+	It(`Refuses transitions that skip a state`, func() {
+		_, err := lifecycle.SetVersionLifecycleStateWithContext(ctx, schemaregistryService.NewSetVersionLifecycleStateOptions("orders", 1, schemaregistryv1.SchemaStateDisabled))
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("version 1 of schema 'orders' cannot move from ENABLED to DISABLED"))
+		_, err = lifecycle.SetVersionLifecycleStateWithContext(ctx, schemaregistryService.NewSetVersionLifecycleStateOptions("orders", 1, schemaregistryv1.SchemaStateDeleted))
+		Expect(err).ToNot(BeNil())
+		Expect(requests).To(BeEmpty())
+
+		record, err := lifecycle.SetVersionLifecycleStateWithContext(ctx, schemaregistryService.NewSetVersionLifecycleStateOptions("orders", 1, schemaregistryv1.SchemaStateEnabled))
+		Expect(err).To(BeNil())
+		Expect(record.State).To(Equal(schemaregistryv1.SchemaStateEnabled))
+	})
+	It(`Refuses to disable the latest enabled version`, func() {
+		_, err := lifecycle.SetVersionLifecycleStateWithContext(ctx, schemaregistryService.NewSetVersionLifecycleStateOptions("orders", 3, schemaregistryv1.SchemaStateDeprecated))
+		Expect(err).To(BeNil())
+		now = now.Add(48 * time.Hour)
+		_, err = lifecycle.SetVersionLifecycleStateWithContext(ctx, schemaregistryService.NewSetVersionLifecycleStateOptions("orders", 3, schemaregistryv1.SchemaStateDisabled))
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("version 3 is the latest enabled version of schema 'orders'"))
+
+		// Enabling a DEPRECATED version again only changes its record.
+		requests = nil
+		_, err = lifecycle.SetVersionLifecycleStateWithContext(ctx, schemaregistryService.NewSetVersionLifecycleStateOptions("orders", 3, schemaregistryv1.SchemaStateEnabled))
+		Expect(err).To(BeNil())
+		Expect(requests).To(BeEmpty())
+	})
+	It(`Re-enables a DISABLED version in the registry`, func() {
+		_, err := lifecycle.SetVersionLifecycleStateWithContext(ctx, schemaregistryService.NewSetVersionLifecycleStateOptions("orders", 2, schemaregistryv1.SchemaStateDeprecated))
+		Expect(err).To(BeNil())
+		now = now.Add(48 * time.Hour)
+		_, err = lifecycle.SetVersionLifecycleStateWithContext(ctx, schemaregistryService.NewSetVersionLifecycleStateOptions("orders", 2, schemaregistryv1.SchemaStateDisabled))
+		Expect(err).To(BeNil())
+		requests = nil
+		_, err = lifecycle.SetVersionLifecycleStateWithContext(ctx, schemaregistryService.NewSetVersionLifecycleStateOptions("orders", 2, schemaregistryv1.SchemaStateEnabled))
+		Expect(err).To(BeNil())
+		Expect(requests).To(Equal([]string{`PUT /artifacts/orders/versions/2/state {"state":"ENABLED"}`}))
+	})
+	It(`Moves a whole schema through its lifecycle`, func() {
+		_, err := lifecycle.SetVersionLifecycleStateWithContext(ctx, schemaregistryService.NewSetVersionLifecycleStateOptions("orders", 1, schemaregistryv1.SchemaStateDeprecated))
+		Expect(err).To(BeNil())
+		_, err = lifecycle.SetSchemaLifecycleStateWithContext(ctx, schemaregistryService.NewSetSchemaLifecycleStateOptions("orders", schemaregistryv1.SchemaStateDeprecated))
+		Expect(err).To(BeNil())
+		now = now.Add(48 * time.Hour)
+		_, err = lifecycle.SetSchemaLifecycleStateWithContext(ctx, schemaregistryService.NewSetSchemaLifecycleStateOptions("orders", schemaregistryv1.SchemaStateDisabled))
+		Expect(err).To(BeNil())
+		now = now.Add(8 * 24 * time.Hour)
+		_, err = lifecycle.SetSchemaLifecycleStateWithContext(ctx, schemaregistryService.NewSetSchemaLifecycleStateOptions("orders", schemaregistryv1.SchemaStateDeleted))
+		Expect(err).To(BeNil())
+		Expect(requests).To(Equal([]string{
+			`PUT /artifacts/orders/state {"state":"DISABLED"}`,
+			"GET /artifacts/orders/versions ",
+			"DELETE /artifacts/orders ",
+		}))
+
+		// The records of the versions are deleted with the schema.
+		record, err := store.Get(schemaregistryv1.LifecycleKey{SchemaID: "orders", Version: 1})
+		Expect(err).To(BeNil())
+		Expect(record).To(BeNil())
+		record, err = store.Get(schemaregistryv1.LifecycleKey{SchemaID: "orders"})
+		Expect(err).To(BeNil())
+		Expect(record).To(BeNil())
+	})
+	It(`Validates the options`, func() {
+		_, err := lifecycle.SetVersionLifecycleState(nil)
+		Expect(err).ToNot(BeNil())
+		_, err = lifecycle.SetSchemaLifecycleState(schemaregistryService.NewSetSchemaLifecycleStateOptions("", schemaregistryv1.SchemaStateDeprecated))
+		Expect(err).ToNot(BeNil())
+		_, err = lifecycle.GetVersionLifecycleState(&schemaregistryv1.GetVersionLifecycleStateOptions{ID: core.StringPtr("orders")})
+		Expect(err).ToNot(BeNil())
+		_, err = lifecycle.PlanRetention(&schemaregistryv1.ApplyRetentionOptions{ID: core.StringPtr("orders")})
+		Expect(err).ToNot(BeNil())
+		Expect(requests).To(BeEmpty())
+
+		record, err := lifecycle.GetSchemaLifecycleState(schemaregistryService.NewGetSchemaLifecycleStateOptions("orders"))
+		Expect(err).To(BeNil())
+		Expect(record.State).To(Equal(schemaregistryv1.SchemaStateEnabled))
+	})
+	It(`Plans and applies a retention policy`, func() {
+		for _, version := range []int64{1, 2, 3} {
+			_, err := lifecycle.SetVersionLifecycleStateWithContext(ctx, schemaregistryService.NewSetVersionLifecycleStateOptions("orders", version, schemaregistryv1.SchemaStateDeprecated))
+			Expect(err).To(BeNil())
+		}
+		now = now.Add(48 * time.Hour)
+		for _, version := range []int64{1, 2} {
+			_, err := lifecycle.SetVersionLifecycleStateWithContext(ctx, schemaregistryService.NewSetVersionLifecycleStateOptions("orders", version, schemaregistryv1.SchemaStateDisabled))
+			Expect(err).To(BeNil())
+			now = now.Add(10 * 24 * time.Hour)
+		}
+
+		policy := schemaregistryv1.RetentionPolicy{KeepLast: 1, DeleteDisabledOlderThan: 15 * 24 * time.Hour}
+		requests = nil
+		plan, err := lifecycle.PlanRetentionWithContext(ctx, schemaregistryService.NewApplyRetentionOptions("orders", policy))
+		Expect(err).To(BeNil())
+		Expect(plan.Actions).To(Equal([]schemaregistryv1.LifecycleAction{
+			{SchemaID: "orders", Version: 1, Action: schemaregistryv1.LifecycleActionDeleteConst, Reason: "disabled for 480h0m0s"},
+		}))
+		Expect(requests).To(Equal([]string{"GET /artifacts/orders/versions "}))
+
+		// The minimum disabled duration applies even when the policy is shorter.
+		policy.DeleteDisabledOlderThan = time.Hour
+		plan, err = lifecycle.PlanRetentionWithContext(ctx, schemaregistryService.NewApplyRetentionOptions("orders", policy))
+		Expect(err).To(BeNil())
+		Expect(plan.Actions).To(HaveLen(2))
+		now = now.Add(-4 * 24 * time.Hour)
+		plan, err = lifecycle.PlanRetentionWithContext(ctx, schemaregistryService.NewApplyRetentionOptions("orders", policy))
+		Expect(err).To(BeNil())
+		Expect(plan.Actions).To(HaveLen(1))
+
+		now = now.Add(4 * 24 * time.Hour)
+		requests = nil
+		plan, err = lifecycle.ApplyRetentionWithContext(ctx, schemaregistryService.NewApplyRetentionOptions("orders", policy))
+		Expect(err).To(BeNil())
+		Expect(plan.Actions).To(HaveLen(2))
+		Expect(plan.Actions[0].Applied).To(BeTrue())
+		Expect(plan.Actions[1].Applied).To(BeTrue())
+		Expect(requests).To(ContainElement("DELETE /artifacts/orders/versions/1 "))
+		Expect(requests).To(ContainElement("DELETE /artifacts/orders/versions/2 "))
+
+		data, err := json.Marshal(plan.Actions[0])
+		Expect(err).To(BeNil())
+		Expect(string(data)).To(Equal(`{"schema_id":"orders","version":1,"action":"delete","reason":"disabled for 480h0m0s","applied":true}`))
+	})
+})
@@ -38,6 +38,9 @@ import (
 // API Version: 1.4.1
 type SchemaregistryV1 struct {
 	Service *core.BaseService
+
+	// The default timeouts of the operations, applied when their context has no deadline.
+	timeouts common.OperationTimeouts
 }
 
 // DefaultServiceName is the default key used to find external configuration information.
@@ -165,6 +168,22 @@ func (schemaregistry *SchemaregistryV1) DisableRetries() {
 	schemaregistry.Service.DisableRetries()
 }
 
+// SetOperationTimeouts sets the default timeouts of the operations of the service, by operation class. The timeouts
+// apply to operations whose context has no deadline, including those called without a context.
+func (schemaregistry *SchemaregistryV1) SetOperationTimeouts(timeouts common.OperationTimeouts) {
+	schemaregistry.timeouts = timeouts
+}
+
+// GetOperationTimeouts returns the default timeouts of the operations of the service.
+func (schemaregistry *SchemaregistryV1) GetOperationTimeouts() common.OperationTimeouts {
+	return schemaregistry.timeouts
+}
+
+// operationContext returns the context of an operation of class, bounded by the default timeout of the class.
+func (schemaregistry *SchemaregistryV1) operationContext(ctx context.Context, class string) (context.Context, context.CancelFunc) {
+	return common.OperationContext(ctx, schemaregistry.timeouts, class)
+}
+
 // GetGlobalRule : Retrieve the configuration for a global rule
 // Retrieves the configuration for the specified global rule. The value of the global rule is used as the _default_ when
 // a schema does not have a corresponding schema compatibility rule defined.
@@ -176,6 +195,9 @@ func (schemaregistry *SchemaregistryV1) GetGlobalRule(getGlobalRuleOptions *GetG
 
 // GetGlobalRuleWithContext is an alternate form of the GetGlobalRule method which supports a Context parameter
 func (schemaregistry *SchemaregistryV1) GetGlobalRuleWithContext(ctx context.Context, getGlobalRuleOptions *GetGlobalRuleOptions) (result *Rule, response *core.DetailedResponse, err error) {
+	ctx, cancel := schemaregistry.operationContext(ctx, common.OperationClassRead)
+	defer cancel()
+
 	err = core.ValidateNotNil(getGlobalRuleOptions, "getGlobalRuleOptions cannot be nil")
 	if err != nil {
 		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
@@ -246,6 +268,9 @@ func (schemaregistry *SchemaregistryV1) UpdateGlobalRule(updateGlobalRuleOptions
 
 // UpdateGlobalRuleWithContext is an alternate form of the UpdateGlobalRule method which supports a Context parameter
 func (schemaregistry *SchemaregistryV1) UpdateGlobalRuleWithContext(ctx context.Context, updateGlobalRuleOptions *UpdateGlobalRuleOptions) (result *Rule, response *core.DetailedResponse, err error) {
+	ctx, cancel := schemaregistry.operationContext(ctx, common.OperationClassWrite)
+	defer cancel()
+
 	err = core.ValidateNotNil(updateGlobalRuleOptions, "updateGlobalRuleOptions cannot be nil")
 	if err != nil {
 		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
@@ -330,6 +355,9 @@ func (schemaregistry *SchemaregistryV1) CreateSchemaRule(createSchemaRuleOptions
 
 // CreateSchemaRuleWithContext is an alternate form of the CreateSchemaRule method which supports a Context parameter
 func (schemaregistry *SchemaregistryV1) CreateSchemaRuleWithContext(ctx context.Context, createSchemaRuleOptions *CreateSchemaRuleOptions) (result *Rule, response *core.DetailedResponse, err error) {
+	ctx, cancel := schemaregistry.operationContext(ctx, common.OperationClassWrite)
+	defer cancel()
+
 	err = core.ValidateNotNil(createSchemaRuleOptions, "createSchemaRuleOptions cannot be nil")
 	if err != nil {
 		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
@@ -414,6 +442,9 @@ func (schemaregistry *SchemaregistryV1) GetSchemaRule(getSchemaRuleOptions *GetS
 
 // GetSchemaRuleWithContext is an alternate form of the GetSchemaRule method which supports a Context parameter
 func (schemaregistry *SchemaregistryV1) GetSchemaRuleWithContext(ctx context.Context, getSchemaRuleOptions *GetSchemaRuleOptions) (result *Rule, response *core.DetailedResponse, err error) {
+	ctx, cancel := schemaregistry.operationContext(ctx, common.OperationClassRead)
+	defer cancel()
+
 	err = core.ValidateNotNil(getSchemaRuleOptions, "getSchemaRuleOptions cannot be nil")
 	if err != nil {
 		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
@@ -485,6 +516,9 @@ func (schemaregistry *SchemaregistryV1) UpdateSchemaRule(updateSchemaRuleOptions
 
 // UpdateSchemaRuleWithContext is an alternate form of the UpdateSchemaRule method which supports a Context parameter
 func (schemaregistry *SchemaregistryV1) UpdateSchemaRuleWithContext(ctx context.Context, updateSchemaRuleOptions *UpdateSchemaRuleOptions) (result *Rule, response *core.DetailedResponse, err error) {
+	ctx, cancel := schemaregistry.operationContext(ctx, common.OperationClassWrite)
+	defer cancel()
+
 	err = core.ValidateNotNil(updateSchemaRuleOptions, "updateSchemaRuleOptions cannot be nil")
 	if err != nil {
 		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
@@ -570,6 +604,9 @@ func (schemaregistry *SchemaregistryV1) DeleteSchemaRule(deleteSchemaRuleOptions
 
 // DeleteSchemaRuleWithContext is an alternate form of the DeleteSchemaRule method which supports a Context parameter
 func (schemaregistry *SchemaregistryV1) DeleteSchemaRuleWithContext(ctx context.Context, deleteSchemaRuleOptions *DeleteSchemaRuleOptions) (response *core.DetailedResponse, err error) {
+	ctx, cancel := schemaregistry.operationContext(ctx, common.OperationClassWrite)
+	defer cancel()
+
 	err = core.ValidateNotNil(deleteSchemaRuleOptions, "deleteSchemaRuleOptions cannot be nil")
 	if err != nil {
 		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
@@ -630,6 +667,9 @@ func (schemaregistry *SchemaregistryV1) SetSchemaState(setSchemaStateOptions *Se
 
 // SetSchemaStateWithContext is an alternate form of the SetSchemaState method which supports a Context parameter
 func (schemaregistry *SchemaregistryV1) SetSchemaStateWithContext(ctx context.Context, setSchemaStateOptions *SetSchemaStateOptions) (response *core.DetailedResponse, err error) {
+	ctx, cancel := schemaregistry.operationContext(ctx, common.OperationClassWrite)
+	defer cancel()
+
 	err = core.ValidateNotNil(setSchemaStateOptions, "setSchemaStateOptions cannot be nil")
 	if err != nil {
 		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
@@ -700,6 +740,9 @@ func (schemaregistry *SchemaregistryV1) SetSchemaVersionState(setSchemaVersionSt
 
 // SetSchemaVersionStateWithContext is an alternate form of the SetSchemaVersionState method which supports a Context parameter
 func (schemaregistry *SchemaregistryV1) SetSchemaVersionStateWithContext(ctx context.Context, setSchemaVersionStateOptions *SetSchemaVersionStateOptions) (response *core.DetailedResponse, err error) {
+	ctx, cancel := schemaregistry.operationContext(ctx, common.OperationClassWrite)
+	defer cancel()
+
 	err = core.ValidateNotNil(setSchemaVersionStateOptions, "setSchemaVersionStateOptions cannot be nil")
 	if err != nil {
 		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
@@ -771,6 +814,9 @@ func (schemaregistry *SchemaregistryV1) ListVersions(listVersionsOptions *ListVe
 
 // ListVersionsWithContext is an alternate form of the ListVersions method which supports a Context parameter
 func (schemaregistry *SchemaregistryV1) ListVersionsWithContext(ctx context.Context, listVersionsOptions *ListVersionsOptions) (result []int64, response *core.DetailedResponse, err error) {
+	ctx, cancel := schemaregistry.operationContext(ctx, common.OperationClassRead)
+	defer cancel()
+
 	err = core.ValidateNotNil(listVersionsOptions, "listVersionsOptions cannot be nil")
 	if err != nil {
 		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
@@ -835,6 +881,9 @@ func (schemaregistry *SchemaregistryV1) CreateVersion(createVersionOptions *Crea
 
 // CreateVersionWithContext is an alternate form of the CreateVersion method which supports a Context parameter
 func (schemaregistry *SchemaregistryV1) CreateVersionWithContext(ctx context.Context, createVersionOptions *CreateVersionOptions) (result *SchemaMetadata, response *core.DetailedResponse, err error) {
+	ctx, cancel := schemaregistry.operationContext(ctx, common.OperationClassWrite)
+	defer cancel()
+
 	err = core.ValidateNotNil(createVersionOptions, "createVersionOptions cannot be nil")
 	if err != nil {
 		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
@@ -915,6 +964,9 @@ func (schemaregistry *SchemaregistryV1) GetVersion(getVersionOptions *GetVersion
 
 // GetVersionWithContext is an alternate form of the GetVersion method which supports a Context parameter
 func (schemaregistry *SchemaregistryV1) GetVersionWithContext(ctx context.Context, getVersionOptions *GetVersionOptions) (result *AvroSchema, response *core.DetailedResponse, err error) {
+	ctx, cancel := schemaregistry.operationContext(ctx, common.OperationClassRead)
+	defer cancel()
+
 	err = core.ValidateNotNil(getVersionOptions, "getVersionOptions cannot be nil")
 	if err != nil {
 		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
@@ -985,6 +1037,9 @@ func (schemaregistry *SchemaregistryV1) DeleteVersion(deleteVersionOptions *Dele
 
 // DeleteVersionWithContext is an alternate form of the DeleteVersion method which supports a Context parameter
 func (schemaregistry *SchemaregistryV1) DeleteVersionWithContext(ctx context.Context, deleteVersionOptions *DeleteVersionOptions) (response *core.DetailedResponse, err error) {
+	ctx, cancel := schemaregistry.operationContext(ctx, common.OperationClassWrite)
+	defer cancel()
+
 	err = core.ValidateNotNil(deleteVersionOptions, "deleteVersionOptions cannot be nil")
 	if err != nil {
 		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
@@ -1045,6 +1100,9 @@ func (schemaregistry *SchemaregistryV1) ListSchemas(listSchemasOptions *ListSche
 
 // ListSchemasWithContext is an alternate form of the ListSchemas method which supports a Context parameter
 func (schemaregistry *SchemaregistryV1) ListSchemasWithContext(ctx context.Context, listSchemasOptions *ListSchemasOptions) (result []string, response *core.DetailedResponse, err error) {
+	ctx, cancel := schemaregistry.operationContext(ctx, common.OperationClassRead)
+	defer cancel()
+
 	err = core.ValidateStruct(listSchemasOptions, "listSchemasOptions")
 	if err != nil {
 		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
@@ -1100,6 +1158,9 @@ func (schemaregistry *SchemaregistryV1) CreateSchema(createSchemaOptions *Create
 
 // CreateSchemaWithContext is an alternate form of the CreateSchema method which supports a Context parameter
 func (schemaregistry *SchemaregistryV1) CreateSchemaWithContext(ctx context.Context, createSchemaOptions *CreateSchemaOptions) (result *SchemaMetadata, response *core.DetailedResponse, err error) {
+	ctx, cancel := schemaregistry.operationContext(ctx, common.OperationClassWrite)
+	defer cancel()
+
 	err = core.ValidateNotNil(createSchemaOptions, "createSchemaOptions cannot be nil")
 	if err != nil {
 		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
@@ -1179,6 +1240,9 @@ func (schemaregistry *SchemaregistryV1) GetLatestSchema(getLatestSchemaOptions *
 
 // GetLatestSchemaWithContext is an alternate form of the GetLatestSchema method which supports a Context parameter
 func (schemaregistry *SchemaregistryV1) GetLatestSchemaWithContext(ctx context.Context, getLatestSchemaOptions *GetLatestSchemaOptions) (result *AvroSchema, response *core.DetailedResponse, err error) {
+	ctx, cancel := schemaregistry.operationContext(ctx, common.OperationClassRead)
+	defer cancel()
+
 	err = core.ValidateNotNil(getLatestSchemaOptions, "getLatestSchemaOptions cannot be nil")
 	if err != nil {
 		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
@@ -1248,6 +1312,9 @@ func (schemaregistry *SchemaregistryV1) DeleteSchema(deleteSchemaOptions *Delete
 
 // DeleteSchemaWithContext is an alternate form of the DeleteSchema method which supports a Context parameter
 func (schemaregistry *SchemaregistryV1) DeleteSchemaWithContext(ctx context.Context, deleteSchemaOptions *DeleteSchemaOptions) (response *core.DetailedResponse, err error) {
+	ctx, cancel := schemaregistry.operationContext(ctx, common.OperationClassWrite)
+	defer cancel()
+
 	err = core.ValidateNotNil(deleteSchemaOptions, "deleteSchemaOptions cannot be nil")
 	if err != nil {
 		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
@@ -1307,6 +1374,9 @@ func (schemaregistry *SchemaregistryV1) UpdateSchema(updateSchemaOptions *Update
 
 // UpdateSchemaWithContext is an alternate form of the UpdateSchema method which supports a Context parameter
 func (schemaregistry *SchemaregistryV1) UpdateSchemaWithContext(ctx context.Context, updateSchemaOptions *UpdateSchemaOptions) (result *SchemaMetadata, response *core.DetailedResponse, err error) {
+	ctx, cancel := schemaregistry.operationContext(ctx, common.OperationClassWrite)
+	defer cancel()
+
 	err = core.ValidateNotNil(updateSchemaOptions, "updateSchemaOptions cannot be nil")
 	if err != nil {
 		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
@@ -26,8 +26,11 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"strings"
+	"sync/atomic"
 	"time"
 
+	common "github.com/IBM/eventstreams-go-sdk/pkg/common"
 	"github.com/IBM/eventstreams-go-sdk/pkg/schemaregistryv1"
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/go-openapi/strfmt"
@@ -162,6 +165,96 @@ var _ = Describe(`SchemaregistryV1`, func() {
 			fmt.Fprintf(GinkgoWriter, "Expected error: %s\n", err.Error())
 		})
 	})
+	Describe(`Operation timeouts`, func() {
+		var requests atomic.Int32
+		BeforeEach(func() {
+			requests.Store(0)
+			testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+				requests.Add(1)
+
+				res.Header().Set("Content-type", "application/json")
+				if strings.HasPrefix(req.URL.EscapedPath(), "/artifacts/unavailable") {
+					res.WriteHeader(503)
+					fmt.Fprintf(res, "%s", `{"error_code": 503, "message": "unavailable"}`)
+					return
+				}
+
+				// Sleep a short time to support a timeout test
+				time.Sleep(100 * time.Millisecond)
+				if req.Method == "DELETE" {
+					res.WriteHeader(204)
+					return
+				}
+				res.WriteHeader(200)
+				fmt.Fprintf(res, "%s", `{"type": "COMPATIBILITY", "config": "BACKWARD"}`)
+			}))
+		})
+		It(`Apply the timeout of the operation class`, func() {
+			schemaregistryService, serviceErr := schemaregistryv1.NewSchemaregistryV1(&schemaregistryv1.SchemaregistryV1Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+			timeouts := common.OperationTimeouts{Read: 50 * time.Millisecond, Write: 50 * time.Millisecond}
+			schemaregistryService.SetOperationTimeouts(timeouts)
+			Expect(schemaregistryService.GetOperationTimeouts()).To(Equal(timeouts))
+
+			_, _, operationErr := schemaregistryService.GetGlobalRule(schemaregistryService.NewGetGlobalRuleOptions("COMPATIBILITY"))
+			Expect(operationErr).ToNot(BeNil())
+			Expect(operationErr.Error()).To(ContainSubstring("deadline exceeded"))
+			_, operationErr = schemaregistryService.DeleteSchema(schemaregistryService.NewDeleteSchemaOptions("testString"))
+			Expect(operationErr).ToNot(BeNil())
+			Expect(operationErr.Error()).To(ContainSubstring("deadline exceeded"))
+
+			// A deadline of the context replaces the default timeout
+			ctx, cancelFunc := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancelFunc()
+			result, _, operationErr := schemaregistryService.GetGlobalRuleWithContext(ctx, schemaregistryService.NewGetGlobalRuleOptions("COMPATIBILITY"))
+			Expect(operationErr).To(BeNil())
+			Expect(*result.Config).To(Equal("BACKWARD"))
+
+			// Write operations have no timeout unless one is set
+			schemaregistryService.SetOperationTimeouts(common.OperationTimeouts{Read: 50 * time.Millisecond})
+			_, operationErr = schemaregistryService.DeleteSchema(schemaregistryService.NewDeleteSchemaOptions("testString"))
+			Expect(operationErr).To(BeNil())
+		})
+		It(`Stop retrying when the timeout expires`, func() {
+			schemaregistryService, serviceErr := schemaregistryv1.NewSchemaregistryV1(&schemaregistryv1.SchemaregistryV1Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+			schemaregistryService.EnableRetries(5, 5*time.Second)
+			schemaregistryService.SetOperationTimeouts(common.OperationTimeouts{Read: 200 * time.Millisecond})
+
+			start := time.Now()
+			_, _, operationErr := schemaregistryService.GetLatestSchema(schemaregistryService.NewGetLatestSchemaOptions("unavailable"))
+			Expect(operationErr).ToNot(BeNil())
+			// Without the timeout, the retries would wait for more than 10 seconds
+			Expect(time.Since(start)).To(BeNumerically("<", 3*time.Second))
+			Expect(requests.Load()).To(BeNumerically("<", int32(6)))
+		})
+		It(`Stop retrying when the context is canceled`, func() {
+			schemaregistryService, serviceErr := schemaregistryv1.NewSchemaregistryV1(&schemaregistryv1.SchemaregistryV1Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+			schemaregistryService.EnableRetries(5, 5*time.Second)
+
+			ctx, cancelFunc := context.WithCancel(context.Background())
+			time.AfterFunc(200*time.Millisecond, cancelFunc)
+			start := time.Now()
+			_, _, operationErr := schemaregistryService.GetLatestSchemaWithContext(ctx, schemaregistryService.NewGetLatestSchemaOptions("unavailable"))
+			Expect(operationErr).ToNot(BeNil())
+			Expect(time.Since(start)).To(BeNumerically("<", 3*time.Second))
+			Expect(requests.Load()).To(BeNumerically("<", int32(6)))
+		})
+		AfterEach(func() {
+			testServer.Close()
+		})
+	})
 	Describe(`GetGlobalRule(getGlobalRuleOptions *GetGlobalRuleOptions) - Operation response error`, func() {
 		getGlobalRulePath := "/rules/COMPATIBILITY"
 		Context(`Using mock server endpoint with invalid JSON response`, func() {
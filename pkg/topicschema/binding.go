@@ -0,0 +1,348 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package topicschema binds the topics of an Event Streams instance to the schema registry schemas that describe
+// their keys and values, following a naming strategy, and validates payloads against the bound schemas before they
+// are produced.
+package topicschema
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/IBM/eventstreams-go-sdk/pkg/adminrestv1"
+	"github.com/IBM/eventstreams-go-sdk/pkg/avro"
+	common "github.com/IBM/eventstreams-go-sdk/pkg/common"
+	"github.com/IBM/eventstreams-go-sdk/pkg/schemaregistryv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Constants associated with the Binding.Role property.
+// The part of a record that a schema describes.
+const (
+	SchemaRoleKey   = "key"
+	SchemaRoleValue = "value"
+)
+
+// NamingStrategy : Relates the names of topics to the IDs of the schemas that describe their records.
+type NamingStrategy interface {
+	// SchemaID returns the ID of the schema for the key or value of records of topic whose record type has the full
+	// name recordName. Strategies that do not use record names ignore recordName.
+	SchemaID(topic string, role string, recordName string) string
+
+	// Match returns the role and record name that schemaID binds to topic, and whether it binds to topic at all.
+	Match(topic string, schemaID string) (role string, recordName string, ok bool)
+}
+
+// TopicNameStrategy : Binds the schemas with IDs `<topic>-key` and `<topic>-value` to a topic. This is the default
+// strategy.
+type TopicNameStrategy struct{}
+
+// SchemaID returns `<topic>-<role>`.
+func (TopicNameStrategy) SchemaID(topic string, role string, recordName string) string {
+	return topic + "-" + role
+}
+
+// Match matches `<topic>-key` and `<topic>-value`.
+func (TopicNameStrategy) Match(topic string, schemaID string) (role string, recordName string, ok bool) {
+	for _, role := range []string{SchemaRoleKey, SchemaRoleValue} {
+		if schemaID == topic+"-"+role {
+			return role, "", true
+		}
+	}
+	return "", "", false
+}
+
+// RecordNameStrategy : Binds the schemas whose IDs are the full names of record types to the topics that use the
+// record types. Record names alone do not identify topics, so the record types used by each topic are configured.
+type RecordNameStrategy struct {
+	// The full names of the value record types used by each topic.
+	Records map[string][]string
+
+	// The full names of the key record types used by each topic.
+	KeyRecords map[string][]string
+}
+
+// SchemaID returns recordName.
+func (strategy RecordNameStrategy) SchemaID(topic string, role string, recordName string) string {
+	return recordName
+}
+
+// Match matches the record names configured for topic.
+func (strategy RecordNameStrategy) Match(topic string, schemaID string) (role string, recordName string, ok bool) {
+	for _, name := range strategy.KeyRecords[topic] {
+		if name == schemaID {
+			return SchemaRoleKey, name, true
+		}
+	}
+	for _, name := range strategy.Records[topic] {
+		if name == schemaID {
+			return SchemaRoleValue, name, true
+		}
+	}
+	return "", "", false
+}
+
+// TopicRecordNameStrategy : Binds the schemas with IDs `<topic>-<record name>` to a topic, where the record name is
+// the full name of a value record type.
+type TopicRecordNameStrategy struct{}
+
+// SchemaID returns `<topic>-<recordName>`.
+func (TopicRecordNameStrategy) SchemaID(topic string, role string, recordName string) string {
+	return topic + "-" + recordName
+}
+
+// Match matches IDs that start with `<topic>-`, except for the IDs used by TopicNameStrategy.
+func (TopicRecordNameStrategy) Match(topic string, schemaID string) (role string, recordName string, ok bool) {
+	recordName = strings.TrimPrefix(schemaID, topic+"-")
+	if recordName == schemaID || recordName == "" || recordName == SchemaRoleKey || recordName == SchemaRoleValue {
+		return "", "", false
+	}
+	return SchemaRoleValue, recordName, true
+}
+
+// Binding : A schema bound to a topic.
+type Binding struct {
+	// The name of the topic.
+	Topic string `json:"topic"`
+
+	// Whether the schema describes keys or values, one of the SchemaRole constants.
+	Role string `json:"role"`
+
+	// The ID of the schema.
+	SchemaID string `json:"schema_id"`
+
+	// The full name of the record type, for strategies that use record names.
+	RecordName string `json:"record_name,omitempty"`
+}
+
+// BindingReport : The bindings between all topics and schemas.
+type BindingReport struct {
+	// The bindings, sorted by topic and schema ID.
+	Bindings []Binding `json:"bindings"`
+
+	// The topics that no schema is bound to.
+	TopicsWithoutSchemas []string `json:"topics_without_schemas"`
+
+	// The schemas that are not bound to any topic.
+	SchemasWithoutTopics []string `json:"schemas_without_topics"`
+}
+
+// Binder : Finds the schemas bound to topics and validates payloads against them. The list of schema IDs and the
+// schemas used for validation are cached until Refresh is called. A Binder is safe for concurrent use.
+type Binder struct {
+	adminrest *adminrestv1.AdminrestV1
+	registry  *schemaregistryv1.SchemaregistryV1
+	strategy  NamingStrategy
+
+	mutex     sync.Mutex
+	schemaIDs []string
+	schemas   map[string]*avro.Schema
+}
+
+// NewBinder : constructs a Binder for the topics of adminrest and the schemas of registry. A nil strategy means
+// TopicNameStrategy.
+func NewBinder(adminrest *adminrestv1.AdminrestV1, registry *schemaregistryv1.SchemaregistryV1, strategy NamingStrategy) *Binder {
+	if strategy == nil {
+		strategy = TopicNameStrategy{}
+	}
+	return &Binder{
+		adminrest: adminrest,
+		registry:  registry,
+		strategy:  strategy,
+		schemas:   map[string]*avro.Schema{},
+	}
+}
+
+// Refresh reloads the list of schema IDs and forgets the cached schemas.
+func (binder *Binder) Refresh(ctx context.Context) (err error) {
+	schemaIDs, _, err := binder.registry.ListSchemasWithContext(ctx, binder.registry.NewListSchemasOptions())
+	if err != nil {
+		err = core.SDKErrorf(err, "", "list-schemas-error", common.GetComponentInfo())
+		return
+	}
+	if schemaIDs == nil {
+		schemaIDs = []string{}
+	}
+	binder.mutex.Lock()
+	defer binder.mutex.Unlock()
+	binder.schemaIDs = schemaIDs
+	binder.schemas = map[string]*avro.Schema{}
+	return
+}
+
+// Report lists the topics and schemas and reports which schemas are bound to which topics. A schema is bound to every
+// topic that it matches, except with TopicRecordNameStrategy, where a schema ID that starts with several topic names,
+// for example `orders-audit-value` with the topics `orders` and `orders-audit`, is bound only to the topic with the
+// longest name.
+func (binder *Binder) Report(ctx context.Context) (result *BindingReport, err error) {
+	topics, _, err := binder.adminrest.ListTopicsWithContext(ctx, binder.adminrest.NewListTopicsOptions())
+	if err != nil {
+		err = core.SDKErrorf(err, "", "list-topics-error", common.GetComponentInfo())
+		return
+	}
+	err = binder.Refresh(ctx)
+	if err != nil {
+		return
+	}
+	schemaIDs := binder.loadedSchemaIDs()
+
+	var topicNames []string
+	for _, topic := range topics {
+		if topic.Name != nil {
+			topicNames = append(topicNames, *topic.Name)
+		}
+	}
+	sort.Strings(topicNames)
+
+	longestOnly := false
+	switch binder.strategy.(type) {
+	case TopicRecordNameStrategy, *TopicRecordNameStrategy:
+		longestOnly = true
+	}
+	result = &BindingReport{Bindings: []Binding{}, TopicsWithoutSchemas: []string{}, SchemasWithoutTopics: []string{}}
+	bound := map[string]bool{}
+	for _, schemaID := range schemaIDs {
+		var matches []Binding
+		for _, topic := range topicNames {
+			role, recordName, ok := binder.strategy.Match(topic, schemaID)
+			if !ok {
+				continue
+			}
+			binding := Binding{Topic: topic, Role: role, SchemaID: schemaID, RecordName: recordName}
+			if longestOnly && len(matches) > 0 {
+				if len(topic) > len(matches[0].Topic) {
+					matches[0] = binding
+				}
+				continue
+			}
+			matches = append(matches, binding)
+		}
+		if len(matches) == 0 {
+			result.SchemasWithoutTopics = append(result.SchemasWithoutTopics, schemaID)
+			continue
+		}
+		for _, binding := range matches {
+			bound[binding.Topic] = true
+		}
+		result.Bindings = append(result.Bindings, matches...)
+	}
+	for _, topic := range topicNames {
+		if !bound[topic] {
+			result.TopicsWithoutSchemas = append(result.TopicsWithoutSchemas, topic)
+		}
+	}
+	sort.Strings(result.SchemasWithoutTopics)
+	sort.Slice(result.Bindings, func(i, j int) bool {
+		if result.Bindings[i].Topic != result.Bindings[j].Topic {
+			return result.Bindings[i].Topic < result.Bindings[j].Topic
+		}
+		return result.Bindings[i].SchemaID < result.Bindings[j].SchemaID
+	})
+	return
+}
+
+// Bindings returns the schemas bound to a topic, sorted by schema ID. The schema IDs are loaded on first use.
+func (binder *Binder) Bindings(ctx context.Context, topic string) (result []Binding, err error) {
+	if binder.loadedSchemaIDs() == nil {
+		err = binder.Refresh(ctx)
+		if err != nil {
+			return
+		}
+	}
+	for _, schemaID := range binder.loadedSchemaIDs() {
+		if role, recordName, ok := binder.strategy.Match(topic, schemaID); ok {
+			result = append(result, Binding{Topic: topic, Role: role, SchemaID: schemaID, RecordName: recordName})
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].SchemaID < result[j].SchemaID })
+	return
+}
+
+// Validate checks that a key or value to be produced to a topic matches one of the schemas bound to the topic for the
+// role, using avro.Schema.ValidateValue. The latest version of each schema is used.
+func (binder *Binder) Validate(ctx context.Context, topic string, role string, value interface{}) (err error) {
+	bindings, err := binder.Bindings(ctx, topic)
+	if err != nil {
+		return
+	}
+	var problems []string
+	for _, binding := range bindings {
+		if binding.Role != role {
+			continue
+		}
+		err = binder.validate(ctx, binding.SchemaID, value)
+		if err == nil {
+			return
+		}
+		problems = append(problems, err.Error())
+	}
+	if len(problems) == 0 {
+		err = core.SDKErrorf(nil, fmt.Sprintf("no %s schema is bound to topic '%s'", role, topic), "no-schema-binding", common.GetComponentInfo())
+		return
+	}
+	err = core.SDKErrorf(nil, fmt.Sprintf("%s for topic '%s' matches no bound schema: %s", role, topic, strings.Join(problems, "; ")), "schema-validation-error", common.GetComponentInfo())
+	return
+}
+
+// ValidateRecord checks that a key or value to be produced to a topic matches the schema that the naming strategy
+// binds to the topic, role and record name, without listing the schemas.
+func (binder *Binder) ValidateRecord(ctx context.Context, topic string, role string, recordName string, value interface{}) (err error) {
+	return binder.validate(ctx, binder.strategy.SchemaID(topic, role, recordName), value)
+}
+
+func (binder *Binder) validate(ctx context.Context, schemaID string, value interface{}) (err error) {
+	schema, err := binder.schema(ctx, schemaID)
+	if err != nil {
+		return
+	}
+	err = schema.ValidateValue(value)
+	if err != nil {
+		err = core.SDKErrorf(err, fmt.Sprintf("schema '%s': %s", schemaID, err.Error()), "schema-validation-error", common.GetComponentInfo())
+	}
+	return
+}
+
+func (binder *Binder) schema(ctx context.Context, schemaID string) (*avro.Schema, error) {
+	binder.mutex.Lock()
+	schema := binder.schemas[schemaID]
+	binder.mutex.Unlock()
+	if schema != nil {
+		return schema, nil
+	}
+
+	avroSchema, _, err := binder.registry.GetLatestSchemaWithContext(ctx, binder.registry.NewGetLatestSchemaOptions(schemaID))
+	if err != nil {
+		return nil, core.SDKErrorf(err, "", "get-latest-schema-error", common.GetComponentInfo())
+	}
+	schema, err = avro.FromAvroSchema(avroSchema)
+	if err != nil {
+		return nil, err
+	}
+	binder.mutex.Lock()
+	binder.schemas[schemaID] = schema
+	binder.mutex.Unlock()
+	return schema, nil
+}
+
+func (binder *Binder) loadedSchemaIDs() []string {
+	binder.mutex.Lock()
+	defer binder.mutex.Unlock()
+	return binder.schemaIDs
+}
@@ -0,0 +1,208 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package topicschema
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/IBM/eventstreams-go-sdk/pkg/adminrestv1"
+	"github.com/IBM/eventstreams-go-sdk/pkg/schemaregistryv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/stretchr/testify/assert"
+)
+
+const orderSchema = `{"type": "record", "name": "Order", "namespace": "com.example", "fields": [{"name": "id", "type": "string"}]}`
+
+// newTestServices starts an admin REST API with the given topics and a schema registry with the given schemas, and
+// counts the requests made to the registry.
+func newTestServices(t *testing.T, topics []string, schemas map[string]string, registryRequests *int) (*adminrestv1.AdminrestV1, *schemaregistryv1.SchemaregistryV1, func()) {
+	adminServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "/admin/topics", req.URL.EscapedPath())
+		var items []string
+		for _, topic := range topics {
+			items = append(items, fmt.Sprintf(`{"name": %q, "partitions": 1}`, topic))
+		}
+		res.Header().Set("Content-type", "application/json")
+		res.WriteHeader(200)
+		fmt.Fprintf(res, "[%s]", strings.Join(items, ","))
+	}))
+	registryServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		*registryRequests++
+		res.Header().Set("Content-type", "application/json")
+		path := req.URL.EscapedPath()
+		if path == "/artifacts" {
+			var ids []string
+			for id := range schemas {
+				ids = append(ids, fmt.Sprintf("%q", id))
+			}
+			res.WriteHeader(200)
+			fmt.Fprintf(res, "[%s]", strings.Join(ids, ","))
+			return
+		}
+		schema, ok := schemas[strings.TrimPrefix(path, "/artifacts/")]
+		if !ok {
+			res.WriteHeader(404)
+			fmt.Fprint(res, `{"error_code": 404, "message": "not found"}`)
+			return
+		}
+		res.WriteHeader(200)
+		fmt.Fprintf(res, `{"schema": %s}`, schema)
+	}))
+
+	adminrest, err := adminrestv1.NewAdminrestV1(&adminrestv1.AdminrestV1Options{
+		URL:           adminServer.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	assert.Nil(t, err)
+	registry, err := schemaregistryv1.NewSchemaregistryV1(&schemaregistryv1.SchemaregistryV1Options{
+		URL:           registryServer.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	assert.Nil(t, err)
+	return adminrest, registry, func() {
+		adminServer.Close()
+		registryServer.Close()
+	}
+}
+
+func TestNamingStrategies(t *testing.T) {
+	assert.Equal(t, "orders-value", TopicNameStrategy{}.SchemaID("orders", SchemaRoleValue, "com.example.Order"))
+	role, _, ok := TopicNameStrategy{}.Match("orders", "orders-key")
+	assert.True(t, ok)
+	assert.Equal(t, SchemaRoleKey, role)
+	_, _, ok = TopicNameStrategy{}.Match("orders", "orders-com.example.Order")
+	assert.False(t, ok)
+
+	strategy := RecordNameStrategy{
+		Records:    map[string][]string{"orders": {"com.example.Order", "com.example.Refund"}},
+		KeyRecords: map[string][]string{"orders": {"com.example.OrderKey"}},
+	}
+	assert.Equal(t, "com.example.Order", strategy.SchemaID("orders", SchemaRoleValue, "com.example.Order"))
+	role, recordName, ok := strategy.Match("orders", "com.example.Refund")
+	assert.True(t, ok)
+	assert.Equal(t, SchemaRoleValue, role)
+	assert.Equal(t, "com.example.Refund", recordName)
+	role, _, ok = strategy.Match("orders", "com.example.OrderKey")
+	assert.True(t, ok)
+	assert.Equal(t, SchemaRoleKey, role)
+	_, _, ok = strategy.Match("payments", "com.example.Order")
+	assert.False(t, ok)
+
+	assert.Equal(t, "orders-com.example.Order", TopicRecordNameStrategy{}.SchemaID("orders", SchemaRoleValue, "com.example.Order"))
+	_, recordName, ok = TopicRecordNameStrategy{}.Match("orders", "orders-com.example.Order")
+	assert.True(t, ok)
+	assert.Equal(t, "com.example.Order", recordName)
+	for _, schemaID := range []string{"orders-value", "orders-", "payments-com.example.Order"} {
+		_, _, ok = TopicRecordNameStrategy{}.Match("orders", schemaID)
+		assert.False(t, ok, schemaID)
+	}
+}
+
+func TestReport(t *testing.T) {
+	var registryRequests int
+	adminrest, registry, stop := newTestServices(t,
+		[]string{"orders", "orders-audit", "payments"},
+		map[string]string{"orders-value": orderSchema, "orders-key": `"string"`, "orders-audit-value": orderSchema, "orders-audit-com.example.Audit": orderSchema, "legacy-value": orderSchema},
+		&registryRequests)
+	defer stop()
+
+	report, err := NewBinder(adminrest, registry, nil).Report(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, &BindingReport{
+		Bindings: []Binding{
+			{Topic: "orders", Role: SchemaRoleKey, SchemaID: "orders-key"},
+			{Topic: "orders", Role: SchemaRoleValue, SchemaID: "orders-value"},
+			{Topic: "orders-audit", Role: SchemaRoleValue, SchemaID: "orders-audit-value"},
+		},
+		TopicsWithoutSchemas: []string{"payments"},
+		SchemasWithoutTopics: []string{"legacy-value", "orders-audit-com.example.Audit"},
+	}, report)
+
+	// With TopicRecordNameStrategy, orders-audit-com.example.Audit could belong to either topic; the longest topic name
+	// wins.
+	report, err = NewBinder(adminrest, registry, TopicRecordNameStrategy{}).Report(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, []Binding{
+		{Topic: "orders", Role: SchemaRoleValue, SchemaID: "orders-audit-value", RecordName: "audit-value"},
+		{Topic: "orders-audit", Role: SchemaRoleValue, SchemaID: "orders-audit-com.example.Audit", RecordName: "com.example.Audit"},
+	}, report.Bindings)
+	assert.Equal(t, []string{"payments"}, report.TopicsWithoutSchemas)
+	assert.Equal(t, []string{"legacy-value", "orders-key", "orders-value"}, report.SchemasWithoutTopics)
+
+	// With RecordNameStrategy, a record schema used by several topics is bound to each of them.
+	strategy := RecordNameStrategy{Records: map[string][]string{"orders": {"com.example.Order"}, "orders-audit": {"com.example.Order"}}}
+	adminrest, registry, stop = newTestServices(t,
+		[]string{"orders", "orders-audit", "payments"},
+		map[string]string{"com.example.Order": orderSchema},
+		&registryRequests)
+	defer stop()
+	report, err = NewBinder(adminrest, registry, strategy).Report(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, []Binding{
+		{Topic: "orders", Role: SchemaRoleValue, SchemaID: "com.example.Order", RecordName: "com.example.Order"},
+		{Topic: "orders-audit", Role: SchemaRoleValue, SchemaID: "com.example.Order", RecordName: "com.example.Order"},
+	}, report.Bindings)
+	assert.Equal(t, []string{"payments"}, report.TopicsWithoutSchemas)
+	assert.Empty(t, report.SchemasWithoutTopics)
+}
+
+func TestValidate(t *testing.T) {
+	var registryRequests int
+	adminrest, registry, stop := newTestServices(t,
+		[]string{"orders"},
+		map[string]string{
+			"orders-com.example.Order":  orderSchema,
+			"orders-com.example.Refund": `{"type": "record", "name": "Refund", "namespace": "com.example", "fields": [{"name": "amount", "type": "int"}]}`,
+		},
+		&registryRequests)
+	defer stop()
+
+	binder := NewBinder(adminrest, registry, TopicRecordNameStrategy{})
+	bindings, err := binder.Bindings(context.Background(), "orders")
+	assert.Nil(t, err)
+	assert.Len(t, bindings, 2)
+
+	assert.Nil(t, binder.Validate(context.Background(), "orders", SchemaRoleValue, map[string]interface{}{"amount": 5}))
+	assert.Nil(t, binder.Validate(context.Background(), "orders", SchemaRoleValue, struct {
+		ID string `avro:"id"`
+	}{ID: "o1"}))
+	err = binder.Validate(context.Background(), "orders", SchemaRoleValue, map[string]interface{}{"amount": "5"})
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "value for topic 'orders' matches no bound schema: schema 'orders-com.example.Order': ")
+	assert.Contains(t, err.Error(), "$.amount: expected an integer for int")
+
+	err = binder.Validate(context.Background(), "orders", SchemaRoleKey, "k1")
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "no key schema is bound to topic 'orders'")
+
+	// The schema list and schemas are cached.
+	assert.Equal(t, 3, registryRequests)
+	assert.Nil(t, binder.ValidateRecord(context.Background(), "orders", SchemaRoleValue, "com.example.Order", map[string]interface{}{"id": "o1"}))
+	assert.Equal(t, 3, registryRequests)
+
+	err = binder.ValidateRecord(context.Background(), "orders", SchemaRoleValue, "com.example.Missing", map[string]interface{}{})
+	assert.NotNil(t, err)
+
+	assert.Nil(t, binder.Refresh(context.Background()))
+	assert.Nil(t, binder.ValidateRecord(context.Background(), "orders", SchemaRoleValue, "com.example.Order", map[string]interface{}{"id": "o1"}))
+	assert.Equal(t, 6, registryRequests)
+}
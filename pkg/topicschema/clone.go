@@ -0,0 +1,159 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package topicschema
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+
+	"github.com/IBM/eventstreams-go-sdk/pkg/adminrestv1"
+	"github.com/IBM/eventstreams-go-sdk/pkg/avro"
+	common "github.com/IBM/eventstreams-go-sdk/pkg/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Constants associated with the SchemaCopy.Action property.
+// What copying a schema did to the target registry.
+const (
+	SchemaCopyActionCreated   = "created"
+	SchemaCopyActionVersioned = "versioned"
+	SchemaCopyActionUnchanged = "unchanged"
+)
+
+// SchemaCopy : A schema bound to a cloned topic, copied to the schema bound to the new topic.
+type SchemaCopy struct {
+	// Whether the schema describes keys or values, one of the SchemaRole constants.
+	Role string `json:"role"`
+
+	// The full name of the record type, for strategies that use record names.
+	RecordName string `json:"record_name,omitempty"`
+
+	// The ID of the schema bound to the cloned topic.
+	SourceID string `json:"source_id"`
+
+	// The ID of the schema bound to the new topic.
+	TargetID string `json:"target_id"`
+
+	// What the copy did, one of the SchemaCopyAction constants.
+	Action string `json:"action"`
+}
+
+// TopicClone : A topic created by CloneTopic, and the schemas copied for it.
+type TopicClone struct {
+	adminrestv1.TopicClone
+
+	// The schemas copied to the target registry, sorted by source schema ID.
+	Schemas []SchemaCopy `json:"schemas"`
+}
+
+// CloneTopic creates a topic like a topic of the binder's instance with adminrestv1.CloneTopic, on the instance of
+// target, and copies the latest version of each schema bound to the topic to the schema that target binds to the new
+// topic. target can be the binder itself when only the topic name changes. The Target of options is replaced by the
+// instance of target.
+//
+// A target schema that does not exist is created, and one whose latest version differs gets a new version, so the
+// copy is subject to the compatibility rules of the target registry. If copying a schema fails, the clone is returned
+// together with the error, with the schemas copied so far.
+func (binder *Binder) CloneTopic(ctx context.Context, options *adminrestv1.CloneTopicOptions, target *Binder) (result *TopicClone, err error) {
+	err = core.ValidateNotNil(options, "options cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateNotNil(target, "target cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	cloneOptions := *options
+	cloneOptions.Target = target.adminrest
+
+	// The bindings are read first so that the topic is not created when the registry cannot be reached.
+	var bindings []Binding
+	if options.TopicName != nil {
+		bindings, err = binder.Bindings(ctx, *options.TopicName)
+		if err != nil {
+			return
+		}
+	}
+
+	clone, err := binder.adminrest.CloneTopicWithContext(ctx, &cloneOptions)
+	if clone == nil {
+		return
+	}
+	result = &TopicClone{TopicClone: *clone, Schemas: []SchemaCopy{}}
+	if err != nil {
+		return
+	}
+
+	for _, binding := range bindings {
+		var copied SchemaCopy
+		copied, err = binder.copySchema(ctx, binding, clone.TargetTopicName, target)
+		if err != nil {
+			return
+		}
+		result.Schemas = append(result.Schemas, copied)
+	}
+	if len(result.Schemas) > 0 {
+		// The target schemas changed, so they are reloaded on next use.
+		target.mutex.Lock()
+		target.schemaIDs = nil
+		target.schemas = map[string]*avro.Schema{}
+		target.mutex.Unlock()
+	}
+	return
+}
+
+// copySchema copies the latest version of the schema of binding to the schema that target binds to targetTopic.
+func (binder *Binder) copySchema(ctx context.Context, binding Binding, targetTopic string, target *Binder) (copied SchemaCopy, err error) {
+	copied = SchemaCopy{
+		Role:       binding.Role,
+		RecordName: binding.RecordName,
+		SourceID:   binding.SchemaID,
+		TargetID:   target.strategy.SchemaID(targetTopic, binding.Role, binding.RecordName),
+	}
+	source, _, err := binder.registry.GetLatestSchemaWithContext(ctx, binder.registry.NewGetLatestSchemaOptions(copied.SourceID))
+	if err != nil {
+		err = core.SDKErrorf(err, "", "get-latest-schema-error", common.GetComponentInfo())
+		return
+	}
+
+	existing, response, err := target.registry.GetLatestSchemaWithContext(ctx, target.registry.NewGetLatestSchemaOptions(copied.TargetID))
+	switch {
+	case err != nil && response != nil && response.StatusCode == http.StatusNotFound:
+		createSchemaOptions := target.registry.NewCreateSchemaOptions().
+			SetSchema(source.Schema).
+			SetXRegistryArtifactID(copied.TargetID)
+		_, _, err = target.registry.CreateSchemaWithContext(ctx, createSchemaOptions)
+		copied.Action = SchemaCopyActionCreated
+	case err != nil:
+		err = core.SDKErrorf(err, "", "get-latest-schema-error", common.GetComponentInfo())
+		return
+	case reflect.DeepEqual(existing.Schema, source.Schema):
+		copied.Action = SchemaCopyActionUnchanged
+	default:
+		createVersionOptions := target.registry.NewCreateVersionOptions(copied.TargetID).SetSchema(source.Schema)
+		_, _, err = target.registry.CreateVersionWithContext(ctx, createVersionOptions)
+		copied.Action = SchemaCopyActionVersioned
+	}
+	if err != nil {
+		err = core.SDKErrorf(err, fmt.Sprintf("failed to copy schema '%s' to '%s'", copied.SourceID, copied.TargetID), "copy-schema-error", common.GetComponentInfo())
+	}
+	return
+}
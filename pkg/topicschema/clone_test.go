@@ -0,0 +1,166 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package topicschema
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/IBM/eventstreams-go-sdk/pkg/adminrestv1"
+	"github.com/IBM/eventstreams-go-sdk/pkg/schemaregistryv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/stretchr/testify/assert"
+)
+
+type testInstance struct {
+	topics   map[string]string
+	schemas  map[string]string
+	requests []string
+}
+
+// newTestBinder starts an admin REST API and a schema registry that serve the topics and schemas of instance, and
+// record the changes made to them.
+func newTestBinder(t *testing.T, instance *testInstance) (*Binder, func()) {
+	adminServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		res.Header().Set("Content-type", "application/json")
+		path := req.URL.EscapedPath()
+		switch {
+		case req.Method == "POST" && path == "/admin/topics":
+			var body map[string]interface{}
+			assert.Nil(t, json.NewDecoder(req.Body).Decode(&body))
+			instance.topics[body["name"].(string)] = fmt.Sprintf(`{"name": %q, "partitions": %v}`, body["name"], body["partition_count"])
+			instance.requests = append(instance.requests, "create topic "+body["name"].(string))
+			res.WriteHeader(202)
+		case strings.HasPrefix(path, "/admin/topics/"):
+			topic, ok := instance.topics[strings.TrimPrefix(path, "/admin/topics/")]
+			if !ok {
+				res.WriteHeader(404)
+				fmt.Fprint(res, `{"error_code": 404, "message": "not found"}`)
+				return
+			}
+			res.WriteHeader(200)
+			fmt.Fprint(res, topic)
+		default:
+			t.Errorf("unexpected admin request %s %s", req.Method, path)
+		}
+	}))
+	registryServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		res.Header().Set("Content-type", "application/json")
+		path := req.URL.EscapedPath()
+		var body struct {
+			Schema json.RawMessage `json:"schema"`
+		}
+		if req.Method == "POST" {
+			assert.Nil(t, json.NewDecoder(req.Body).Decode(&body))
+		}
+		switch {
+		case req.Method == "GET" && path == "/artifacts":
+			ids := []string{}
+			for id := range instance.schemas {
+				ids = append(ids, fmt.Sprintf("%q", id))
+			}
+			res.WriteHeader(200)
+			fmt.Fprintf(res, "[%s]", strings.Join(ids, ","))
+		case req.Method == "POST" && path == "/artifacts":
+			id := req.Header.Get("X-Registry-ArtifactId")
+			instance.schemas[id] = string(body.Schema)
+			instance.requests = append(instance.requests, "create schema "+id)
+			res.WriteHeader(200)
+			fmt.Fprintf(res, `{"id": %q, "version": 1}`, id)
+		case req.Method == "POST" && strings.HasSuffix(path, "/versions"):
+			id := strings.TrimSuffix(strings.TrimPrefix(path, "/artifacts/"), "/versions")
+			instance.schemas[id] = string(body.Schema)
+			instance.requests = append(instance.requests, "create version "+id)
+			res.WriteHeader(200)
+			fmt.Fprintf(res, `{"id": %q, "version": 2}`, id)
+		case req.Method == "GET":
+			schema, ok := instance.schemas[strings.TrimPrefix(path, "/artifacts/")]
+			if !ok {
+				res.WriteHeader(404)
+				fmt.Fprint(res, `{"error_code": 404, "message": "not found"}`)
+				return
+			}
+			res.WriteHeader(200)
+			fmt.Fprintf(res, `{"schema": %s}`, schema)
+		default:
+			t.Errorf("unexpected registry request %s %s", req.Method, path)
+		}
+	}))
+
+	adminrest, err := adminrestv1.NewAdminrestV1(&adminrestv1.AdminrestV1Options{
+		URL:           adminServer.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	assert.Nil(t, err)
+	registry, err := schemaregistryv1.NewSchemaregistryV1(&schemaregistryv1.SchemaregistryV1Options{
+		URL:           registryServer.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	assert.Nil(t, err)
+	return NewBinder(adminrest, registry, nil), func() {
+		adminServer.Close()
+		registryServer.Close()
+	}
+}
+
+func TestCloneTopic(t *testing.T) {
+	keySchema := `{"type": "record", "name": "OrderKey", "fields": [{"name": "id", "type": "string"}]}`
+	refundSchema := strings.Replace(orderSchema, `"Order"`, `"Refund"`, 1)
+	source := &testInstance{
+		topics:  map[string]string{"orders": `{"name": "orders", "partitions": 3, "retentionMs": 3600000}`},
+		schemas: map[string]string{"orders-key": keySchema, "orders-value": orderSchema, "payments-value": refundSchema},
+	}
+	target := &testInstance{
+		topics:  map[string]string{},
+		schemas: map[string]string{"orders-key": keySchema, "orders-value": refundSchema},
+	}
+	sourceBinder, closeSource := newTestBinder(t, source)
+	defer closeSource()
+	targetBinder, closeTarget := newTestBinder(t, target)
+	defer closeTarget()
+	ctx := context.Background()
+
+	clone, err := sourceBinder.CloneTopic(ctx, sourceBinder.adminrest.NewCloneTopicOptions("orders"), targetBinder)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(3), clone.Partitions)
+	assert.Equal(t, map[string]string{"retention.ms": "3600000"}, clone.Configs)
+	assert.Equal(t, []SchemaCopy{
+		{Role: SchemaRoleKey, SourceID: "orders-key", TargetID: "orders-key", Action: SchemaCopyActionUnchanged},
+		{Role: SchemaRoleValue, SourceID: "orders-value", TargetID: "orders-value", Action: SchemaCopyActionVersioned},
+	}, clone.Schemas)
+	assert.Equal(t, []string{"create topic orders", "create version orders-value"}, target.requests)
+	assert.JSONEq(t, orderSchema, target.schemas["orders-value"])
+
+	clone, err = sourceBinder.CloneTopic(ctx, sourceBinder.adminrest.NewCloneTopicOptions("orders").SetTargetTopicName("orders-copy"), sourceBinder)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"orders-copy-key", "orders-copy-value"}, []string{clone.Schemas[0].TargetID, clone.Schemas[1].TargetID})
+	assert.Equal(t, SchemaCopyActionCreated, clone.Schemas[0].Action)
+	assert.Equal(t, []string{"create topic orders-copy", "create schema orders-copy-key", "create schema orders-copy-value"}, source.requests)
+	bindings, err := sourceBinder.Bindings(ctx, "orders-copy")
+	assert.Nil(t, err)
+	assert.Len(t, bindings, 2)
+
+	_, err = sourceBinder.CloneTopic(ctx, sourceBinder.adminrest.NewCloneTopicOptions("missing"), targetBinder)
+	assert.NotNil(t, err)
+	_, err = sourceBinder.CloneTopic(ctx, nil, targetBinder)
+	assert.NotNil(t, err)
+}